@@ -292,6 +292,51 @@ type Config struct {
 
 	// S3UsEast1RegionalEndpoint will enable regional or legacy endpoint resolving
 	S3UsEast1RegionalEndpoint endpoints.S3UsEast1RegionalEndpoint
+
+	// IBM COS SDK Code -- START
+	// HMACCredentials are the HMAC (access key ID and secret access key)
+	// credentials held alongside the IBM IAM Credentials. Requests that select
+	// HMAC signing, see UseHMACSigning, are signed with these credentials
+	// using the V4 signer, all other requests are signed with Credentials.
+	//
+	// Example:
+	//    sess := session.Must(session.NewSession(&aws.Config{
+	//         Credentials:     ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpoint, apiKey, serviceInstanceID),
+	//         HMACCredentials: credentials.NewStaticCredentials(accessKeyID, secretAccessKey, ""),
+	//    }))
+	HMACCredentials *credentials.Credentials
+
+	// UseHMACSigning sets the default signer for requests made with both
+	// Credentials and HMACCredentials configured. When true requests are
+	// signed with HMACCredentials, otherwise with Credentials. Individual
+	// requests can override the default with request.WithHMACSigning.
+	//
+	// Has no effect if HMACCredentials is not set.
+	UseHMACSigning *bool
+
+	// S3BucketLocationRouting enables routing S3 bucket requests to the IBM
+	// COS endpoint of the bucket's location. The location is discovered with
+	// GetBucketLocation, or ListBucketsExtended, and cached for
+	// S3BucketLocationCacheTTL. Requests failing with a wrong endpoint error
+	// are retried once against the rediscovered location.
+	//
+	//     svc := s3.New(sess, &aws.Config{
+	//         S3BucketLocationRouting: aws.Bool(true),
+	//     })
+	S3BucketLocationRouting *bool
+
+	// S3BucketLocationCacheTTL is how long discovered bucket locations are
+	// cached when S3BucketLocationRouting is enabled. Defaults to 15 minutes.
+	S3BucketLocationCacheTTL time.Duration
+
+	// UseStreamingXMLDecoder enables deserializing REST-XML responses, e.g.
+	// S3 ListObjects and ListObjectVersions, directly from the response
+	// body's XML tokens instead of from an intermediate document tree,
+	// reducing the garbage created decoding large responses.
+	//
+	// Experimental, defaults to false.
+	UseStreamingXMLDecoder *bool
+	// IBM COS SDK Code -- END
 }
 
 // NewConfig returns a new Config pointer that can be chained with builder
@@ -494,6 +539,45 @@ func (c *Config) WithDisableRestProtocolURICleaning(t bool) *Config {
 	return c
 }
 
+// IBM COS SDK Code -- START
+
+// WithHMACCredentials sets a config HMACCredentials value returning a Config
+// pointer for chaining.
+func (c *Config) WithHMACCredentials(creds *credentials.Credentials) *Config {
+	c.HMACCredentials = creds
+	return c
+}
+
+// WithUseHMACSigning sets a config UseHMACSigning value returning a Config
+// pointer for chaining.
+func (c *Config) WithUseHMACSigning(enable bool) *Config {
+	c.UseHMACSigning = &enable
+	return c
+}
+
+// WithS3BucketLocationRouting sets a config S3BucketLocationRouting value
+// returning a Config pointer for chaining.
+func (c *Config) WithS3BucketLocationRouting(enable bool) *Config {
+	c.S3BucketLocationRouting = &enable
+	return c
+}
+
+// WithS3BucketLocationCacheTTL sets a config S3BucketLocationCacheTTL value
+// returning a Config pointer for chaining.
+func (c *Config) WithS3BucketLocationCacheTTL(ttl time.Duration) *Config {
+	c.S3BucketLocationCacheTTL = ttl
+	return c
+}
+
+// WithUseStreamingXMLDecoder sets a config UseStreamingXMLDecoder value
+// returning a Config pointer for chaining.
+func (c *Config) WithUseStreamingXMLDecoder(enable bool) *Config {
+	c.UseStreamingXMLDecoder = &enable
+	return c
+}
+
+// IBM COS SDK Code -- END
+
 // MergeIn merges the passed in configs into the existing config object.
 func (c *Config) MergeIn(cfgs ...*Config) {
 	for _, other := range cfgs {
@@ -624,6 +708,28 @@ func mergeInConfig(dst *Config, other *Config) {
 	if other.UseDualStackEndpoint != endpoints.DualStackEndpointStateUnset {
 		dst.UseDualStackEndpoint = other.UseDualStackEndpoint
 	}
+
+	// IBM COS SDK Code -- START
+	if other.HMACCredentials != nil {
+		dst.HMACCredentials = other.HMACCredentials
+	}
+
+	if other.UseHMACSigning != nil {
+		dst.UseHMACSigning = other.UseHMACSigning
+	}
+
+	if other.S3BucketLocationRouting != nil {
+		dst.S3BucketLocationRouting = other.S3BucketLocationRouting
+	}
+
+	if other.S3BucketLocationCacheTTL != 0 {
+		dst.S3BucketLocationCacheTTL = other.S3BucketLocationCacheTTL
+	}
+
+	if other.UseStreamingXMLDecoder != nil {
+		dst.UseStreamingXMLDecoder = other.UseStreamingXMLDecoder
+	}
+	// IBM COS SDK Code -- END
 }
 
 // Copy will return a shallow copy of the Config object. If any additional
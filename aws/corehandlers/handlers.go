@@ -228,7 +228,7 @@ var ValidateEndpointHandler = request.NamedHandler{Name: "core.ValidateEndpointH
 
 	// Anonymous Creds support
 	if r.Config.Credentials != credentials.AnonymousCredentials {
-		value, err := r.Config.Credentials.Get()
+		value, err := r.Config.Credentials.GetWithContext(r.Context())
 		if err != nil {
 			r.Error = err
 			return
@@ -69,9 +69,30 @@ func NewChainCredentials(providers []Provider) *Credentials {
 // If a provider is found it will be cached and any calls to IsExpired()
 // will return the expired state of the cached provider.
 func (c *ChainProvider) Retrieve() (Value, error) {
+	// IBM COS SDK Code -- START
+	return c.RetrieveWithContext(backgroundContext())
+}
+
+// RetrieveWithContext is Retrieve passing the context to the providers
+// implementing ProviderWithContext.
+//
+// Credentials.GetWithContext shares a retrieval between its concurrent
+// callers, and passes the values of the caller's context without its
+// cancellation or deadline. A canceled caller stops waiting for the
+// retrieval, which continues for the other callers.
+func (c *ChainProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	// IBM COS SDK Code -- END
 	var errs []error
 	for _, p := range c.Providers {
-		creds, err := p.Retrieve()
+		// IBM COS SDK Code -- START
+		var creds Value
+		var err error
+		if pc, ok := p.(ProviderWithContext); ok {
+			creds, err = pc.RetrieveWithContext(ctx)
+		} else {
+			creds, err = p.Retrieve()
+		}
+		// IBM COS SDK Code -- END
 		if err == nil {
 			c.curr = p
 			return creds, nil
@@ -88,6 +109,12 @@ func (c *ChainProvider) Retrieve() (Value, error) {
 	return Value{}, err
 }
 
+// ResolvedProvider returns the provider which supplied the most recently
+// retrieved credentials, or nil if none of the providers did.
+func (c *ChainProvider) ResolvedProvider() Provider {
+	return c.curr
+}
+
 // IsExpired will returned the expired state of the currently cached provider
 // if there is one.  If there is no current provider, true will be returned.
 func (c *ChainProvider) IsExpired() bool {
@@ -4,6 +4,10 @@ import (
 	"reflect"
 	"testing"
 
+	// IBM COS SDK Code -- START
+	"context"
+	// IBM COS SDK Code -- END
+
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 )
 
@@ -187,3 +191,80 @@ func TestChainProviderWithNoValidProviderWithVerboseEnabled(t *testing.T) {
 		t.Errorf("Expect no providers error returned, %v, got %v", e, a)
 	}
 }
+
+func TestChainProviderResolvedProvider(t *testing.T) {
+	resolved := &stubProvider{
+		creds: Value{
+			AccessKeyID:     "AKID",
+			SecretAccessKey: "SECRET",
+		},
+	}
+	p := &ChainProvider{
+		Providers: []Provider{
+			&stubProvider{err: awserr.New("FirstError", "first provider error", nil)},
+			resolved,
+		},
+	}
+	creds := NewCredentials(p)
+
+	if v := creds.ProviderChain(); v != nil {
+		t.Errorf("Expect no provider chain before Get, got %v", v)
+	}
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("Expect no error, got %v", err)
+	}
+
+	if e, a := Provider(resolved), p.ResolvedProvider(); e != a {
+		t.Errorf("Expect resolved provider to match, %v got %v", e, a)
+	}
+	if e, a := []Provider{p, resolved}, creds.ProviderChain(); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expect provider chain to match, %v got %v", e, a)
+	}
+
+	creds.Expire()
+	if v := creds.ProviderChain(); v != nil {
+		t.Errorf("Expect no provider chain after Expire, got %v", v)
+	}
+}
+
+// IBM COS SDK Code -- START
+type contextStubProvider struct {
+	stubProvider
+	ctx Context
+}
+
+func (s *contextStubProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	s.ctx = ctx
+	return s.Retrieve()
+}
+
+type chainContextKey struct{}
+
+func TestChainProviderRetrieveWithContext(t *testing.T) {
+	withContext := &contextStubProvider{
+		stubProvider: stubProvider{creds: Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}},
+	}
+	p := &ChainProvider{
+		Providers: []Provider{
+			&stubProvider{err: awserr.New("FirstError", "first provider error", nil)},
+			withContext,
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), chainContextKey{}, "value")
+	if _, err := NewCredentials(p).GetWithContext(ctx); err != nil {
+		t.Fatalf("Expect no error, got %v", err)
+	}
+	if withContext.ctx == nil {
+		t.Fatalf("Expect provider to be retrieved with context")
+	}
+	if e, a := "value", withContext.ctx.Value(chainContextKey{}); e != a {
+		t.Errorf("Expect context value %v, got %v", e, a)
+	}
+	if e, a := Provider(withContext), p.ResolvedProvider(); e != a {
+		t.Errorf("Expect resolved provider to match, %v got %v", e, a)
+	}
+}
+
+// IBM COS SDK Code -- END
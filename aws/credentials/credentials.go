@@ -135,6 +135,14 @@ type Expirer interface {
 	ExpiresAt() time.Time
 }
 
+// A ProviderResolver is a Provider that delegates the retrieval of credentials
+// to other Providers, such as the ChainProvider. It reports which Provider
+// supplied the most recently retrieved credentials.
+type ProviderResolver interface {
+	// The Provider the credentials were retrieved from, nil if none.
+	ResolvedProvider() Provider
+}
+
 // An ErrorProvider is a stub credentials provider that always returns an error
 // this is used by the SDK when construction a known provider is not possible
 // due to an error.
@@ -265,6 +273,11 @@ func (c *Credentials) GetWithContext(ctx Context) (Value, error) {
 	// Cannot pass context down to the actual retrieve, because the first
 	// context would cancel the whole group when there is not direct
 	// association of items in the group.
+	// IBM COS SDK Code -- START
+	// ProviderWithContext providers are passed the values of the context,
+	// e.g. ChainProvider and ibmiam.Provider, but a canceled caller only
+	// stops waiting for the shared retrieval.
+	// IBM COS SDK Code -- END
 	resCh := c.sf.DoChan("", func() (interface{}, error) {
 		return c.singleRetrieve(&suppressedContext{ctx})
 	})
@@ -380,6 +393,35 @@ func (c *Credentials) ExpiresAt() (time.Time, error) {
 	return expirer.ExpiresAt(), nil
 }
 
+// ProviderChain returns the chain of Providers that resolved the currently
+// cached credentials Value. The first element is the Credentials' Provider,
+// each following element is the Provider the previous one resolved to, as
+// reported by the ProviderResolver interface.
+//
+// Returns nil if the credentials were not retrieved yet, or were expired with
+// Expire().
+func (c *Credentials) ProviderChain() []Provider {
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	if c.creds == (Value{}) {
+		return nil
+	}
+
+	chain := []Provider{c.provider}
+	for p := c.provider; ; {
+		resolver, ok := p.(ProviderResolver)
+		if !ok {
+			break
+		}
+		if p = resolver.ResolvedProvider(); p == nil {
+			break
+		}
+		chain = append(chain, p)
+	}
+	return chain
+}
+
 type suppressedContext struct {
 	Context
 }
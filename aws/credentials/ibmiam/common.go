@@ -6,6 +6,7 @@ import (
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/tokenmanager"
 )
 
@@ -114,13 +115,36 @@ func (p *Provider) IsValid() bool {
 //	Credential values
 //	Error
 func (p *Provider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+// RetrieveWithContext ...
+// Parameters:
+//
+//	Context - cancels or sets the deadline of the token fetch, when the token
+//	manager supports it. Credentials.GetWithContext shares the fetch between
+//	its concurrent callers and does not pass the cancellation or deadline of
+//	the caller's context, a canceled caller only stops waiting for the fetch
+//
+// Returns:
+//
+//	Credential values
+//	Error
+func (p *Provider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
 	if p.ErrorStatus != nil {
 		if p.logLevel.Matches(aws.LogDebug) {
 			p.logger.Log(debugLog, ibmiamProviderLog, p.providerName, p.ErrorStatus)
 		}
 		return credentials.Value{ProviderName: p.providerName}, p.ErrorStatus
 	}
-	tokenValue, err := p.tokenManager.Get()
+
+	var tokenValue *token.Token
+	var err error
+	if tm, ok := p.tokenManager.(tokenmanager.APIWithContext); ok {
+		tokenValue, err = tm.GetWithContext(ctx)
+	} else {
+		tokenValue, err = p.tokenManager.Get()
+	}
 	if err != nil {
 		var returnErr error
 		if p.logLevel.Matches(aws.LogDebug) {
@@ -0,0 +1,97 @@
+package ibmiam
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/tokenmanager"
+)
+
+// Introspection describes the credentials a Credentials object resolves to,
+// meant for diagnostics and health endpoints
+type Introspection struct {
+	// Name of the provider that supplied the credentials
+	ProviderName string
+
+	// Type of the provider that supplied the credentials, "oauth" for IBM IAM
+	ProviderType string
+
+	// Type names of the providers that resolved the credentials, from the
+	// outermost provider to the one that supplied them
+	ProviderChain []string
+
+	// Service Instance ID of the credentials
+	ServiceInstanceID string
+
+	// Claims of the IBM IAM access token, nil when not IBM IAM credentials
+	Claims *token.Claims
+
+	// Expiration of the credentials, zero when unknown
+	ExpiresAt time.Time
+
+	// Refresh schedule of the token manager, nil when the provider has no
+	// token manager or it cannot report its schedule
+	RefreshSchedule *tokenmanager.RefreshSchedule
+}
+
+// RefreshSchedule reports the refresh schedule of the token manager
+// Returns:
+//
+//	Refresh schedule
+//	Boolean - whether the token manager reports a refresh schedule
+func (p *Provider) RefreshSchedule() (tokenmanager.RefreshSchedule, bool) {
+	if scheduler, ok := p.tokenManager.(tokenmanager.RefreshScheduler); ok {
+		return scheduler.RefreshSchedule(), true
+	}
+	return tokenmanager.RefreshSchedule{}, false
+}
+
+// Introspect retrieves the credentials and describes the provider chain, the
+// identity and the expiration of the IBM IAM token they resolve to
+// Parameters:
+//
+//	Credentials
+//
+// Returns:
+//
+//	Introspection
+//	Error - retrieving the credentials or decoding the access token claims
+func Introspect(creds *credentials.Credentials) (*Introspection, error) {
+	value, err := creds.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Introspection{
+		ProviderName:      value.ProviderName,
+		ProviderType:      value.ProviderType,
+		ServiceInstanceID: value.ServiceInstanceID,
+	}
+
+	if expiresAt, err := creds.ExpiresAt(); err == nil {
+		result.ExpiresAt = expiresAt
+	}
+
+	for _, p := range creds.ProviderChain() {
+		result.ProviderChain = append(result.ProviderChain, fmt.Sprintf("%T", p))
+
+		if p, ok := p.(*Provider); ok {
+			if schedule, ok := p.RefreshSchedule(); ok {
+				result.RefreshSchedule = &schedule
+			}
+		}
+	}
+
+	if value.AccessToken != "" {
+		if result.Claims, err = value.Claims(); err != nil {
+			return result, err
+		}
+		if result.ExpiresAt.IsZero() {
+			result.ExpiresAt = result.Claims.ExpiresAtTime()
+		}
+	}
+
+	return result, nil
+}
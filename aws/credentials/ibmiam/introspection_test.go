@@ -0,0 +1,62 @@
+package ibmiam
+
+import (
+	"encoding/base64"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/tokenmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test Introspection of IBM IAM credentials resolved through a provider chain
+func TestIntrospect(t *testing.T) {
+	expiration := time.Now().Add(time.Hour).Truncate(time.Second)
+	enc := base64.RawURLEncoding
+	accessToken := enc.EncodeToString([]byte(`{"alg":"RS256"}`)) + "." +
+		enc.EncodeToString([]byte(`{"iam_id":"iam-ServiceId-1234","sub":"ServiceId-1234",`+
+			`"account":{"bss":"acc1"},"exp":`+strconv.FormatInt(expiration.Unix(), 10)+`}`)) + ".sig"
+
+	initFunc := func() (*token.Token, error) {
+		return &token.Token{
+			AccessToken: accessToken,
+			TokenType:   "Bearer",
+			Expiration:  expiration.Unix(),
+		}, nil
+	}
+	tm := tokenmanager.NewTokenManager(&aws.Config{}, initFunc, authendpoint, nil, nil, nil, nil)
+	defer tm.StopBackgroundRefresh()
+
+	prov := &Provider{
+		providerName:      StaticProviderName,
+		providerType:      "oauth",
+		tokenManager:      tm,
+		serviceInstanceID: serviceinstanceid,
+		logLevel:          aws.LogLevel(aws.LogOff),
+	}
+	creds := credentials.NewChainCredentials([]credentials.Provider{
+		&Provider{ErrorStatus: awserr.New("IbmApiKeyIdNotFound", "IBM API Key Id not found", nil), logLevel: aws.LogLevel(aws.LogOff)},
+		prov,
+	})
+
+	result, err := Introspect(creds)
+	require.Nil(t, err, "unexpected error")
+
+	assert.Equal(t, StaticProviderName, result.ProviderName, "Provider Name did not match")
+	assert.Equal(t, "oauth", result.ProviderType, "Provider Type did not match")
+	assert.Equal(t, serviceinstanceid, result.ServiceInstanceID, "Service Instance ID did not match")
+	assert.Equal(t, []string{"*credentials.ChainProvider", "*ibmiam.Provider"}, result.ProviderChain,
+		"Provider Chain did not match")
+	require.NotNil(t, result.Claims, "Claims not set")
+	assert.Equal(t, "iam-ServiceId-1234", result.Claims.IAMID, "IAM ID did not match")
+	assert.Equal(t, "acc1", result.Claims.Account.BSS, "Account did not match")
+	require.NotNil(t, result.RefreshSchedule, "Refresh Schedule not set")
+	assert.Equal(t, expiration, result.RefreshSchedule.Expiration, "Expiration did not match")
+	assert.Equal(t, expiration, result.Claims.ExpiresAtTime(), "Claims Expiration did not match")
+}
@@ -326,3 +326,40 @@ func TestProgramaticalToken(t *testing.T) {
 	assert.Equal(t, CustomInitFuncProviderName, tk.ProviderName, "e3")
 	assert.Equal(t, serviceinstanceid, tk.ServiceInstanceID, "e4")
 }
+
+// Mock Token Manager with context-aware operations
+type tokenManagerWithContextMock struct {
+	tokenManagerMock
+
+	// Context received by GetWithContext
+	ctx aws.Context
+}
+
+// Mock Token Manager's GetWithContext()
+func (tmm *tokenManagerWithContextMock) GetWithContext(ctx aws.Context) (*token.Token, error) {
+	tmm.ctx = ctx
+	return tmm.Get()
+}
+
+// Mock Token Manager's RefreshWithContext()
+func (tmm *tokenManagerWithContextMock) RefreshWithContext(_ aws.Context) error {
+	return nil
+}
+
+// Test Provider passes the context to the Token Manager
+func TestProviderRetrieveWithContext(t *testing.T) {
+	tm := &tokenManagerWithContextMock{}
+	prov := &Provider{
+		providerName: StaticProviderName,
+		providerType: "oauth",
+		tokenManager: tm,
+		logLevel:     aws.LogLevel(aws.LogOff),
+	}
+
+	ctx := aws.BackgroundContext()
+	tk, err := prov.RetrieveWithContext(ctx)
+
+	assert.Nil(t, err, "e1")
+	assert.Equal(t, "A", tk.AccessToken, "e2")
+	assert.Equal(t, ctx, tm.ctx, "e3")
+}
@@ -0,0 +1,294 @@
+package ibmiam
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+)
+
+const (
+	// ServiceCredentialsProviderName name of the IBM IAM provider that loads IAM credentials
+	// from IBM COS service credentials
+	ServiceCredentialsProviderName = "ServiceCredentialsProviderIBM"
+
+	// ServiceCredentialsHMACProviderName name of the HMAC provider that loads HMAC keys
+	// from IBM COS service credentials
+	ServiceCredentialsHMACProviderName = "ServiceCredentialsHMACProviderIBM"
+
+	// ServiceCredentialsEnvVar environment variable holding the IBM COS service credentials JSON
+	ServiceCredentialsEnvVar = "IBM_SERVICE_CREDENTIALS"
+
+	// ErrCodeServiceCredentialsLoad error code returned when the service credentials cannot be loaded
+	ErrCodeServiceCredentialsLoad = "ServiceCredentialsLoadError"
+)
+
+// ServiceCredentials IBM COS service credentials, as downloaded from the IBM Cloud console
+type ServiceCredentials struct {
+	// IBM IAM API Key
+	APIKey string `json:"apikey"`
+
+	// HMAC keys, only present when the credentials were created with HMAC enabled
+	HMACKeys *ServiceCredentialsHMACKeys `json:"cos_hmac_keys,omitempty"`
+
+	// URL listing the IBM COS endpoints
+	Endpoints string `json:"endpoints"`
+
+	// Description of the IBM IAM API Key
+	IAMAPIKeyDescription string `json:"iam_apikey_description"`
+
+	// Name of the IBM IAM API Key
+	IAMAPIKeyName string `json:"iam_apikey_name"`
+
+	// CRN of the role granted to the service ID
+	IAMRoleCRN string `json:"iam_role_crn"`
+
+	// CRN of the service ID owning the API Key
+	IAMServiceIDCRN string `json:"iam_serviceid_crn"`
+
+	// CRN of the IBM COS instance, used as Service Instance ID
+	ResourceInstanceID string `json:"resource_instance_id"`
+}
+
+// ServiceCredentialsHMACKeys HMAC keys of the IBM COS service credentials
+type ServiceCredentialsHMACKeys struct {
+	// HMAC Access Key ID
+	AccessKeyID string `json:"access_key_id"`
+
+	// HMAC Secret Access Key
+	SecretAccessKey string `json:"secret_access_key"`
+}
+
+// ParseServiceCredentials parses IBM COS service credentials JSON
+// Parameters:
+//
+//	Service credentials JSON
+//
+// Returns:
+//
+//	Service credentials
+//	Error
+func ParseServiceCredentials(data []byte) (*ServiceCredentials, error) {
+	creds := &ServiceCredentials{}
+	if err := json.Unmarshal(data, creds); err != nil {
+		return nil, awserr.New(ErrCodeServiceCredentialsLoad, "unable to parse service credentials", err)
+	}
+	return creds, nil
+}
+
+// LoadServiceCredentialsFile reads and parses an IBM COS service credentials JSON file
+// Parameters:
+//
+//	Service credentials filename
+//
+// Returns:
+//
+//	Service credentials
+//	Error
+func LoadServiceCredentialsFile(filename string) (*ServiceCredentials, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, awserr.New(ErrCodeServiceCredentialsLoad,
+			"unable to read service credentials file '"+filename+"'", err)
+	}
+	return ParseServiceCredentials(data)
+}
+
+// loadServiceCredentialsEnv parses the IBM COS service credentials JSON held by
+// the IBM_SERVICE_CREDENTIALS environment variable
+func loadServiceCredentialsEnv() (*ServiceCredentials, error) {
+	data := os.Getenv(ServiceCredentialsEnvVar)
+	if data == "" {
+		return nil, awserr.New(ErrCodeServiceCredentialsLoad,
+			ServiceCredentialsEnvVar+" environment variable not set", nil)
+	}
+	return ParseServiceCredentials([]byte(data))
+}
+
+// newServiceCredentialsProvider constructor of the IBM IAM provider using the API Key
+// and the resource instance ID of the service credentials
+func newServiceCredentialsProvider(config *aws.Config, authEndPoint string, creds *ServiceCredentials,
+	err error) *Provider {
+	if err != nil {
+		logFromConfigHelper(config, "<DEBUG>", "<IBM IAM PROVIDER BUILD>", ServiceCredentialsProviderName, err)
+		return &Provider{
+			providerName: ServiceCredentialsProviderName,
+			ErrorStatus:  err,
+		}
+	}
+	return NewProvider(ServiceCredentialsProviderName, config, creds.APIKey, authEndPoint, creds.ResourceInstanceID,
+		nil)
+}
+
+// NewServiceCredentialsProvider constructor of the IBM IAM provider that loads IAM credentials
+// from IBM COS service credentials JSON
+// Parameters:
+//
+//	AWS Config
+//	IBM IAM Authentication Server Endpoint
+//	Service credentials JSON
+//
+// Returns:
+//
+//	A new provider with the API Key and the Service Instance ID of the service credentials
+func NewServiceCredentialsProvider(config *aws.Config, authEndPoint string, data []byte) *Provider {
+	creds, err := ParseServiceCredentials(data)
+	return newServiceCredentialsProvider(config, authEndPoint, creds, err)
+}
+
+// NewServiceCredentialsFileProvider constructor of the IBM IAM provider that loads IAM credentials
+// from an IBM COS service credentials JSON file
+// Parameters:
+//
+//	AWS Config
+//	IBM IAM Authentication Server Endpoint
+//	Service credentials filename
+//
+// Returns:
+//
+//	A new provider with the API Key and the Service Instance ID of the service credentials
+func NewServiceCredentialsFileProvider(config *aws.Config, authEndPoint, filename string) *Provider {
+	creds, err := LoadServiceCredentialsFile(filename)
+	return newServiceCredentialsProvider(config, authEndPoint, creds, err)
+}
+
+// NewServiceCredentialsEnvProvider constructor of the IBM IAM provider that loads IAM credentials
+// from the IBM COS service credentials JSON held by the IBM_SERVICE_CREDENTIALS environment variable
+// Parameters:
+//
+//	AWS Config
+//
+// Returns:
+//
+//	A new provider with the API Key and the Service Instance ID of the service credentials, and the
+//	IBM IAM Authentication Server Endpoint of the IBM_AUTH_ENDPOINT environment variable
+func NewServiceCredentialsEnvProvider(config *aws.Config) *Provider {
+	creds, err := loadServiceCredentialsEnv()
+	return newServiceCredentialsProvider(config, os.Getenv("IBM_AUTH_ENDPOINT"), creds, err)
+}
+
+// NewServiceCredentialsCredentials Constructor
+func NewServiceCredentialsCredentials(config *aws.Config, authEndPoint string, data []byte) *credentials.Credentials {
+	return credentials.NewCredentials(NewServiceCredentialsProvider(config, authEndPoint, data))
+}
+
+// NewServiceCredentialsFileCredentials Constructor
+func NewServiceCredentialsFileCredentials(config *aws.Config, authEndPoint, filename string) *credentials.Credentials {
+	return credentials.NewCredentials(NewServiceCredentialsFileProvider(config, authEndPoint, filename))
+}
+
+// NewServiceCredentialsEnvCredentials Constructor
+func NewServiceCredentialsEnvCredentials(config *aws.Config) *credentials.Credentials {
+	return credentials.NewCredentials(NewServiceCredentialsEnvProvider(config))
+}
+
+// ServiceCredentialsHMACProvider provides the HMAC keys of IBM COS service credentials,
+// requests using them are signed with the V4 signer
+type ServiceCredentialsHMACProvider struct {
+	// Credentials Value with the HMAC keys
+	value credentials.Value
+
+	// Error
+	ErrorStatus error
+}
+
+// newServiceCredentialsHMACProvider constructor of the HMAC provider using the HMAC keys
+// and the resource instance ID of the service credentials
+func newServiceCredentialsHMACProvider(creds *ServiceCredentials, err error) *ServiceCredentialsHMACProvider {
+	provider := &ServiceCredentialsHMACProvider{}
+	switch {
+	case err != nil:
+		provider.ErrorStatus = err
+	case creds.HMACKeys == nil || creds.HMACKeys.AccessKeyID == "" || creds.HMACKeys.SecretAccessKey == "":
+		provider.ErrorStatus = awserr.New("ServiceCredentialsHMACKeysNotFound",
+			"HMAC keys not found in service credentials", nil)
+	default:
+		provider.value = credentials.Value{
+			AccessKeyID:       creds.HMACKeys.AccessKeyID,
+			SecretAccessKey:   creds.HMACKeys.SecretAccessKey,
+			ServiceInstanceID: creds.ResourceInstanceID,
+			ProviderName:      ServiceCredentialsHMACProviderName,
+		}
+	}
+	return provider
+}
+
+// NewServiceCredentialsHMACProvider constructor of the HMAC provider that loads HMAC keys
+// from IBM COS service credentials JSON
+// Parameters:
+//
+//	Service credentials JSON
+//
+// Returns:
+//
+//	A new provider with the HMAC keys and the Service Instance ID of the service credentials
+func NewServiceCredentialsHMACProvider(data []byte) *ServiceCredentialsHMACProvider {
+	return newServiceCredentialsHMACProvider(ParseServiceCredentials(data))
+}
+
+// NewServiceCredentialsFileHMACProvider constructor of the HMAC provider that loads HMAC keys
+// from an IBM COS service credentials JSON file
+// Parameters:
+//
+//	Service credentials filename
+//
+// Returns:
+//
+//	A new provider with the HMAC keys and the Service Instance ID of the service credentials
+func NewServiceCredentialsFileHMACProvider(filename string) *ServiceCredentialsHMACProvider {
+	return newServiceCredentialsHMACProvider(LoadServiceCredentialsFile(filename))
+}
+
+// NewServiceCredentialsEnvHMACProvider constructor of the HMAC provider that loads HMAC keys
+// from the IBM COS service credentials JSON held by the IBM_SERVICE_CREDENTIALS environment variable
+// Returns:
+//
+//	A new provider with the HMAC keys and the Service Instance ID of the service credentials
+func NewServiceCredentialsEnvHMACProvider() *ServiceCredentialsHMACProvider {
+	return newServiceCredentialsHMACProvider(loadServiceCredentialsEnv())
+}
+
+// NewServiceCredentialsHMACCredentials Constructor
+func NewServiceCredentialsHMACCredentials(data []byte) *credentials.Credentials {
+	return credentials.NewCredentials(NewServiceCredentialsHMACProvider(data))
+}
+
+// NewServiceCredentialsFileHMACCredentials Constructor
+func NewServiceCredentialsFileHMACCredentials(filename string) *credentials.Credentials {
+	return credentials.NewCredentials(NewServiceCredentialsFileHMACProvider(filename))
+}
+
+// NewServiceCredentialsEnvHMACCredentials Constructor
+func NewServiceCredentialsEnvHMACCredentials() *credentials.Credentials {
+	return credentials.NewCredentials(NewServiceCredentialsEnvHMACProvider())
+}
+
+// IsValid ...
+// Returns:
+//
+//	Provider validation - boolean
+func (p *ServiceCredentialsHMACProvider) IsValid() bool {
+	return nil == p.ErrorStatus
+}
+
+// Retrieve ...
+// Returns:
+//
+//	Credential values
+//	Error
+func (p *ServiceCredentialsHMACProvider) Retrieve() (credentials.Value, error) {
+	if p.ErrorStatus != nil {
+		return credentials.Value{ProviderName: ServiceCredentialsHMACProviderName}, p.ErrorStatus
+	}
+	return p.value, nil
+}
+
+// IsExpired ...
+//
+//	Provider expired or not - boolean, HMAC keys never expire
+func (p *ServiceCredentialsHMACProvider) IsExpired() bool {
+	return false
+}
@@ -0,0 +1,120 @@
+package ibmiam
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/tokenmanager"
+	"github.com/stretchr/testify/assert"
+)
+
+// Service credentials JSON as downloaded from the IBM Cloud console, with
+// API Key, HMAC keys and resource instance ID
+var serviceCredentialsContent = `{
+  "apikey": "%[1]s",
+  "cos_hmac_keys": {
+    "access_key_id": "hmacAKID",
+    "secret_access_key": "hmacSECRET"
+  },
+  "endpoints": "https://control.cloud-object-storage.cloud.ibm.com/v2/endpoints",
+  "iam_apikey_description": "Auto-generated for key",
+  "iam_apikey_name": "cos-credentials",
+  "iam_role_crn": "crn:v1:bluemix:public:iam::::serviceRole:Writer",
+  "iam_serviceid_crn": "crn:v1:bluemix:public:iam-identity::a/acc1::serviceid:ServiceId-1234",
+  "resource_instance_id": "%[2]s"
+}`
+
+// Test IBM IAM and HMAC providers with in-memory service credentials
+func TestServiceCredentials(t *testing.T) {
+	data := []byte(fmt.Sprintf(serviceCredentialsContent, apikey, serviceinstanceid))
+
+	realNTM := tokenmanager.NewTokenManagerFromAPIKey
+	tokenmanager.NewTokenManagerFromAPIKey = newTMMock
+	prov := NewServiceCredentialsProvider(&aws.Config{}, authendpoint, data)
+	tokenmanager.NewTokenManagerFromAPIKey = realNTM
+
+	tk, _ := prov.Retrieve()
+
+	assert.Equal(t, apikey, prov.tokenManager.(*tokenManagerMock).apikey, "e1")
+	assert.Equal(t, authendpoint, prov.tokenManager.(*tokenManagerMock).authendpoint, "e2")
+	assert.Equal(t, ServiceCredentialsProviderName, tk.ProviderName, "e3")
+	assert.Equal(t, serviceinstanceid, tk.ServiceInstanceID, "e4")
+
+	hmacProv := NewServiceCredentialsHMACProvider(data)
+	assert.True(t, hmacProv.IsValid(), "e5")
+	value, err := hmacProv.Retrieve()
+	assert.Nil(t, err, "e6")
+	assert.Equal(t, "hmacAKID", value.AccessKeyID, "e7")
+	assert.Equal(t, "hmacSECRET", value.SecretAccessKey, "e8")
+	assert.Equal(t, serviceinstanceid, value.ServiceInstanceID, "e9")
+	assert.Equal(t, "", value.ProviderType, "e10")
+}
+
+// Test IBM IAM and HMAC providers with a service credentials file
+func TestServiceCredentialsFile(t *testing.T) {
+	f, e := ioutil.TempFile("", "")
+	if e != nil {
+		t.Fatal(e)
+	}
+	defer os.Remove(f.Name())
+
+	f.WriteString(fmt.Sprintf(serviceCredentialsContent, apikey, serviceinstanceid))
+	name := f.Name()
+	f.Close()
+
+	realNTM := tokenmanager.NewTokenManagerFromAPIKey
+	tokenmanager.NewTokenManagerFromAPIKey = newTMMock
+	prov := NewServiceCredentialsFileProvider(&aws.Config{}, authendpoint, name)
+	tokenmanager.NewTokenManagerFromAPIKey = realNTM
+
+	tk, _ := prov.Retrieve()
+
+	assert.Equal(t, apikey, prov.tokenManager.(*tokenManagerMock).apikey, "e1")
+	assert.Equal(t, serviceinstanceid, tk.ServiceInstanceID, "e2")
+
+	value, err := NewServiceCredentialsFileHMACCredentials(name).Get()
+	assert.Nil(t, err, "e3")
+	assert.Equal(t, "hmacAKID", value.AccessKeyID, "e4")
+	assert.Equal(t, ServiceCredentialsHMACProviderName, value.ProviderName, "e5")
+}
+
+// Test IBM IAM and HMAC providers with the IBM_SERVICE_CREDENTIALS environment variable
+func TestServiceCredentialsEnv(t *testing.T) {
+	os.Setenv(ServiceCredentialsEnvVar, fmt.Sprintf(serviceCredentialsContent, apikey, serviceinstanceid))
+	os.Setenv("IBM_AUTH_ENDPOINT", authendpoint)
+	defer os.Unsetenv(ServiceCredentialsEnvVar)
+
+	realNTM := tokenmanager.NewTokenManagerFromAPIKey
+	tokenmanager.NewTokenManagerFromAPIKey = newTMMock
+	prov := NewServiceCredentialsEnvProvider(&aws.Config{})
+	tokenmanager.NewTokenManagerFromAPIKey = realNTM
+
+	tk, _ := prov.Retrieve()
+
+	assert.Equal(t, apikey, prov.tokenManager.(*tokenManagerMock).apikey, "e1")
+	assert.Equal(t, authendpoint, prov.tokenManager.(*tokenManagerMock).authendpoint, "e2")
+	assert.Equal(t, serviceinstanceid, tk.ServiceInstanceID, "e3")
+
+	value, err := NewServiceCredentialsEnvHMACProvider().Retrieve()
+	assert.Nil(t, err, "e4")
+	assert.Equal(t, "hmacSECRET", value.SecretAccessKey, "e5")
+}
+
+// Test providers with invalid or incomplete service credentials
+func TestServiceCredentialsInvalid(t *testing.T) {
+	prov := NewServiceCredentialsProvider(&aws.Config{}, authendpoint, []byte("not json"))
+	assert.False(t, prov.IsValid(), "e1")
+	assert.Equal(t, ErrCodeServiceCredentialsLoad, prov.ErrorStatus.(awserr.Error).Code(), "e2")
+
+	prov = NewServiceCredentialsFileProvider(&aws.Config{}, authendpoint, "/nonexistent/credentials.json")
+	assert.False(t, prov.IsValid(), "e3")
+
+	hmacProv := NewServiceCredentialsHMACProvider([]byte(`{"apikey":"ak","resource_instance_id":"sii"}`))
+	assert.False(t, hmacProv.IsValid(), "e4")
+	_, err := hmacProv.Retrieve()
+	assert.NotNil(t, err, "e5")
+}
@@ -0,0 +1,89 @@
+package token
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+)
+
+// ErrCodeInvalidAccessToken is the error code returned when the access token
+// is not a JWT whose claims can be decoded
+const ErrCodeInvalidAccessToken = "InvalidAccessToken"
+
+// Claims holds the identity claims carried by an IBM IAM access token
+type Claims struct {
+	// IAM ID of the identity the token was issued to
+	IAMID string `json:"iam_id"`
+
+	// Unique ID of the identity
+	ID string `json:"id"`
+
+	// Subject of the token, e.g. the user name or service ID name
+	Sub string `json:"sub"`
+
+	// Type of the subject, e.g. ServiceId, Profile
+	SubType string `json:"sub_type"`
+
+	// Account the token was issued in
+	Account AccountClaims `json:"account"`
+
+	// Grant type used to obtain the token
+	GrantType string `json:"grant_type"`
+
+	// Issuer of the token
+	Issuer string `json:"iss"`
+
+	// Issued at, seconds since the Unix epoch
+	IssuedAt int64 `json:"iat"`
+
+	// Expiration, seconds since the Unix epoch
+	ExpiresAt int64 `json:"exp"`
+}
+
+// AccountClaims holds the account claims of an IBM IAM access token
+type AccountClaims struct {
+	// Billing account ID
+	BSS string `json:"bss"`
+
+	// Whether the account is valid
+	Valid bool `json:"valid"`
+}
+
+// IssuedAtTime returns the time the token was issued at
+func (c Claims) IssuedAtTime() time.Time {
+	return time.Unix(c.IssuedAt, 0)
+}
+
+// ExpiresAtTime returns the time the token expires at
+func (c Claims) ExpiresAtTime() time.Time {
+	return time.Unix(c.ExpiresAt, 0)
+}
+
+// Claims decodes the claims of the access token. The token signature is not
+// verified, the claims are only meant for diagnostics.
+func (t Token) Claims() (*Claims, error) {
+	return ParseClaims(t.AccessToken)
+}
+
+// ParseClaims decodes the claims of an IBM IAM access token. The token
+// signature is not verified, the claims are only meant for diagnostics.
+func ParseClaims(accessToken string) (*Claims, error) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return nil, awserr.New(ErrCodeInvalidAccessToken, "access token is not a JWT", nil)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, awserr.New(ErrCodeInvalidAccessToken, "unable to decode access token claims", err)
+	}
+
+	claims := Claims{}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return nil, awserr.New(ErrCodeInvalidAccessToken, "unable to unmarshal access token claims", err)
+	}
+	return &claims, nil
+}
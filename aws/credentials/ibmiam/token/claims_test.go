@@ -0,0 +1,62 @@
+package token
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+)
+
+func buildAccessToken(payload string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"RS256"}`)) + "." +
+		enc.EncodeToString([]byte(payload)) + "." + enc.EncodeToString([]byte("signature"))
+}
+
+func TestParseClaims(t *testing.T) {
+	accessToken := buildAccessToken(`{"iam_id":"iam-ServiceId-1234","id":"ServiceId-1234",` +
+		`"sub":"ServiceId-1234","sub_type":"ServiceId","account":{"bss":"acc1","valid":true},` +
+		`"grant_type":"urn:ibm:params:oauth:grant-type:apikey","iss":"https://iam.cloud.ibm.com/identity",` +
+		`"iat":1700000000,"exp":1700003600}`)
+
+	claims, err := Token{AccessToken: accessToken}.Claims()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "iam-ServiceId-1234", claims.IAMID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "ServiceId", claims.SubType; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "acc1", claims.Account.BSS; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := time.Unix(1700003600, 0), claims.ExpiresAtTime(); !e.Equal(a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := time.Hour, claims.ExpiresAtTime().Sub(claims.IssuedAtTime()); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestParseClaimsInvalid(t *testing.T) {
+	cases := []string{
+		"",
+		"opaque-token",
+		"a.%%%.c",
+		buildAccessToken(`not json`),
+	}
+
+	for i, c := range cases {
+		_, err := ParseClaims(c)
+		if err == nil {
+			t.Fatalf("%d, expect error", i)
+		}
+		if e, a := ErrCodeInvalidAccessToken, err.(awserr.Error).Code(); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+}
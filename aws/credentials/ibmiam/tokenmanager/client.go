@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
 )
 
 var (
@@ -112,7 +114,8 @@ func defaultIBMClient(config *aws.Config) *defaultIBMCImplementation {
 	return newIBMClient(config, 500*time.Millisecond, f)
 }
 
-// Internal IBM Client HTTP Client request execution
+// Internal IBM Client HTTP Client request execution,
+// the request context cancels the request and the backoff between retries
 // Parameter:
 //
 //	An HTTP Request Object
@@ -148,7 +151,12 @@ func (c *defaultIBMCImplementation) Do(req *http.Request) (r *http.Response, e e
 		if c.logLevel.Matches(aws.LogDebugWithRequestRetries) {
 			c.logger.Log(debugLog, defaultIBMCImpLog, req.Method, req.URL, "Retry:", i+1)
 		}
-		time.Sleep(sleep)
+		if err := aws.SleepWithContext(req.Context(), sleep); err != nil {
+			if r != nil {
+				r.Body.Close()
+			}
+			return nil, awserr.New(request.CanceledErrorCode, "request context canceled", err)
+		}
 		req = copyRequest(req)
 		r, e = c.Client.Do(req)
 		if e == nil && isSuccess(r) {
@@ -166,7 +174,7 @@ func (c *defaultIBMCImplementation) Do(req *http.Request) (r *http.Response, e e
 	return
 }
 
-// only copies method, url, body , headers
+// only copies method, url, body , headers and context
 // tight coupled to the token manager and the way request is build
 // Paramter:
 //
@@ -178,7 +186,7 @@ func (c *defaultIBMCImplementation) Do(req *http.Request) (r *http.Response, e e
 func copyRequest(r *http.Request) *http.Request {
 	buf, _ := ioutil.ReadAll(r.Body)
 	newReader := ioutil.NopCloser(bytes.NewBuffer(buf))
-	req, _ := http.NewRequest(r.Method, r.URL.String(), newReader)
+	req, _ := http.NewRequestWithContext(r.Context(), r.Method, r.URL.String(), newReader)
 	for k, lv := range r.Header {
 		for _, v := range lv {
 			req.Header.Add(k, v)
@@ -1,6 +1,7 @@
 package tokenmanager
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"net/http"
@@ -10,6 +11,8 @@ import (
 	"time"
 
 	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -306,6 +309,38 @@ func TestClientDo_WhenDoCalled_OnErrorServerCalledRetriesTimes(t *testing.T) {
 	assert.Equal(t, retries+1, len(requestLogger), badNumberOfRetries)
 }
 
+func TestClientDo_WhenContextCanceled_BackOffInterrupted(t *testing.T) {
+	// Set variables for the test
+	retries := 3
+	requestLogger := make([]*http.Request, 0)
+
+	// Build a local test server
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestLogger = append(requestLogger, r)
+		w.WriteHeader(500)
+	}))
+	defer ts.Close()
+
+	// Build a client with a backoff longer than the test
+	client := NewIBMClient(buildConfig(retries, aws.LogOff, nil), time.Hour, nil)
+
+	// Build a request with a context canceled after the first call
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req := buildTestRequest(t, ts.URL).WithContext(ctx)
+
+	// Submit request
+	start := time.Now()
+	rsp, err := client.Do(req)
+
+	// Assertions
+	require.NotNil(t, err, "Error Expected")
+	assert.Nil(t, rsp, "Unexpected Response")
+	assert.Equal(t, request.CanceledErrorCode, err.(awserr.Error).Code(), "Error Code did not match")
+	assert.True(t, time.Since(start) < time.Minute, "BackOff not interrupted")
+	assert.Equal(t, 1, len(requestLogger), badNumberOfRetries)
+}
+
 // Test Logger
 type tstLogger struct {
 	loggerLogger []string
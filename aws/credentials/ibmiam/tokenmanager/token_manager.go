@@ -76,7 +76,7 @@ type defaultTMImplementation struct {
 	// read write mutex to sync access
 	mutex sync.RWMutex
 	// function used to retrieve initial token
-	initFunc func() (*token.Token, error)
+	initFunc func(ctx aws.Context) (*token.Token, error)
 
 	// logger where the logging is sent
 	logger aws.Logger
@@ -93,21 +93,22 @@ func newTokenManagerFromAPIKey(config *aws.Config, apiKey, authEndPoint string,
 		client = defaultIBMClient(config)
 	}
 
-	// set the function to get the initial token the defaultInit that uses the APIKey passed as argument
-	initFunc := defaultInit(apiKey, authEndPoint, client)
-	return newTokenManager(config, initFunc, authEndPoint, advisoryRefreshTimeout, mandatoryRefreshTimeout, timeFunc,
+	tm := newTokenManager(config, nil, authEndPoint, advisoryRefreshTimeout, mandatoryRefreshTimeout, timeFunc,
 		client)
+	// set the function to get the initial token the defaultInit that uses the APIKey passed as argument
+	tm.initFunc = defaultInit(apiKey, authEndPoint, client)
+	return tm
 }
 
 // default init function,
 // uses the APIKey passed as argument to obtain the first token
-func defaultInit(apiKey string, authEndPoint string, client IBMClientDo) func() (*token.Token, error) {
-	return func() (*token.Token, error) {
+func defaultInit(apiKey string, authEndPoint string, client IBMClientDo) func(aws.Context) (*token.Token, error) {
+	return func(ctx aws.Context) (*token.Token, error) {
 		data := url.Values{
 			"apikey": {apiKey},
 		}
 		// build the http request
-		req, err := buildRequest(authEndPoint, grantAPIKey, data)
+		req, err := buildRequest(ctx, authEndPoint, grantAPIKey, data)
 		// checks for errors
 		if err != nil {
 			return nil, ErrFetchingIAMTokenFn(err)
@@ -164,22 +165,27 @@ func newTokenManager(config *aws.Config, initFunc func() (*token.Token, error),
 		advisoryRefreshTimeout:  advisoryRefreshTimeout,
 		mandatoryRefreshTimeout: mandatoryRefreshTimeout,
 		timeProvider:            timeFunc,
-		initFunc:                initFunc,
 
 		logLevel: logLevel,
 		logger:   config.Logger,
 	}
+	// custom init functions do not take a context
+	if initFunc != nil {
+		tm.initFunc = func(aws.Context) (*token.Token, error) {
+			return initFunc()
+		}
+	}
 	return tm
 }
 
 // function to obtain to initialize the token manager in a concurrent safe way
-func (tm *defaultTMImplementation) init() (*token.Token, error) {
+func (tm *defaultTMImplementation) init(ctx aws.Context) (*token.Token, error) {
 	// checks logLevel and logs
 	if tm.logLevel.Matches(aws.LogDebug) {
 		tm.logger.Log(debugLog, defaultTMImpLog, "INIT")
 	}
 	// fetches the initial vale using the init function
-	tokenValue, err := tm.initFunc()
+	tokenValue, err := tm.initFunc(ctx)
 	if err != nil {
 		// checks logLevel and logs
 		if tm.logLevel.Matches(aws.LogDebug) {
@@ -206,7 +212,7 @@ func (tm *defaultTMImplementation) init() (*token.Token, error) {
 }
 
 // function to call the init operation in a concurrent safe way, managing the RWLock
-func retrieveInit(tm *defaultTMImplementation) (unlockOP func(), tk *token.Token, err error) {
+func retrieveInit(ctx aws.Context, tm *defaultTMImplementation) (unlockOP func(), tk *token.Token, err error) {
 	// escalate the READ lock to a WRITE lock
 	now := time.Now()
 	tm.mutex.RUnlock()
@@ -223,7 +229,7 @@ func retrieveInit(tm *defaultTMImplementation) (unlockOP func(), tk *token.Token
 	// since another routine could be scheduled between the release of Read mutex and the acquire of Write mutex
 	// re-check the init is still required
 	if tm.Cache == nil {
-		tk, err = tm.init()
+		tk, err = tm.init(ctx)
 	} else {
 		tk = retrieveCheckGet(tm)
 	}
@@ -231,7 +237,7 @@ func retrieveInit(tm *defaultTMImplementation) (unlockOP func(), tk *token.Token
 }
 
 // function to call the refresh operation in a concurrent safe way, managing the RWLock
-func retrieveFetch(tm *defaultTMImplementation) (unlockOP func(), tk *token.Token, err error) {
+func retrieveFetch(ctx aws.Context, tm *defaultTMImplementation) (unlockOP func(), tk *token.Token, err error) {
 
 	// escalate the READ lock to a WRITE lock
 	now := time.Now()
@@ -250,7 +256,7 @@ func retrieveFetch(tm *defaultTMImplementation) (unlockOP func(), tk *token.Toke
 	// re-check the refresh is still required
 	tk = retrieveCheckGet(tm)
 	for tk == nil {
-		err := tm.refresh()
+		err := tm.refresh(ctx)
 		if err != nil {
 			// checks logLevel and logs
 			if tm.logLevel.Matches(aws.LogDebug) {
@@ -279,6 +285,12 @@ func retrieveCheckGet(tm *defaultTMImplementation) (tk *token.Token) {
 // Get retrieves the value of the auth token, checks the cache if the token is valid returns it,
 // if not valid does a refresh and then returns it
 func (tm *defaultTMImplementation) Get() (tk *token.Token, err error) {
+	return tm.GetWithContext(aws.BackgroundContext())
+}
+
+// GetWithContext retrieves the value of the auth token like Get, the context cancels or sets the
+// deadline of the calls to the IAM endpoint when the token needs to be fetched or refreshed
+func (tm *defaultTMImplementation) GetWithContext(ctx aws.Context) (tk *token.Token, err error) {
 
 	// holder for the func to be called in the defer
 	var unlockOP func()
@@ -302,7 +314,7 @@ func (tm *defaultTMImplementation) Get() (tk *token.Token, err error) {
 	//check if cache was initialized
 	if tm.Cache == nil {
 		// if cache not initialized, initialize it
-		unlockOP, tk, err = retrieveInit(tm)
+		unlockOP, tk, err = retrieveInit(ctx, tm)
 		return
 	}
 
@@ -312,14 +324,14 @@ func (tm *defaultTMImplementation) Get() (tk *token.Token, err error) {
 	if tk == nil {
 		// content of the cache invalid
 		// refresh cache content
-		unlockOP, tk, err = retrieveFetch(tm)
+		unlockOP, tk, err = retrieveFetch(ctx, tm)
 	}
 
 	return
 }
 
 // function to do the refresh operation calls
-func (tm *defaultTMImplementation) refresh() error {
+func (tm *defaultTMImplementation) refresh(ctx aws.Context) error {
 	// stop the timer
 	tm.stopTimer()
 	// defer timer reset
@@ -329,7 +341,7 @@ func (tm *defaultTMImplementation) refresh() error {
 		"refresh_token": {tm.Cache.RefreshToken},
 	}
 	// build the request
-	req, err := buildRequest(tm.authEndPoint, grantRefreshToken, data)
+	req, err := buildRequest(ctx, tm.authEndPoint, grantRefreshToken, data)
 	if err != nil {
 		return ErrFetchingIAMTokenFn(err)
 	}
@@ -346,7 +358,7 @@ func (tm *defaultTMImplementation) refresh() error {
 			if tm.logLevel.Matches(aws.LogDebug) {
 				tm.logger.Log(debugLog, defaultTMImpLog, "REFRESH TOKEN INVALID. NEW TOKEN INITIALIZED", err, response.Header["Transaction-Id"], response.Body)
 			}
-			tm.init()
+			tm.init(ctx)
 			return nil
 		} else {
 			if tm.logLevel.Matches(aws.LogDebug) {
@@ -364,6 +376,12 @@ func (tm *defaultTMImplementation) refresh() error {
 
 // Refresh forces the refresh of the token in the cache in a concurrent safe way
 func (tm *defaultTMImplementation) Refresh() error {
+	return tm.RefreshWithContext(aws.BackgroundContext())
+}
+
+// RefreshWithContext forces the refresh of the token like Refresh, the context cancels or sets
+// the deadline of the calls to the IAM endpoint
+func (tm *defaultTMImplementation) RefreshWithContext(ctx aws.Context) error {
 	// acquire a Write lock
 	tm.mutex.Lock()
 	// defer the release of the write lock
@@ -372,7 +390,38 @@ func (tm *defaultTMImplementation) Refresh() error {
 	if tm.logLevel.Matches(aws.LogDebug) {
 		tm.logger.Log(debugLog, defaultTMImpLog, "MANUAL TRIGGER BACKGROUND REFRESH")
 	}
-	return tm.refresh()
+	return tm.refresh(ctx)
+}
+
+// RefreshSchedule reports the expiration of the cached token and when it will be refreshed
+func (tm *defaultTMImplementation) RefreshSchedule() (schedule RefreshSchedule) {
+	// acquire Read lock
+	tm.mutex.RLock()
+	// defer the release of the read lock
+	defer tm.mutex.RUnlock()
+
+	schedule.BackgroundRefreshEnabled = tm.enableBackgroundRefresh != nil && *tm.enableBackgroundRefresh
+	if tm.Cache == nil || tm.Cache.Expiration == 0 {
+		return
+	}
+
+	now := tm.timeProvider()
+	schedule.Expiration = time.Unix(tm.Cache.Expiration, 0)
+	schedule.TTL = tm.tokenTTL
+	if wait := waitingTime(tm.tokenTTL, tm.Cache.Expiration, nil, tm.mandatoryRefreshTimeout,
+		tm.timeProvider); wait != nil {
+		schedule.MandatoryRefreshAt = now.Add(*wait)
+	}
+	if schedule.BackgroundRefreshEnabled && tm.timer != nil {
+		if wait := waitingTime(tm.tokenTTL, tm.Cache.Expiration, tm.advisoryRefreshTimeout,
+			tm.mandatoryRefreshTimeout, tm.timeProvider); wait != nil {
+			if minimumWait > *wait {
+				*wait = minimumWait
+			}
+			schedule.AdvisoryRefreshAt = now.Add(*wait)
+		}
+	}
+	return
 }
 
 // callback function used by to timer to refresh tokens in background
@@ -399,7 +448,7 @@ func (tm *defaultTMImplementation) backgroundRefreshFunc() {
 		if tm.logLevel.Matches(aws.LogDebug) {
 			tm.logger.Log(debugLog, defaultTMImpLog, backgroundRefreshLog, "TOKEN NEED UPDATE")
 		}
-		tm.refresh()
+		tm.refresh(aws.BackgroundContext())
 	} else {
 		// checks logLevel and logs
 		if tm.logLevel.Matches(aws.LogDebug) {
@@ -473,7 +522,7 @@ func (tm *defaultTMImplementation) resetTimer() {
 }
 
 // helper function used to build the http request used to retrieve initial and refresh tokens
-func buildRequest(endPoint string, grantType string, customValues url.Values) (*http.Request, error) {
+func buildRequest(ctx aws.Context, endPoint string, grantType string, customValues url.Values) (*http.Request, error) {
 	data := url.Values{
 		"grant_type":    {grantType},
 		"response_type": {"cloud_iam"},
@@ -481,7 +530,7 @@ func buildRequest(endPoint string, grantType string, customValues url.Values) (*
 	for key, value := range customValues {
 		data[key] = value
 	}
-	req, err := http.NewRequest(http.MethodPost, endPoint, strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endPoint, strings.NewReader(data.Encode()))
 	if err != nil {
 		return nil, err
 	}
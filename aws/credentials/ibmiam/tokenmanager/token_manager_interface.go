@@ -23,6 +23,45 @@ type API interface {
 	StartBackgroundRefresh()
 }
 
+// APIWithContext Token Manager interface with context-aware operations,
+// the context cancels or sets the deadline of the calls to the IAM endpoint
+// and of the backoff between their retries
+type APIWithContext interface {
+	API
+
+	// Token Management Get Function with a context
+	GetWithContext(ctx aws.Context) (*token.Token, error)
+
+	// Token Management Refresh Function with a context
+	RefreshWithContext(ctx aws.Context) error
+}
+
+// RefreshSchedule reports when the cached token expires and when the token
+// manager will refresh it
+type RefreshSchedule struct {
+	// Expiration of the cached token, zero when no token was retrieved yet
+	Expiration time.Time
+
+	// Time to live of the cached token at the moment it was retrieved
+	TTL time.Duration
+
+	// Whether the background refresh is enabled
+	BackgroundRefreshEnabled bool
+
+	// Time the background refresh is scheduled at, zero when not scheduled
+	AdvisoryRefreshAt time.Time
+
+	// Time after which Get blocks to refresh the token
+	MandatoryRefreshAt time.Time
+}
+
+// RefreshScheduler is implemented by token managers able to report their
+// refresh schedule
+type RefreshScheduler interface {
+	// Token Management Refresh Schedule Function
+	RefreshSchedule() RefreshSchedule
+}
+
 // default implementations
 // wrap implementation in the interface
 var (
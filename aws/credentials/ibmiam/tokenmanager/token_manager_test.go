@@ -2,6 +2,7 @@ package tokenmanager
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -262,3 +264,97 @@ func TestNewTokenManagerCustomFuncInitialGetError(t *testing.T) {
 	require.NotNil(t, e, errorGettingToken)
 	assert.Equal(t, &tokenError, e, "error message not match")
 }
+
+// Tests the Refresh Schedule reported by the Token Manager
+func TestTokenManagerRefreshSchedule(t *testing.T) {
+
+	// Sets vars for the test
+	config := &aws.Config{}
+	now := time.Unix(1700000000, 0)
+	timeFunc := func() time.Time { return now }
+	advised := func(_ time.Duration) time.Duration { return time.Duration(15) * time.Minute }
+	mandatory := func(_ time.Duration) time.Duration { return time.Duration(10) * time.Minute }
+
+	// Create a mock token expiring in one hour
+	tokenValue := token.Token{
+		AccessToken:  "A",
+		RefreshToken: "R",
+		TokenType:    "T",
+		Expiration:   now.Add(time.Hour).Unix(),
+	}
+	customFunc := func() (*token.Token, error) {
+		return &tokenValue, nil
+	}
+
+	// Mock Token Manager
+	tm := newTokenManager(config, customFunc, endPoint, advised, mandatory, timeFunc, nil)
+	defer tm.StopBackgroundRefresh()
+
+	// Expectations before the first Get
+	// - No Expiration
+	// - Background refresh not enabled
+	schedule := tm.RefreshSchedule()
+	assert.True(t, schedule.Expiration.IsZero(), "Expiration set before Get")
+	assert.False(t, schedule.BackgroundRefreshEnabled, "Background Refresh enabled before Get")
+
+	_, e := tm.Get()
+	require.Nil(t, e, errorGettingToken)
+
+	// Expectations after the first Get
+	// - Expiration and TTL match the token
+	// - Advisory and Mandatory refresh before the expiration by their timeouts
+	schedule = tm.RefreshSchedule()
+	assert.Equal(t, now.Add(time.Hour), schedule.Expiration, "Expiration did not match")
+	assert.Equal(t, time.Hour, schedule.TTL, "TTL did not match")
+	assert.True(t, schedule.BackgroundRefreshEnabled, "Background Refresh not enabled")
+	assert.Equal(t, now.Add(45*time.Minute), schedule.AdvisoryRefreshAt, "Advisory Refresh did not match")
+	assert.Equal(t, now.Add(50*time.Minute), schedule.MandatoryRefreshAt, "Mandatory Refresh did not match")
+
+	// Expectations after stopping the background refresh
+	tm.StopBackgroundRefresh()
+	schedule = tm.RefreshSchedule()
+	assert.False(t, schedule.BackgroundRefreshEnabled, "Background Refresh enabled after Stop")
+	assert.True(t, schedule.AdvisoryRefreshAt.IsZero(), "Advisory Refresh set after Stop")
+}
+
+// Tests the context passed to GetWithContext is used to fetch the token
+func TestTokenManagerGetWithContext(t *testing.T) {
+
+	// Sets vars for the test
+	config := &aws.Config{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Sets the Request Handler, failing like the http client when the context is canceled
+	handler := func(req *http.Request) (*http.Response, error) {
+		if err := req.Context().Err(); err != nil {
+			return nil, err
+		}
+		rsp := new(http.Response)
+		rsp.StatusCode = 200
+		rsp.Body = ioutil.NopCloser(bytes.NewReader([]byte(`{"access_token":"A"}`)))
+		return rsp, nil
+	}
+
+	// Mock IBM Client
+	icm := &ibmclientMock{
+		requestLogs: make([]*http.Request, 0),
+		handler:     handler,
+	}
+
+	// Mock Token Manager
+	tm := newTokenManagerFromAPIKey(config, "UNIK-APIKEY", endPoint, nil, nil, time.Now, icm)
+	defer tm.StopBackgroundRefresh()
+
+	// Expectations
+	// - Canceled context fails the token fetch
+	// - Background context fetches the token
+	_, e := tm.GetWithContext(ctx)
+	require.NotNil(t, e, "Error Expected")
+	assert.Equal(t, context.Canceled, e.(awserr.Error).OrigErr(), "Error did not match")
+
+	tk, e := tm.GetWithContext(context.Background())
+	require.Nil(t, e, errorGettingToken)
+	assert.Equal(t, "A", tk.AccessToken, tokensNotMatch)
+	assert.Equal(t, 2, len(icm.requestLogs), "Bad Request Count")
+}
@@ -15,6 +15,10 @@ const (
 	ResourceServiceID          ResourceType = "SID"
 )
 
+// SharedConfigTrustedProfileProviderName name of the IBM IAM provider that uses IAM trusted-profile
+// details loaded from a shared config profile
+const SharedConfigTrustedProfileProviderName = "SharedConfigTrustedProfileProviderIBM"
+
 // NewTrustedProfileProviderWithCR constructor of the IBM IAM provider that uses IAM trusted-profile
 // details passed
 // Returns: New TrustedProfileProvider (AWS type)
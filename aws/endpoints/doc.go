@@ -62,4 +62,17 @@
 //	    Region:           aws.String("us-west-2"),
 //	    EndpointResolver: endpoints.ResolverFunc(myCustomResolver),
 //	}))
+//
+// # IBM COS Endpoints
+//
+// The IBMCOSResolver resolves IBM COS endpoints for regional (us-south),
+// cross-region (us) and single-site (ams03) locations, passed as the region,
+// over the public, private or direct network. The session selects it when
+// the IBM_COS_NETWORK_TYPE environment variable or the ibm_cos_network_type
+// shared config key is set.
+//
+//	sess := session.Must(session.NewSession(&aws.Config{
+//	    Region:           aws.String("us-south"),
+//	    EndpointResolver: endpoints.IBMCOSResolver{NetworkType: endpoints.IBMCOSPrivateNetwork},
+//	}))
 package endpoints
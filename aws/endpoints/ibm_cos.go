@@ -0,0 +1,178 @@
+package endpoints
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+)
+
+// IBMCOSPartitionID is the partition ID reported by endpoints resolved with
+// the IBMCOSResolver.
+const IBMCOSPartitionID = "ibm-cos"
+
+// IBMCOSNetworkType is the network IBM COS endpoints are reached through.
+type IBMCOSNetworkType string
+
+// IBM COS network types.
+const (
+	// IBMCOSPublicNetwork resolves endpoints reachable from the internet.
+	IBMCOSPublicNetwork IBMCOSNetworkType = "public"
+
+	// IBMCOSPrivateNetwork resolves endpoints reachable from the IBM Cloud
+	// private network.
+	IBMCOSPrivateNetwork IBMCOSNetworkType = "private"
+
+	// IBMCOSDirectNetwork resolves endpoints reachable from VPC and Direct
+	// Link connections.
+	IBMCOSDirectNetwork IBMCOSNetworkType = "direct"
+)
+
+// GetIBMCOSNetworkType returns the IBMCOSNetworkType based on the input string
+// provided in env config or shared config by the user.
+//
+// `public`, `private` and `direct` are the only case-insensitive valid strings.
+func GetIBMCOSNetworkType(s string) (IBMCOSNetworkType, error) {
+	for _, v := range []IBMCOSNetworkType{IBMCOSPublicNetwork, IBMCOSPrivateNetwork, IBMCOSDirectNetwork} {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("unable to resolve the value of IBMCOSNetworkType for %v", s)
+}
+
+// IBMCOSLocationType is the resiliency of an IBM COS location.
+type IBMCOSLocationType string
+
+// IBM COS location types.
+const (
+	// IBMCOSRegionalLocation data is spread across the zones of a region.
+	IBMCOSRegionalLocation IBMCOSLocationType = "regional"
+
+	// IBMCOSCrossRegionLocation data is spread across the regions of a geography.
+	IBMCOSCrossRegionLocation IBMCOSLocationType = "crossRegion"
+
+	// IBMCOSSingleSiteLocation data is spread across a single data center.
+	IBMCOSSingleSiteLocation IBMCOSLocationType = "singleSite"
+)
+
+// IBMCOSLocation describes an IBM COS location endpoints can be resolved for.
+type IBMCOSLocation struct {
+	// Location ID, e.g. us-south, us or ams03
+	ID string `json:"-"`
+
+	// Human readable name of the location
+	Description string `json:"description"`
+
+	// Resiliency of the location
+	Type IBMCOSLocationType `json:"type"`
+
+	// Whether the location has dual-stack endpoints
+	DualStack bool `json:"dualStack"`
+}
+
+// ibmCOSModel is the IBM COS endpoint model, generated from
+// models/endpoints/ibm_cos_endpoints.json.
+type ibmCOSModel struct {
+	DNSSuffix          string                       `json:"dnsSuffix"`
+	Hostnames          map[IBMCOSNetworkType]string `json:"hostnames"`
+	DualStackHostnames map[IBMCOSNetworkType]string `json:"dualStackHostnames"`
+	Locations          map[string]IBMCOSLocation    `json:"locations"`
+}
+
+// IBMCOSLocations returns a map of the IBM COS locations endpoints can be
+// resolved for, keyed by location ID.
+func IBMCOSLocations() map[string]IBMCOSLocation {
+	ls := make(map[string]IBMCOSLocation, len(ibmCOSEndpoints.Locations))
+	for id, l := range ibmCOSEndpoints.Locations {
+		l.ID = id
+		ls[id] = l
+	}
+	return ls
+}
+
+// IBMCOSResolver resolves IBM COS endpoints for the location passed as the
+// region, e.g. us-south for a regional bucket, us for a cross-region bucket or
+// ams03 for a single-site bucket. Dual-stack endpoints are resolved when the
+// UseDualStackEndpoint option is enabled.
+//
+//	sess := session.Must(session.NewSession(&aws.Config{
+//	    Region: aws.String("us-south"),
+//	    EndpointResolver: endpoints.IBMCOSResolver{
+//	        NetworkType: endpoints.IBMCOSPrivateNetwork,
+//	    },
+//	}))
+type IBMCOSResolver struct {
+	// Network the endpoints are reached through, defaults to IBMCOSPublicNetwork.
+	NetworkType IBMCOSNetworkType
+
+	// Resolver used for services other than S3. If nil an UnknownServiceError
+	// is returned for them.
+	Fallback Resolver
+}
+
+// EndpointFor returns the IBM COS endpoint for the location passed as region.
+// Returns UnknownEndpointError if the location is not known, or has no
+// dual-stack endpoint when one is requested.
+func (r IBMCOSResolver) EndpointFor(service, region string, opts ...func(*Options)) (ResolvedEndpoint, error) {
+	const s3 = "s3"
+
+	var opt Options
+	opt.Set(opts...)
+
+	if service != s3 {
+		if r.Fallback != nil {
+			return r.Fallback.EndpointFor(service, region, opts...)
+		}
+		return ResolvedEndpoint{}, NewUnknownServiceError(IBMCOSPartitionID, service, []string{s3})
+	}
+
+	if len(opt.ResolvedRegion) != 0 {
+		region = opt.ResolvedRegion
+	}
+
+	location, ok := ibmCOSEndpoints.Locations[region]
+	if !ok {
+		return ResolvedEndpoint{}, NewUnknownEndpointError(IBMCOSPartitionID, service, region,
+			ibmCOSLocationIDs())
+	}
+
+	networkType := r.NetworkType
+	if len(networkType) == 0 {
+		networkType = IBMCOSPublicNetwork
+	}
+
+	hostnames := ibmCOSEndpoints.Hostnames
+	if opt.getEndpointVariant(service)&dualStackVariant != 0 {
+		if !location.DualStack {
+			return ResolvedEndpoint{}, NewUnknownEndpointError(IBMCOSPartitionID, service, region, nil)
+		}
+		hostnames = ibmCOSEndpoints.DualStackHostnames
+	}
+
+	hostname, ok := hostnames[networkType]
+	if !ok {
+		return ResolvedEndpoint{}, awserr.New("InvalidIBMCOSNetworkType",
+			fmt.Sprintf("unknown IBM COS network type %q", networkType), nil)
+	}
+	hostname = strings.Replace(hostname, "{location}", region, 1)
+	hostname = strings.Replace(hostname, "{dnsSuffix}", ibmCOSEndpoints.DNSSuffix, 1)
+
+	return ResolvedEndpoint{
+		URL:           AddScheme(hostname, opt.DisableSSL),
+		PartitionID:   IBMCOSPartitionID,
+		SigningRegion: region,
+		SigningName:   s3,
+		SigningMethod: "v4",
+	}, nil
+}
+
+func ibmCOSLocationIDs() []string {
+	ids := make([]string, 0, len(ibmCOSEndpoints.Locations))
+	for id := range ibmCOSEndpoints.Locations {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
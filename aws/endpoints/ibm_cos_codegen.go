@@ -0,0 +1,77 @@
+//go:build codegen
+// +build codegen
+
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/template"
+)
+
+// CodeGenIBMCOSModel given an IBM COS endpoints model file will decode it and
+// attempt to generate Go code from the model definition. Error will be
+// returned if the code is unable to be generated, or decoded.
+func CodeGenIBMCOSModel(modelFile io.Reader, outFile io.Writer) error {
+	var model ibmCOSModel
+	if err := json.NewDecoder(modelFile).Decode(&model); err != nil {
+		return fmt.Errorf("failed to decode IBM COS endpoints model, %v", err)
+	}
+
+	for _, networkType := range []IBMCOSNetworkType{IBMCOSPublicNetwork, IBMCOSPrivateNetwork, IBMCOSDirectNetwork} {
+		if _, ok := model.Hostnames[networkType]; !ok {
+			return fmt.Errorf("IBM COS endpoints model missing %s hostname", networkType)
+		}
+		if _, ok := model.DualStackHostnames[networkType]; !ok {
+			return fmt.Errorf("IBM COS endpoints model missing %s dual-stack hostname", networkType)
+		}
+	}
+
+	tmpl := template.Must(template.New("tmpl").Funcs(funcMap).Parse(ibmCOSTmpl))
+	if err := tmpl.ExecuteTemplate(outFile, "ibm cos defaults", model); err != nil {
+		return fmt.Errorf("failed to execute template, %v", err)
+	}
+
+	return nil
+}
+
+const ibmCOSTmpl = `
+{{ define "ibm cos defaults" -}}
+// Code generated by aws/endpoints/ibm_cos_codegen.go. DO NOT EDIT.
+
+package endpoints
+
+// IBM COS locations.
+const (
+	{{ range $id, $l := .Locations -}}
+		IBMCOS{{ ToSymbol $id }}LocationID = {{ QuoteString $id }} // {{ $l.Description }}.
+	{{ end -}}
+)
+
+var ibmCOSEndpoints = ibmCOSModel{
+	DNSSuffix: {{ QuoteString .DNSSuffix }},
+	Hostnames: map[IBMCOSNetworkType]string{
+		{{ range $n, $h := .Hostnames -}}
+			{{ printf "%q" $n }}: {{ QuoteString $h }},
+		{{ end -}}
+	},
+	DualStackHostnames: map[IBMCOSNetworkType]string{
+		{{ range $n, $h := .DualStackHostnames -}}
+			{{ printf "%q" $n }}: {{ QuoteString $h }},
+		{{ end -}}
+	},
+	Locations: map[string]IBMCOSLocation{
+		{{ range $id, $l := .Locations -}}
+			{{ QuoteString $id }}: {
+				Description: {{ QuoteString $l.Description }},
+				Type: {{ printf "%q" $l.Type }},
+				{{ if $l.DualStack -}}
+				DualStack: true,
+				{{ end -}}
+			},
+		{{ end -}}
+	},
+}
+{{- end }}
+`
@@ -0,0 +1,130 @@
+package endpoints
+
+import (
+	"testing"
+)
+
+func TestIBMCOSResolver(t *testing.T) {
+	cases := map[string]struct {
+		Resolver    IBMCOSResolver
+		Region      string
+		Opts        []func(*Options)
+		ExpectURL   string
+		ExpectError bool
+	}{
+		"regional public default": {
+			Region:    "us-south",
+			ExpectURL: "https://s3.us-south.cloud-object-storage.appdomain.cloud",
+		},
+		"regional private": {
+			Resolver:  IBMCOSResolver{NetworkType: IBMCOSPrivateNetwork},
+			Region:    "eu-de",
+			ExpectURL: "https://s3.private.eu-de.cloud-object-storage.appdomain.cloud",
+		},
+		"cross region direct": {
+			Resolver:  IBMCOSResolver{NetworkType: IBMCOSDirectNetwork},
+			Region:    "us",
+			ExpectURL: "https://s3.direct.us.cloud-object-storage.appdomain.cloud",
+		},
+		"single site disable ssl": {
+			Region:    "ams03",
+			Opts:      []func(*Options){DisableSSLOption},
+			ExpectURL: "http://s3.ams03.cloud-object-storage.appdomain.cloud",
+		},
+		"regional dual-stack": {
+			Region:    "jp-tok",
+			Opts:      []func(*Options){UseDualStackEndpointOption},
+			ExpectURL: "https://s3.dualstack.jp-tok.cloud-object-storage.appdomain.cloud",
+		},
+		"resolved region": {
+			Region: "ignored",
+			Opts: []func(*Options){func(o *Options) {
+				o.ResolvedRegion = "ca-tor"
+			}},
+			ExpectURL: "https://s3.ca-tor.cloud-object-storage.appdomain.cloud",
+		},
+		"single site dual-stack unsupported": {
+			Region:      "ams03",
+			Opts:        []func(*Options){UseDualStackEndpointOption},
+			ExpectError: true,
+		},
+		"unknown location": {
+			Region:      "us-east-1",
+			ExpectError: true,
+		},
+		"unknown network type": {
+			Resolver:    IBMCOSResolver{NetworkType: "satellite"},
+			Region:      "us-south",
+			ExpectError: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			resolved, err := c.Resolver.EndpointFor("s3", c.Region, c.Opts...)
+			if c.ExpectError {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectURL, resolved.URL; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			if e, a := IBMCOSPartitionID, resolved.PartitionID; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			if e, a := "s3", resolved.SigningName; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestIBMCOSResolverFallback(t *testing.T) {
+	_, err := IBMCOSResolver{}.EndpointFor("sts", "us-south")
+	if _, ok := err.(UnknownServiceError); !ok {
+		t.Fatalf("expect UnknownServiceError, got %T", err)
+	}
+
+	fallback := ResolverFunc(func(service, region string, opts ...func(*Options)) (ResolvedEndpoint, error) {
+		return ResolvedEndpoint{URL: "https://" + service + "." + region + ".example.com"}, nil
+	})
+	resolved, err := IBMCOSResolver{Fallback: fallback}.EndpointFor("sts", "us-south")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "https://sts.us-south.example.com", resolved.URL; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestGetIBMCOSNetworkType(t *testing.T) {
+	for _, v := range []string{"public", "PRIVATE", "Direct"} {
+		if _, err := GetIBMCOSNetworkType(v); err != nil {
+			t.Errorf("%s, expect no error, got %v", v, err)
+		}
+	}
+	if _, err := GetIBMCOSNetworkType("satellite"); err == nil {
+		t.Errorf("expect error, got none")
+	}
+}
+
+func TestIBMCOSLocations(t *testing.T) {
+	locations := IBMCOSLocations()
+	for id, l := range map[string]IBMCOSLocationType{
+		IBMCOSUsSouthLocationID: IBMCOSRegionalLocation,
+		IBMCOSUsLocationID:      IBMCOSCrossRegionLocation,
+		IBMCOSAms03LocationID:   IBMCOSSingleSiteLocation,
+	} {
+		if e, a := l, locations[id].Type; e != a {
+			t.Errorf("%s, expect %v, got %v", id, e, a)
+		}
+		if e, a := id, locations[id].ID; e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package aws
+
+import "fmt"
+
+// Optional is a value of type T that may be unset. The zero value of an
+// Optional is unset.
+//
+// Optional is used by value-typed clients to distinguish an optional field
+// not set from a field set to the zero value of its type, without the
+// pointer indirection of the service client types.
+//
+//	in := &s3typed.ListObjectsV2Input{
+//	    Bucket:  "bucket",
+//	    MaxKeys: aws.Some(int64(100)),
+//	}
+//	if v, ok := out.NextContinuationToken.Get(); ok {
+//	    // more objects to list
+//	}
+type Optional[T any] struct {
+	value T
+	set   bool
+}
+
+// Some returns an Optional set to the value.
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, set: true}
+}
+
+// None returns an unset Optional.
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// OptionalOf returns an Optional set to the value the pointer refers to, or
+// an unset Optional if the pointer is nil.
+func OptionalOf[T any](v *T) Optional[T] {
+	if v == nil {
+		return Optional[T]{}
+	}
+	return Some(*v)
+}
+
+// Get returns the value of the Optional, and if the value is set. The zero
+// value of T is returned if the Optional is unset.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// IsSet returns if the value of the Optional is set.
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// ValueOr returns the value of the Optional, or the default value passed in
+// if the Optional is unset.
+func (o Optional[T]) ValueOr(def T) T {
+	if !o.set {
+		return def
+	}
+	return o.value
+}
+
+// Ptr returns a pointer to a copy of the value of the Optional, or nil if the
+// Optional is unset.
+func (o Optional[T]) Ptr() *T {
+	if !o.set {
+		return nil
+	}
+	v := o.value
+	return &v
+}
+
+// String returns the string representation of the value of the Optional, or
+// "<unset>" if the Optional is unset.
+func (o Optional[T]) String() string {
+	if !o.set {
+		return "<unset>"
+	}
+	return fmt.Sprint(o.value)
+}
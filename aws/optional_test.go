@@ -0,0 +1,58 @@
+package aws
+
+import "testing"
+
+func TestOptional(t *testing.T) {
+	var unset Optional[int64]
+	if unset.IsSet() {
+		t.Errorf("expect zero value unset")
+	}
+	if v, ok := unset.Get(); ok || v != 0 {
+		t.Errorf("expect 0 unset, got %v, %v", v, ok)
+	}
+	if e, a := int64(10), unset.ValueOr(10); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if unset.Ptr() != nil {
+		t.Errorf("expect nil pointer")
+	}
+	if e, a := "<unset>", unset.String(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if None[int64]() != unset {
+		t.Errorf("expect None to equal zero value")
+	}
+
+	zero := Some(int64(0))
+	if v, ok := zero.Get(); !ok || v != 0 {
+		t.Errorf("expect 0 set, got %v, %v", v, ok)
+	}
+	if e, a := int64(0), zero.ValueOr(10); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if p := zero.Ptr(); p == nil || *p != 0 {
+		t.Errorf("expect pointer to 0, got %v", p)
+	}
+	if e, a := "0", zero.String(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestOptionalOf(t *testing.T) {
+	if OptionalOf[string](nil).IsSet() {
+		t.Errorf("expect nil pointer unset")
+	}
+
+	v := "value"
+	o := OptionalOf(&v)
+	v = "changed"
+	if e, a := Some("value"), o; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	p := o.Ptr()
+	*p = "changed"
+	if e, a := "value", o.ValueOr(""); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
@@ -219,6 +219,23 @@ func WithLogLevel(l aws.LogLevelType) Option {
 	}
 }
 
+// IBM COS SDK Code -- START
+
+// WithHMACSigning is a request option that selects whether the request is
+// signed with the config's HMACCredentials instead of its IBM IAM Credentials.
+// Overrides the aws.Config.UseHMACSigning default for a single request.
+//
+//	req, _ := svc.GetObjectRequest(params)
+//	req.ApplyOptions(request.WithHMACSigning(true))
+//	url, err := req.Presign(15 * time.Minute)
+func WithHMACSigning(enable bool) Option {
+	return func(r *Request) {
+		r.Config.UseHMACSigning = aws.Bool(enable)
+	}
+}
+
+// IBM COS SDK Code -- END
+
 // ApplyOptions will apply each option to the request calling them in the order
 // the were provided.
 func (r *Request) ApplyOptions(opts ...Option) {
@@ -7,6 +7,7 @@ import (
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/aws/awsutil"
+	"github.com/IBM/ibm-cos-sdk-go/internal/sdkrand"
 )
 
 // WaiterResourceNotReadyErrorCode is the error code returned by a waiter when
@@ -66,6 +67,54 @@ func WithWaiterRequestOptions(opts ...Option) WaiterOption {
 	}
 }
 
+// IBM COS SDK Code -- START
+
+// WithWaiterMaxWait returns a waiter option setting the total time the
+// waiter will wait for the resource state, in addition to its max attempts.
+// Zero, the default, does not limit the time.
+func WithWaiterMaxWait(d time.Duration) WaiterOption {
+	return func(w *Waiter) {
+		w.MaxWait = d
+	}
+}
+
+// JitteredWaiterDelay returns a WaiterDelay backing off exponentially from
+// minDelay, up to maxDelay, with each delay randomly chosen between minDelay
+// and the backoff delay of the attempt.
+func JitteredWaiterDelay(minDelay, maxDelay time.Duration) WaiterDelay {
+	return func(attempt int) time.Duration {
+		// Shift only while the backoff delay stays below maxDelay, as large
+		// attempts would overflow the shifted minDelay.
+		delay := maxDelay
+		if shift := attempt - 1; shift >= 0 && shift < 63 && minDelay <= maxDelay>>uint(shift) {
+			delay = minDelay << uint(shift)
+		}
+		if delay <= minDelay {
+			return minDelay
+		}
+		return minDelay + time.Duration(sdkrand.SeededRand.Int63n(int64(delay-minDelay)+1))
+	}
+}
+
+// waiterMaxWaitDelay returns the delay before the next attempt of a waiter
+// started at the time, limited to the waiter's remaining max wait time. Returns
+// false if the max wait time elapsed.
+func waiterMaxWaitDelay(delay time.Duration, start time.Time, maxWait time.Duration) (time.Duration, bool) {
+	if maxWait <= 0 {
+		return delay, true
+	}
+	remaining := maxWait - time.Since(start)
+	if remaining <= 0 {
+		return 0, false
+	}
+	if delay > remaining {
+		delay = remaining
+	}
+	return delay, true
+}
+
+// IBM COS SDK Code -- END
+
 // A Waiter provides the functionality to perform a blocking call which will
 // wait for a resource state to be satisfied by a service.
 //
@@ -79,6 +128,10 @@ type Waiter struct {
 	MaxAttempts int
 	Delay       WaiterDelay
 
+	// IBM COS SDK Code -- START
+	MaxWait time.Duration
+	// IBM COS SDK Code -- END
+
 	RequestOptions   []Option
 	NewRequest       func([]Option) (*Request, error)
 	SleepWithContext func(aws.Context, time.Duration) error
@@ -167,6 +220,9 @@ func (m WaiterMatchMode) String() string {
 // retryer ShouldRetry returns false. This normally will happen when the max
 // wait attempts expires.
 func (w Waiter) WaitWithContext(ctx aws.Context) error {
+	// IBM COS SDK Code -- START
+	start := time.Now()
+	// IBM COS SDK Code -- END
 
 	for attempt := 1; ; attempt++ {
 		req, err := w.NewRequest(w.RequestOptions)
@@ -193,6 +249,12 @@ func (w Waiter) WaitWithContext(ctx aws.Context) error {
 
 		// Delay to wait before inspecting the resource again
 		delay := w.Delay(attempt)
+		// IBM COS SDK Code -- START
+		delay, ok := waiterMaxWaitDelay(delay, start, w.MaxWait)
+		if !ok {
+			return awserr.New(WaiterResourceNotReadyErrorCode, "exceeded max wait time", nil)
+		}
+		// IBM COS SDK Code -- END
 		if sleepFn := req.Config.SleepDelay; sleepFn != nil {
 			// Support SleepDelay for backwards compatibility and testing
 			sleepFn(delay)
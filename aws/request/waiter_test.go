@@ -693,3 +693,50 @@ func TestWaiterWithContextNilInput(t *testing.T) {
 		t.Fatalf("expect no error, but got %v", err)
 	}
 }
+
+// IBM COS SDK Code -- START
+func TestWaiterMaxWait(t *testing.T) {
+	svc := &mockClient{Client: awstesting.NewClient(&aws.Config{
+		Region:      aws.String("mock-region"),
+		Credentials: credentials.AnonymousCredentials,
+	})}
+	svc.Handlers.Send.Clear() // mock sending
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.ValidateResponse.Clear()
+
+	numBuiltReq := 0
+	svc.Handlers.Build.PushBack(func(r *request.Request) {
+		numBuiltReq++
+	})
+	svc.Handlers.Unmarshal.PushBack(func(r *request.Request) {
+		r.Data = &MockOutput{States: []*MockState{{State: aws.String("pending")}}}
+	})
+
+	w := request.Waiter{
+		Delay: request.ConstantWaiterDelay(20 * time.Millisecond),
+		Acceptors: []request.WaiterAcceptor{
+			{
+				State:    request.SuccessWaiterState,
+				Matcher:  request.PathAllWaiterMatch,
+				Argument: "States[].State",
+				Expected: "running",
+			},
+		},
+		NewRequest: BuildNewMockRequest(svc, &MockInput{}),
+	}
+	w.ApplyOptions(request.WithWaiterMaxWait(50 * time.Millisecond))
+
+	err := w.WaitWithContext(aws.BackgroundContext())
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := request.WaiterResourceNotReadyErrorCode, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if numBuiltReq < 3 || numBuiltReq > 5 {
+		t.Errorf("expect 3 to 5 requests within max wait, got %v", numBuiltReq)
+	}
+}
+
+// IBM COS SDK Code -- END
@@ -0,0 +1,221 @@
+package request
+
+import (
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awsutil"
+)
+
+// A TypedAcceptor matches the output, or error, of a TypedWaiter attempt to
+// the waiter state the acceptor transitions to.
+type TypedAcceptor[T any] struct {
+	State WaiterState
+
+	// Matcher returns if the acceptor matches the output and error of the
+	// attempt. The output is the zero value of T if the request failed.
+	Matcher func(out T, err error) bool
+}
+
+// A TypedWaiter is a Waiter matching the typed outputs and errors of its
+// requests with the waiter's acceptors, returning the output of the last
+// attempt.
+//
+// Unlike Waiter, a TypedWaiter returns the errors of attempts no acceptor
+// matches instead of retrying them.
+//
+//	w := request.TypedWaiter[*s3.HeadObjectOutput]{
+//	    Name:  "WaitUntilObjectArchived",
+//	    Delay: request.JitteredWaiterDelay(5*time.Second, 2*time.Minute),
+//	    Acceptors: []request.TypedAcceptor[*s3.HeadObjectOutput]{{
+//	        State: request.SuccessWaiterState,
+//	        Matcher: func(out *s3.HeadObjectOutput, err error) bool {
+//	            return err == nil && aws.StringValue(out.StorageClass) == s3.StorageClassGlacier
+//	        },
+//	    }},
+//	    NewRequest: func(opts []request.Option) (*request.Request, *s3.HeadObjectOutput, error) {
+//	        req, out := svc.HeadObjectRequest(input)
+//	        req.SetContext(ctx)
+//	        req.ApplyOptions(opts...)
+//	        return req, out, nil
+//	    },
+//	}
+//	w.ApplyOptions(request.WithWaiterMaxWait(time.Hour))
+//	out, err := w.WaitWithContext(ctx)
+type TypedWaiter[T any] struct {
+	Name      string
+	Acceptors []TypedAcceptor[T]
+	Logger    aws.Logger
+
+	MaxAttempts int
+	Delay       WaiterDelay
+	MaxWait     time.Duration
+
+	RequestOptions   []Option
+	NewRequest       func([]Option) (*Request, T, error)
+	SleepWithContext func(aws.Context, time.Duration) error
+}
+
+// ApplyOptions updates the waiter with the list of waiter options provided.
+func (w *TypedWaiter[T]) ApplyOptions(opts ...WaiterOption) {
+	waiter := Waiter{
+		Name:             w.Name,
+		Logger:           w.Logger,
+		MaxAttempts:      w.MaxAttempts,
+		Delay:            w.Delay,
+		MaxWait:          w.MaxWait,
+		RequestOptions:   w.RequestOptions,
+		SleepWithContext: w.SleepWithContext,
+	}
+	waiter.ApplyOptions(opts...)
+
+	w.Name = waiter.Name
+	w.Logger = waiter.Logger
+	w.MaxAttempts = waiter.MaxAttempts
+	w.Delay = waiter.Delay
+	w.MaxWait = waiter.MaxWait
+	w.RequestOptions = waiter.RequestOptions
+	w.SleepWithContext = waiter.SleepWithContext
+}
+
+// WaitWithContext makes requests until the first acceptor matching the
+// attempt transitions the waiter to the success or failure state, and
+// returns the output of the last attempt.
+//
+// Attempts matched by no acceptor are retried if they succeeded, or their
+// error is returned. Returns the WaiterResourceNotReadyErrorCode error code
+// if the failure state is matched, or the max attempts or max wait time
+// expire.
+func (w TypedWaiter[T]) WaitWithContext(ctx aws.Context) (T, error) {
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		req, out, err := w.NewRequest(w.RequestOptions)
+		if err != nil {
+			waiterLogf(w.Logger, "unable to create request %v", err)
+			return out, err
+		}
+		req.Handlers.Build.PushBack(MakeAddToUserAgentFreeFormHandler("Waiter"))
+		err = req.Send()
+		if err != nil {
+			var zero T
+			out = zero
+		}
+
+		state, matched := w.match(out, err)
+		switch {
+		case matched && state == SuccessWaiterState:
+			return out, nil
+		case matched && state == FailureWaiterState:
+			return out, awserr.New(WaiterResourceNotReadyErrorCode,
+				"failed waiting for successful resource state", err)
+		case !matched && err != nil:
+			return out, err
+		}
+
+		if attempt == w.MaxAttempts {
+			return out, awserr.New(WaiterResourceNotReadyErrorCode, "exceeded wait attempts", nil)
+		}
+
+		delay, ok := waiterMaxWaitDelay(w.Delay(attempt), start, w.MaxWait)
+		if !ok {
+			return out, awserr.New(WaiterResourceNotReadyErrorCode, "exceeded max wait time", nil)
+		}
+		if sleepFn := req.Config.SleepDelay; sleepFn != nil {
+			sleepFn(delay)
+		} else {
+			sleepCtxFn := w.SleepWithContext
+			if sleepCtxFn == nil {
+				sleepCtxFn = aws.SleepWithContext
+			}
+
+			if err := sleepCtxFn(ctx, delay); err != nil {
+				return out, awserr.New(CanceledErrorCode, "waiter context canceled", err)
+			}
+		}
+	}
+}
+
+// match returns the state of the first acceptor matching the attempt.
+func (w TypedWaiter[T]) match(out T, err error) (WaiterState, bool) {
+	for _, a := range w.Acceptors {
+		if !a.Matcher(out, err) {
+			continue
+		}
+		switch a.State {
+		case SuccessWaiterState, FailureWaiterState, RetryWaiterState:
+			return a.State, true
+		default:
+			waiterLogf(w.Logger, "WARNING: Waiter %s encountered unexpected state: %s",
+				w.Name, a.State)
+		}
+	}
+	return RetryWaiterState, false
+}
+
+// MatchSuccess returns a TypedAcceptor matcher matching successful attempts.
+func MatchSuccess[T any]() func(T, error) bool {
+	return func(_ T, err error) bool {
+		return err == nil
+	}
+}
+
+// MatchErrorCode returns a TypedAcceptor matcher matching attempts failing
+// with the error code.
+func MatchErrorCode[T any](code string) func(T, error) bool {
+	return func(_ T, err error) bool {
+		aerr, ok := err.(awserr.Error)
+		return ok && aerr.Code() == code
+	}
+}
+
+// MatchStatus returns a TypedAcceptor matcher matching attempts failing
+// with the HTTP status code. Successful attempts match the status code 200.
+func MatchStatus[T any](status int) func(T, error) bool {
+	return func(_ T, err error) bool {
+		if err == nil {
+			return status == 200
+		}
+		rerr, ok := err.(awserr.RequestFailure)
+		return ok && rerr.StatusCode() == status
+	}
+}
+
+// MatchPath returns a TypedAcceptor matcher matching successful attempts
+// where all values of the output at the JMESPath-like path, as used by
+// awsutil.ValuesAtPath, equal the expected value.
+func MatchPath[T any](path string, expected interface{}) func(T, error) bool {
+	return func(out T, err error) bool {
+		if err != nil {
+			return false
+		}
+		vals, _ := awsutil.ValuesAtPath(out, path)
+		if len(vals) == 0 {
+			return false
+		}
+		for _, val := range vals {
+			if !awsutil.DeepEqual(val, expected) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// MatchPathAny returns a TypedAcceptor matcher matching successful attempts
+// where any value of the output at the path equals the expected value.
+func MatchPathAny[T any](path string, expected interface{}) func(T, error) bool {
+	return func(out T, err error) bool {
+		if err != nil {
+			return false
+		}
+		vals, _ := awsutil.ValuesAtPath(out, path)
+		for _, val := range vals {
+			if awsutil.DeepEqual(val, expected) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,248 @@
+package request_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/awstesting"
+)
+
+// newTypedMockClient returns a client responding to each request with the
+// next output, or error.
+func newTypedMockClient(t *testing.T, resps []interface{}) (*mockClient, *int) {
+	svc := &mockClient{Client: awstesting.NewClient(&aws.Config{
+		Region:      aws.String("mock-region"),
+		Credentials: credentials.AnonymousCredentials,
+	})}
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.ValidateResponse.Clear()
+
+	var reqNum int
+	svc.Handlers.Unmarshal.PushBack(func(r *request.Request) {
+		if reqNum >= len(resps) {
+			t.Errorf("too many polling requests made")
+			return
+		}
+		switch v := resps[reqNum].(type) {
+		case error:
+			r.Error = v
+		case *MockOutput:
+			*r.Data.(*MockOutput) = *v
+		}
+		reqNum++
+	})
+	return svc, &reqNum
+}
+
+func newTypedMockWaiter(svc *mockClient, acceptors ...request.TypedAcceptor[*MockOutput]) request.TypedWaiter[*MockOutput] {
+	return request.TypedWaiter[*MockOutput]{
+		Name:        "WaitUntilMock",
+		MaxAttempts: 10,
+		Delay:       request.ConstantWaiterDelay(0),
+		Acceptors:   acceptors,
+		NewRequest: func(opts []request.Option) (*request.Request, *MockOutput, error) {
+			req, out := svc.MockRequest(&MockInput{})
+			req.ApplyOptions(opts...)
+			return req, out, nil
+		},
+	}
+}
+
+func mockStates(states ...string) *MockOutput {
+	out := &MockOutput{}
+	for _, s := range states {
+		out.States = append(out.States, &MockState{State: aws.String(s)})
+	}
+	return out
+}
+
+func TestTypedWaiter(t *testing.T) {
+	svc, reqNum := newTypedMockClient(t, []interface{}{
+		awserr.NewRequestFailure(awserr.New("NotFound", "not found", nil), 404, "id"),
+		mockStates("pending", "running"),
+		mockStates("running", "running"),
+	})
+
+	w := newTypedMockWaiter(svc,
+		request.TypedAcceptor[*MockOutput]{
+			State:   request.SuccessWaiterState,
+			Matcher: request.MatchPath[*MockOutput]("States[].State", "running"),
+		},
+		request.TypedAcceptor[*MockOutput]{
+			State:   request.FailureWaiterState,
+			Matcher: request.MatchPathAny[*MockOutput]("States[].State", "failed"),
+		},
+		request.TypedAcceptor[*MockOutput]{
+			State:   request.RetryWaiterState,
+			Matcher: request.MatchStatus[*MockOutput](404),
+		},
+	)
+
+	out, err := w.WaitWithContext(aws.BackgroundContext())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 3, *reqNum; e != a {
+		t.Errorf("expect %v requests, got %v", e, a)
+	}
+	if e, a := 2, len(out.States); e != a {
+		t.Errorf("expect %v states, got %v", e, a)
+	}
+}
+
+func TestTypedWaiter_Failure(t *testing.T) {
+	svc, _ := newTypedMockClient(t, []interface{}{
+		mockStates("pending", "failed"),
+	})
+
+	w := newTypedMockWaiter(svc,
+		request.TypedAcceptor[*MockOutput]{
+			State:   request.FailureWaiterState,
+			Matcher: request.MatchPathAny[*MockOutput]("States[].State", "failed"),
+		},
+	)
+
+	out, err := w.WaitWithContext(aws.BackgroundContext())
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != request.WaiterResourceNotReadyErrorCode {
+		t.Fatalf("expect %v error, got %v", request.WaiterResourceNotReadyErrorCode, err)
+	}
+	if e, a := "failed", aws.StringValue(out.States[1].State); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestTypedWaiter_UnmatchedError(t *testing.T) {
+	svc, reqNum := newTypedMockClient(t, []interface{}{
+		awserr.New("AccessDenied", "denied", nil),
+	})
+
+	w := newTypedMockWaiter(svc,
+		request.TypedAcceptor[*MockOutput]{
+			State:   request.RetryWaiterState,
+			Matcher: request.MatchErrorCode[*MockOutput]("NotFound"),
+		},
+		request.TypedAcceptor[*MockOutput]{
+			State:   request.SuccessWaiterState,
+			Matcher: request.MatchSuccess[*MockOutput](),
+		},
+	)
+
+	out, err := w.WaitWithContext(aws.BackgroundContext())
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != "AccessDenied" {
+		t.Fatalf("expect AccessDenied error, got %v", err)
+	}
+	if out != nil {
+		t.Errorf("expect no output, got %v", out)
+	}
+	if e, a := 1, *reqNum; e != a {
+		t.Errorf("expect %v requests, got %v", e, a)
+	}
+}
+
+func TestTypedWaiter_Options(t *testing.T) {
+	resps := make([]interface{}, 10)
+	for i := range resps {
+		resps[i] = mockStates("pending")
+	}
+	svc, reqNum := newTypedMockClient(t, resps)
+
+	var delays []time.Duration
+	w := newTypedMockWaiter(svc, request.TypedAcceptor[*MockOutput]{
+		State:   request.SuccessWaiterState,
+		Matcher: request.MatchPath[*MockOutput]("States[].State", "running"),
+	})
+	w.ApplyOptions(
+		request.WithWaiterMaxAttempts(3),
+		request.WithWaiterDelay(request.ConstantWaiterDelay(time.Second)),
+		func(w *request.Waiter) {
+			w.SleepWithContext = func(_ aws.Context, d time.Duration) error {
+				delays = append(delays, d)
+				return nil
+			}
+		},
+	)
+
+	_, err := w.WaitWithContext(aws.BackgroundContext())
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != request.WaiterResourceNotReadyErrorCode {
+		t.Fatalf("expect %v error, got %v", request.WaiterResourceNotReadyErrorCode, err)
+	}
+	if e, a := 3, *reqNum; e != a {
+		t.Errorf("expect %v requests, got %v", e, a)
+	}
+	if e, a := 2, len(delays); e != a {
+		t.Fatalf("expect %v delays, got %v", e, a)
+	}
+	if e, a := time.Second, delays[0]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestTypedWaiter_MaxWait(t *testing.T) {
+	resps := make([]interface{}, 100)
+	for i := range resps {
+		resps[i] = mockStates("pending")
+	}
+	svc, reqNum := newTypedMockClient(t, resps)
+
+	w := newTypedMockWaiter(svc, request.TypedAcceptor[*MockOutput]{
+		State:   request.SuccessWaiterState,
+		Matcher: request.MatchPath[*MockOutput]("States[].State", "running"),
+	})
+	w.ApplyOptions(
+		request.WithWaiterMaxAttempts(0),
+		request.WithWaiterDelay(request.ConstantWaiterDelay(20*time.Millisecond)),
+		request.WithWaiterMaxWait(50*time.Millisecond),
+	)
+
+	_, err := w.WaitWithContext(aws.BackgroundContext())
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != request.WaiterResourceNotReadyErrorCode {
+		t.Fatalf("expect %v error, got %v", request.WaiterResourceNotReadyErrorCode, err)
+	}
+	if *reqNum < 3 || *reqNum > 5 {
+		t.Errorf("expect 3 to 5 requests within max wait, got %v", *reqNum)
+	}
+}
+
+func TestJitteredWaiterDelay(t *testing.T) {
+	delay := request.JitteredWaiterDelay(time.Second, 10*time.Second)
+
+	for attempt := 1; attempt < 100; attempt++ {
+		max := time.Second << uint(attempt-1)
+		if attempt > 4 {
+			max = 10 * time.Second
+		}
+		d := delay(attempt)
+		if d < time.Second || d > max {
+			t.Errorf("%d, expect delay between 1s and %v, got %v", attempt, max, d)
+		}
+	}
+	if e, a := time.Second, delay(1); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestJitteredWaiterDelay_LargeAttempts(t *testing.T) {
+	minDelay, maxDelay := 5*time.Minute, time.Hour
+	delay := request.JitteredWaiterDelay(minDelay, maxDelay)
+
+	for _, attempt := range []int{20, 26, 30, 31, 32, 63, 64, 1000, math.MaxInt32, math.MaxInt64} {
+		var backoff bool
+		for i := 0; i < 100; i++ {
+			d := delay(attempt)
+			if d < minDelay || d > maxDelay {
+				t.Fatalf("%d, expect delay between %v and %v, got %v", attempt, minDelay, maxDelay, d)
+			}
+			backoff = backoff || d > maxDelay/2
+		}
+		if !backoff {
+			t.Errorf("%d, expect delays backed off up to %v", attempt, maxDelay)
+		}
+	}
+}
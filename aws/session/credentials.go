@@ -6,6 +6,7 @@ import (
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/processcreds"
 	"github.com/IBM/ibm-cos-sdk-go/aws/request"
 )
@@ -52,6 +53,12 @@ func resolveCredsFromProfile(cfg *aws.Config,
 			sharedCfg.Creds,
 		)
 
+	// IBM COS SDK Code -- START
+	case len(sharedCfg.IBMServiceCredentialsFile) != 0:
+		// HMAC keys from an IBM COS service credentials file
+		creds = ibmiam.NewServiceCredentialsFileHMACCredentials(sharedCfg.IBMServiceCredentialsFile)
+	// IBM COS SDK Code -- END
+
 	case len(sharedCfg.CredentialProcess) != 0:
 		// Get credentials from CredentialProcess
 		creds = processcreds.NewCredentials(sharedCfg.CredentialProcess)
@@ -87,6 +94,62 @@ func resolveCredsFromProfile(cfg *aws.Config,
 	return creds, nil
 }
 
+// IBM COS SDK Code -- START
+// newSharedConfigTrustedProfileProvider returns the provider assuming the IBM
+// IAM trusted profile of the shared config profile, with the IBM IAM
+// credentials of the profile or of its source profiles.
+func newSharedConfigTrustedProfileProvider(cfg *aws.Config, sharedCfg sharedConfig) *ibmiam.TrustedProfileProvider {
+	src := sharedCfg.ibmCredentialsSource()
+
+	tpConfig := &ibmiam.TrustedProfileConfig{
+		TrustedProfileID:   sharedCfg.IBMTrustedProfileID,
+		TrustedProfileName: sharedCfg.IBMTrustedProfileName,
+		IAMAccountID:       sharedCfg.IBMIAMAccountID,
+		ServiceIDApiKey:    src.IBMAPIKeyID,
+		CrTokenFilePath:    src.IBMCRTokenFilePath,
+	}
+	resourceType := ibmiam.ResourceServiceID
+	if len(src.IBMCRTokenFilePath) != 0 {
+		resourceType = ibmiam.ResourceComputeResource
+	}
+
+	authEndpoint := sharedCfg.IBMAuthEndpoint
+	if len(authEndpoint) == 0 {
+		authEndpoint = src.IBMAuthEndpoint
+	}
+	serviceInstanceID := sharedCfg.IBMServiceInstanceID
+	if len(serviceInstanceID) == 0 {
+		serviceInstanceID = src.IBMServiceInstanceID
+	}
+
+	return ibmiam.NewTrustedProfileProviderWithConfig(ibmiam.SharedConfigTrustedProfileProviderName, cfg,
+		authEndpoint, tpConfig, serviceInstanceID, resourceType)
+}
+
+// resolveHMACCredentials returns the static HMAC credentials found in the
+// environment, shared config or IBM COS service credentials, or nil if none
+// provides a complete key pair.
+func resolveHMACCredentials(envCfg envConfig, sharedCfg sharedConfig) *credentials.Credentials {
+	switch {
+	case envCfg.Creds.HasKeys():
+		return credentials.NewStaticCredentialsFromCreds(envCfg.Creds)
+	case sharedCfg.Creds.HasKeys():
+		return credentials.NewStaticCredentialsFromCreds(sharedCfg.Creds)
+	}
+
+	if provider := ibmiam.NewServiceCredentialsEnvHMACProvider(); provider.IsValid() {
+		return credentials.NewCredentials(provider)
+	}
+	if len(sharedCfg.IBMServiceCredentialsFile) != 0 {
+		if provider := ibmiam.NewServiceCredentialsFileHMACProvider(sharedCfg.IBMServiceCredentialsFile); provider.IsValid() {
+			return credentials.NewCredentials(provider)
+		}
+	}
+	return nil
+}
+
+// IBM COS SDK Code -- END
+
 // valid credential source values
 const (
 	credSourceEnvironment = "Environment"
@@ -133,6 +133,21 @@ type envConfig struct {
 	S3UseARNRegion bool
 	// AWS_USE_DUALSTACK_ENDPOINT=true
 	UseDualStackEndpoint endpoints.DualStackEndpointState
+
+	// IBM COS SDK Code -- START
+	// Specifies if requests should be signed with the HMAC credentials by
+	// default when both IBM IAM and HMAC credentials are available.
+	//
+	// IBM_USE_HMAC_SIGNING=true
+	UseHMACSigning *bool
+
+	// Specifies the network IBM COS endpoints are resolved for, selecting the
+	// IBM COS endpoint resolver. The region is used as the IBM COS location.
+	//
+	// IBM_COS_NETWORK_TYPE=private
+	// This can take value as `public`, `private` or `direct`
+	IBMCOSNetworkType endpoints.IBMCOSNetworkType
+	// IBM COS SDK Code -- END
 }
 
 var (
@@ -184,6 +199,12 @@ var (
 	awsUseDualStackEndpoint = []string{
 		"AWS_USE_DUALSTACK_ENDPOINT",
 	}
+	ibmUseHMACSigningEnvKey = []string{
+		"IBM_USE_HMAC_SIGNING",
+	}
+	ibmCOSNetworkTypeEnvKey = []string{
+		"IBM_COS_NETWORK_TYPE",
+	}
 )
 
 // loadEnvConfig retrieves the SDK's environment configuration.
@@ -286,6 +307,32 @@ func envConfigLoad(enableSharedConfig bool) (envConfig, error) {
 		}
 	}
 
+	// IBM COS SDK Code -- START
+	var useHMACSigning string
+	setFromEnvVal(&useHMACSigning, ibmUseHMACSigningEnvKey)
+	if len(useHMACSigning) != 0 {
+		switch {
+		case strings.EqualFold(useHMACSigning, "false"):
+			cfg.UseHMACSigning = aws.Bool(false)
+		case strings.EqualFold(useHMACSigning, "true"):
+			cfg.UseHMACSigning = aws.Bool(true)
+		default:
+			return envConfig{}, fmt.Errorf(
+				"invalid value for environment variable, %s=%s, need true or false",
+				ibmUseHMACSigningEnvKey[0], useHMACSigning)
+		}
+	}
+
+	for _, k := range ibmCOSNetworkTypeEnvKey {
+		if v := os.Getenv(k); len(v) != 0 {
+			cfg.IBMCOSNetworkType, err = endpoints.GetIBMCOSNetworkType(v)
+			if err != nil {
+				return cfg, fmt.Errorf("failed to load, %v from env config, %v", k, err)
+			}
+		}
+	}
+	// IBM COS SDK Code -- END
+
 	return cfg, nil
 }
 
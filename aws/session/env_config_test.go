@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
 	"github.com/IBM/ibm-cos-sdk-go/aws/endpoints"
 	"github.com/IBM/ibm-cos-sdk-go/awstesting"
@@ -356,6 +357,38 @@ func TestLoadEnvConfig(t *testing.T) {
 				SharedConfigFile:      shareddefaults.SharedConfigFilename(),
 			},
 		},
+		21: {
+			Env: map[string]string{
+				"IBM_USE_HMAC_SIGNING": "true",
+			},
+			Config: envConfig{
+				UseHMACSigning:        aws.Bool(true),
+				SharedCredentialsFile: shareddefaults.SharedCredentialsFilename(),
+				SharedConfigFile:      shareddefaults.SharedConfigFilename(),
+			},
+		},
+		22: {
+			Env: map[string]string{
+				"IBM_USE_HMAC_SIGNING": "invalid",
+			},
+			WantErr: true,
+		},
+		23: {
+			Env: map[string]string{
+				"IBM_COS_NETWORK_TYPE": "Private",
+			},
+			Config: envConfig{
+				IBMCOSNetworkType:     endpoints.IBMCOSPrivateNetwork,
+				SharedCredentialsFile: shareddefaults.SharedCredentialsFilename(),
+				SharedConfigFile:      shareddefaults.SharedConfigFilename(),
+			},
+		},
+		24: {
+			Env: map[string]string{
+				"IBM_COS_NETWORK_TYPE": "satellite",
+			},
+			WantErr: true,
+		},
 	}
 
 	for i, c := range cases {
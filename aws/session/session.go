@@ -550,11 +550,33 @@ func mergeConfigSrcs(cfg, userCfg *aws.Config,
 		endpoints.LegacyS3UsEast1Endpoint,
 	})
 
+	// IBM COS SDK Code -- START
+	// IBM COS endpoint resolver for the network type, if not already set by
+	// the user when creating the Session.
+	if userCfg.EndpointResolver == nil {
+		networkType := envCfg.IBMCOSNetworkType
+		if len(networkType) == 0 {
+			networkType = sharedCfg.IBMCOSNetworkType
+		}
+		if len(networkType) != 0 {
+			cfg.EndpointResolver = endpoints.IBMCOSResolver{
+				NetworkType: networkType,
+				Fallback:    cfg.EndpointResolver,
+			}
+		}
+	}
+	// IBM COS SDK Code -- END
+
 	// Configure credentials if not already set by the user when creating the
 	// Session.
 	if cfg.Credentials == credentials.AnonymousCredentials && userCfg.Credentials == nil {
-		if iBmIamCreds := getIBMIAMCredentials(userCfg); iBmIamCreds != nil {
+		if iBmIamCreds := getIBMIAMCredentials(userCfg, sharedCfg); iBmIamCreds != nil {
 			cfg.Credentials = iBmIamCreds
+			// Keep HMAC keys found alongside the IBM IAM credentials for
+			// requests that select HMAC signing.
+			if cfg.HMACCredentials == nil {
+				cfg.HMACCredentials = resolveHMACCredentials(envCfg, sharedCfg)
+			}
 		} else {
 			creds, err := resolveCredentials(cfg, envCfg, sharedCfg, handlers, sessOpts)
 			if err != nil {
@@ -565,6 +587,16 @@ func mergeConfigSrcs(cfg, userCfg *aws.Config,
 		// IBM COS SDK Code -- END
 	}
 
+	// IBM COS SDK Code -- START
+	if cfg.UseHMACSigning == nil {
+		if envCfg.UseHMACSigning != nil {
+			cfg.WithUseHMACSigning(*envCfg.UseHMACSigning)
+		} else if sharedCfg.UseHMACSigning != nil {
+			cfg.WithUseHMACSigning(*sharedCfg.UseHMACSigning)
+		}
+	}
+	// IBM COS SDK Code -- END
+
 	cfg.S3UseARNRegion = userCfg.S3UseARNRegion
 	if cfg.S3UseARNRegion == nil {
 		cfg.S3UseARNRegion = &envCfg.S3UseARNRegion
@@ -594,7 +626,7 @@ func mergeS3UsEast1RegionalEndpointConfig(cfg *aws.Config, values []endpoints.S3
 
 // IBM COS SDK Code -- START
 // getIBMIAMCredentials retrieve token manager creds or ibm based credentials
-func getIBMIAMCredentials(config *aws.Config) *credentials.Credentials {
+func getIBMIAMCredentials(config *aws.Config, sharedCfg sharedConfig) *credentials.Credentials {
 
 	if provider := ibmiam.NewEnvProvider(config); provider.IsValid() {
 		return credentials.NewCredentials(provider)
@@ -604,6 +636,22 @@ func getIBMIAMCredentials(config *aws.Config) *credentials.Credentials {
 		return credentials.NewCredentials(provider)
 	}
 
+	if provider := ibmiam.NewServiceCredentialsEnvProvider(config); provider.IsValid() {
+		return credentials.NewCredentials(provider)
+	}
+
+	if sharedCfg.hasIBMTrustedProfile() {
+		return credentials.NewCredentials(newSharedConfigTrustedProfileProvider(config, sharedCfg))
+	}
+
+	if len(sharedCfg.IBMServiceCredentialsFile) != 0 {
+		provider := ibmiam.NewServiceCredentialsFileProvider(config, sharedCfg.IBMAuthEndpoint,
+			sharedCfg.IBMServiceCredentialsFile)
+		if provider.IsValid() {
+			return credentials.NewCredentials(provider)
+		}
+	}
+
 	if provider := ibmiam.NewSharedCredentialsProvider(config, "", ""); provider.IsValid() {
 		return credentials.NewCredentials(provider)
 	}
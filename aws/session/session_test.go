@@ -135,6 +135,129 @@ func TestNewSession_NoCredentials(t *testing.T) {
 	}
 }
 
+// IBM COS SDK Code -- START
+func TestNewSession_IBMIAMWithHMACCredentials(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+
+	os.Setenv("IBM_API_KEY_ID", "api_key")
+	os.Setenv("AWS_ACCESS_KEY_ID", "AKID")
+	os.Setenv("AWS_SECRET_ACCESS_KEY", "SECRET")
+	os.Setenv("IBM_USE_HMAC_SIGNING", "true")
+
+	s, err := NewSession()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if s.Config.HMACCredentials == nil {
+		t.Fatalf("expect HMAC credentials")
+	}
+	if e, a := s.Config.Credentials, s.Config.HMACCredentials; e == a {
+		t.Errorf("expect HMAC credentials to differ from IAM credentials")
+	}
+	creds, err := s.Config.HMACCredentials.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := true, aws.BoolValue(s.Config.UseHMACSigning); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestNewSession_IBMServiceCredentialsFile(t *testing.T) {
+	cases := map[string]struct {
+		Profile   string
+		ExpectIAM bool
+	}{
+		"iam and hmac": {
+			Profile:   "ibm_service_credentials",
+			ExpectIAM: true,
+		},
+		"hmac only": {
+			Profile: "ibm_service_credentials_hmac_only",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreEnvFn := initSessionTestEnv()
+			defer restoreEnvFn()
+
+			os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+			os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+			os.Setenv("AWS_PROFILE", c.Profile)
+
+			s, err := NewSession()
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			hmacCreds := s.Config.Credentials
+			if c.ExpectIAM {
+				// HMAC credentials are only kept alongside IBM IAM credentials
+				if s.Config.HMACCredentials == nil {
+					t.Fatalf("expect HMAC credentials")
+				}
+				if e, a := s.Config.Credentials, s.Config.HMACCredentials; e == a {
+					t.Errorf("expect HMAC credentials to differ from IAM credentials")
+				}
+				hmacCreds = s.Config.HMACCredentials
+			} else if s.Config.HMACCredentials != nil {
+				t.Errorf("expect no HMAC credentials alongside HMAC only credentials")
+			}
+
+			creds, err := hmacCreds.Get()
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := "ibm_service_credentials_akid", creds.AccessKeyID; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			if e, a := "ibm_service_credentials_instance_id", creds.ServiceInstanceID; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestNewSession_IBMCOSNetworkType(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+
+	os.Setenv("IBM_COS_NETWORK_TYPE", "direct")
+
+	s, err := NewSession(&aws.Config{Region: aws.String("us-south")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	cfg := s.ClientConfig("s3")
+	if e, a := "https://s3.direct.us-south.cloud-object-storage.appdomain.cloud", cfg.Endpoint; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := endpoints.IBMCOSPartitionID, cfg.PartitionID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	// A resolver set by the user is kept
+	s, err = NewSession(&aws.Config{
+		Region:           aws.String("us-south"),
+		EndpointResolver: endpoints.DefaultResolver(),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, ok := s.Config.EndpointResolver.(endpoints.IBMCOSResolver); ok {
+		t.Errorf("expect user endpoint resolver")
+	}
+}
+
+// IBM COS SDK Code -- END
+
 func TestNewSessionWithOptions_OverrideProfile(t *testing.T) {
 	restoreEnvFn := initSessionTestEnv()
 	defer restoreEnvFn()
@@ -51,6 +51,29 @@ const (
 	s3UseARNRegionKey = "s3_use_arn_region"
 	// Use DualStack Endpoint Resolution
 	useDualStackEndpoint = "use_dualstack_endpoint"
+
+	// IBM COS SDK Code -- START
+	// Sign requests with the HMAC credentials by default
+	ibmUseHMACSigningKey = "ibm_use_hmac_signing"
+
+	// IBM IAM Credentials group
+	ibmAPIKeyIDKey          = `ibm_api_key_id`          // group required (or ibm_cr_token_file_path)
+	ibmCRTokenFilePathKey   = `ibm_cr_token_file_path`  // group required (or ibm_api_key_id)
+	ibmAuthEndpointKey      = `ibm_auth_endpoint`       // optional
+	ibmServiceInstanceIDKey = `ibm_service_instance_id` // optional
+
+	// IBM IAM Trusted Profile group
+	ibmTrustedProfileIDKey   = `ibm_trusted_profile_id`   // group required (or ibm_trusted_profile_name)
+	ibmTrustedProfileNameKey = `ibm_trusted_profile_name` // group required (or ibm_trusted_profile_id)
+	ibmIAMAccountIDKey       = `ibm_iam_account_id`       // required with ibm_trusted_profile_name
+	ibmSourceProfileKey      = `ibm_source_profile`       // optional
+
+	// IBM COS service credentials JSON file, providing IBM IAM and HMAC credentials
+	ibmServiceCredentialsFileKey = `ibm_service_credentials_file`
+
+	// Network IBM COS endpoints are resolved for
+	ibmCOSNetworkTypeKey = `ibm_cos_network_type`
+	// IBM COS SDK Code -- END
 )
 
 // sharedConfig represents the configuration fields of the SDK config files.
@@ -113,6 +136,56 @@ type sharedConfig struct {
 	S3UseARNRegion bool
 	// use_dualstack_endpoint=true
 	UseDualStackEndpoint endpoints.DualStackEndpointState
+
+	// IBM COS SDK Code -- START
+	// Specifies if requests should be signed with the HMAC credentials by
+	// default when both IBM IAM and HMAC credentials are available.
+	//
+	//	ibm_use_hmac_signing = true
+	UseHMACSigning *bool
+
+	// IBM IAM credentials of the profile, a service ID API key or the path
+	// to a compute resource token file. Used to assume the IBM IAM trusted
+	// profile of this profile, or of profiles referencing this profile with
+	// ibm_source_profile.
+	//
+	//	ibm_api_key_id
+	//	ibm_cr_token_file_path
+	//	ibm_auth_endpoint
+	//	ibm_service_instance_id
+	IBMAPIKeyID          string
+	IBMCRTokenFilePath   string
+	IBMAuthEndpoint      string
+	IBMServiceInstanceID string
+
+	// IBM IAM trusted profile assumed with the IBM IAM credentials of the
+	// profile, or of the profile named by ibm_source_profile. The trusted
+	// profile is identified by ID, or by name and account ID.
+	//
+	//	ibm_trusted_profile_id
+	//	ibm_trusted_profile_name
+	//	ibm_iam_account_id
+	//	ibm_source_profile
+	IBMTrustedProfileID   string
+	IBMTrustedProfileName string
+	IBMIAMAccountID       string
+	IBMSourceProfileName  string
+	IBMSourceProfile      *sharedConfig
+
+	// Path to the IBM COS service credentials JSON downloaded from the IBM
+	// Cloud console. Its API key and HMAC keys are used as the IBM IAM and
+	// HMAC credentials of the profile.
+	//
+	//	ibm_service_credentials_file
+	IBMServiceCredentialsFile string
+
+	// Specifies the network IBM COS endpoints are resolved for, selecting the
+	// IBM COS endpoint resolver. The region is used as the IBM COS location.
+	//
+	//	ibm_cos_network_type = private
+	// This can take value as `public`, `private` or `direct`
+	IBMCOSNetworkType endpoints.IBMCOSNetworkType
+	// IBM COS SDK Code -- END
 }
 
 type sharedConfigFile struct {
@@ -176,6 +249,7 @@ func (cfg *sharedConfig) setFromIniFiles(profiles map[string]struct{}, profile s
 	// Trim files from the list that don't exist.
 	var skippedFiles int
 	var profileNotFoundErr error
+	var profileFilename string
 	for _, f := range files {
 		if err := cfg.setFromIniFile(profile, f, exOpts); err != nil {
 			if _, ok := err.(SharedConfigProfileNotExistsError); ok {
@@ -186,6 +260,7 @@ func (cfg *sharedConfig) setFromIniFiles(profiles map[string]struct{}, profile s
 			}
 			return err
 		}
+		profileFilename = f.Filename
 	}
 	if skippedFiles == len(files) {
 		// If all files were skipped because the profile is not found, return
@@ -214,9 +289,90 @@ func (cfg *sharedConfig) setFromIniFiles(profiles map[string]struct{}, profile s
 		cfg.SourceProfile = srcCfg
 	}
 
+	// IBM COS SDK Code -- START
+	if err := cfg.setIBMSourceProfile(profiles, files, exOpts); err != nil {
+		if _, ok := err.(SharedConfigIBMTrustedProfileError); ok {
+			return SharedConfigLoadError{Filename: profileFilename, Err: err}
+		}
+		return err
+	}
+	// IBM COS SDK Code -- END
+
+	return nil
+}
+
+// IBM COS SDK Code -- START
+// setIBMSourceProfile validates the IBM IAM trusted profile options of the
+// profile, and links the profile named by ibm_source_profile. Source profiles
+// may reference further profiles, the IBM IAM credentials are taken from the
+// last profile of the chain.
+func (cfg *sharedConfig) setIBMSourceProfile(profiles map[string]struct{}, files []sharedConfigFile, exOpts bool) error {
+	hasCreds := len(cfg.IBMAPIKeyID) != 0 || len(cfg.IBMCRTokenFilePath) != 0
+	hasTrustedProfile := len(cfg.IBMTrustedProfileID) != 0 || len(cfg.IBMTrustedProfileName) != 0
+
+	switch {
+	case len(cfg.IBMAPIKeyID) != 0 && len(cfg.IBMCRTokenFilePath) != 0:
+		return SharedConfigIBMTrustedProfileError{Profile: cfg.Profile,
+			Reason: fmt.Sprintf("only one of %s or %s can be set", ibmAPIKeyIDKey, ibmCRTokenFilePathKey)}
+	case len(cfg.IBMTrustedProfileID) != 0 && len(cfg.IBMTrustedProfileName) != 0:
+		return SharedConfigIBMTrustedProfileError{Profile: cfg.Profile,
+			Reason: fmt.Sprintf("only one of %s or %s can be set", ibmTrustedProfileIDKey, ibmTrustedProfileNameKey)}
+	case len(cfg.IBMTrustedProfileName) != 0 && len(cfg.IBMIAMAccountID) == 0:
+		return SharedConfigIBMTrustedProfileError{Profile: cfg.Profile,
+			Reason: fmt.Sprintf("%s is required with %s", ibmIAMAccountIDKey, ibmTrustedProfileNameKey)}
+	case len(cfg.IBMSourceProfileName) == 0:
+		if hasTrustedProfile && !hasCreds {
+			return SharedConfigIBMTrustedProfileError{Profile: cfg.Profile,
+				Reason: fmt.Sprintf("%s, %s or %s is required with the trusted profile",
+					ibmAPIKeyIDKey, ibmCRTokenFilePathKey, ibmSourceProfileKey)}
+		}
+		return nil
+	case hasCreds:
+		return SharedConfigIBMTrustedProfileError{Profile: cfg.Profile, SourceProfile: cfg.IBMSourceProfileName,
+			Reason: fmt.Sprintf("%s cannot be set with %s or %s", ibmSourceProfileKey, ibmAPIKeyIDKey,
+				ibmCRTokenFilePathKey)}
+	}
+
+	if _, ok := profiles[cfg.IBMSourceProfileName]; ok {
+		return SharedConfigIBMTrustedProfileError{Profile: cfg.Profile, SourceProfile: cfg.IBMSourceProfileName,
+			Reason: "source profiles form a cycle"}
+	}
+
+	srcCfg := &sharedConfig{}
+	if err := srcCfg.setFromIniFiles(profiles, cfg.IBMSourceProfileName, files, exOpts); err != nil {
+		if _, ok := err.(SharedConfigProfileNotExistsError); ok {
+			return SharedConfigIBMTrustedProfileError{Profile: cfg.Profile, SourceProfile: cfg.IBMSourceProfileName,
+				Reason: "source profile does not exist"}
+		}
+		return err
+	}
+	if src := srcCfg.ibmCredentialsSource(); len(src.IBMAPIKeyID) == 0 && len(src.IBMCRTokenFilePath) == 0 {
+		return SharedConfigIBMTrustedProfileError{Profile: cfg.Profile, SourceProfile: cfg.IBMSourceProfileName,
+			Reason: "source profile has no IBM IAM credentials"}
+	}
+
+	cfg.IBMSourceProfile = srcCfg
 	return nil
 }
 
+// ibmCredentialsSource returns the profile holding the IBM IAM credentials,
+// the last profile of the ibm_source_profile chain.
+func (cfg *sharedConfig) ibmCredentialsSource() *sharedConfig {
+	src := cfg
+	for src.IBMSourceProfile != nil {
+		src = src.IBMSourceProfile
+	}
+	return src
+}
+
+// hasIBMTrustedProfile returns if the profile assumes an IBM IAM trusted
+// profile.
+func (cfg *sharedConfig) hasIBMTrustedProfile() bool {
+	return len(cfg.IBMTrustedProfileID) != 0 || len(cfg.IBMTrustedProfileName) != 0
+}
+
+// IBM COS SDK Code -- END
+
 // setFromFile loads the configuration from the file using the profile
 // provided. A sharedConfig pointer type value is used so that multiple config
 // file loadings can be chained.
@@ -284,6 +440,30 @@ func (cfg *sharedConfig) setFromIniFile(profile string, file sharedConfigFile, e
 
 	updateBool(&cfg.S3UseARNRegion, section, s3UseARNRegionKey)
 
+	// IBM COS SDK Code -- START
+	updateBoolPtr(&cfg.UseHMACSigning, section, ibmUseHMACSigningKey)
+
+	// IBM IAM Credentials and Trusted Profile
+	updateString(&cfg.IBMAPIKeyID, section, ibmAPIKeyIDKey)
+	updateString(&cfg.IBMCRTokenFilePath, section, ibmCRTokenFilePathKey)
+	updateString(&cfg.IBMAuthEndpoint, section, ibmAuthEndpointKey)
+	updateString(&cfg.IBMServiceInstanceID, section, ibmServiceInstanceIDKey)
+	updateString(&cfg.IBMTrustedProfileID, section, ibmTrustedProfileIDKey)
+	updateString(&cfg.IBMTrustedProfileName, section, ibmTrustedProfileNameKey)
+	updateString(&cfg.IBMIAMAccountID, section, ibmIAMAccountIDKey)
+	updateString(&cfg.IBMSourceProfileName, section, ibmSourceProfileKey)
+	updateString(&cfg.IBMServiceCredentialsFile, section, ibmServiceCredentialsFileKey)
+
+	if v := section.String(ibmCOSNetworkTypeKey); len(v) != 0 {
+		networkType, err := endpoints.GetIBMCOSNetworkType(v)
+		if err != nil {
+			return fmt.Errorf("failed to load %s from shared config, %s, %v",
+				ibmCOSNetworkTypeKey, file.Filename, err)
+		}
+		cfg.IBMCOSNetworkType = networkType
+	}
+	// IBM COS SDK Code -- END
+
 	return nil
 }
 
@@ -447,3 +627,40 @@ func (e SharedConfigAssumeRoleError) OrigErr() error {
 func (e SharedConfigAssumeRoleError) Error() string {
 	return awserr.SprintError(e.Code(), e.Message(), "", nil)
 }
+
+// IBM COS SDK Code -- START
+
+// SharedConfigIBMTrustedProfileError is an error for the shared config when
+// the profile contains IBM IAM trusted profile information, but that
+// information is invalid or not complete, or its source profiles form a cycle.
+type SharedConfigIBMTrustedProfileError struct {
+	Profile       string
+	SourceProfile string
+	Reason        string
+}
+
+// Code is the short id of the error.
+func (e SharedConfigIBMTrustedProfileError) Code() string {
+	return "SharedConfigIBMTrustedProfileError"
+}
+
+// Message is the description of the error
+func (e SharedConfigIBMTrustedProfileError) Message() string {
+	if len(e.SourceProfile) != 0 {
+		return fmt.Sprintf("failed to load IBM trusted profile for %s, source profile %s, %s",
+			e.Profile, e.SourceProfile, e.Reason)
+	}
+	return fmt.Sprintf("failed to load IBM trusted profile for %s, %s", e.Profile, e.Reason)
+}
+
+// OrigErr is the underlying error that caused the failure.
+func (e SharedConfigIBMTrustedProfileError) OrigErr() error {
+	return nil
+}
+
+// Error satisfies the error interface.
+func (e SharedConfigIBMTrustedProfileError) Error() string {
+	return awserr.SprintError(e.Code(), e.Message(), "", nil)
+}
+
+// IBM COS SDK Code -- END
@@ -11,7 +11,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
 	"github.com/IBM/ibm-cos-sdk-go/aws/endpoints"
 	"github.com/IBM/ibm-cos-sdk-go/internal/ini"
@@ -56,6 +55,15 @@ func TestLoadSharedConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			Filenames: []string{testConfigFilename},
+			Profile:   "ibm_cos_network_type",
+			Expected: sharedConfig{
+				Profile:           "ibm_cos_network_type",
+				Region:            "us-south",
+				IBMCOSNetworkType: endpoints.IBMCOSPrivateNetwork,
+			},
+		},
 		{
 			Filenames: []string{testConfigFilename, testConfigOtherFilename},
 			Profile:   "config_file_load_order",
@@ -224,3 +232,132 @@ func TestLoadSharedConfigIniFiles(t *testing.T) {
 		})
 	}
 }
+
+// IBM COS SDK Code -- START
+func TestLoadSharedConfig_IBMTrustedProfile(t *testing.T) {
+	serviceID := sharedConfig{
+		Profile:              "ibm_service_id",
+		IBMAPIKeyID:          "ibm_service_id_api_key",
+		IBMAuthEndpoint:      "ibm_service_id_auth_endpoint",
+		IBMServiceInstanceID: "ibm_service_id_service_instance_id",
+	}
+	byID := sharedConfig{
+		Profile:              "ibm_trusted_profile_by_id",
+		IBMTrustedProfileID:  "ibm_trusted_profile_id",
+		IBMSourceProfileName: "ibm_service_id",
+		IBMSourceProfile:     &serviceID,
+	}
+
+	cases := []struct {
+		Profile  string
+		Expected sharedConfig
+		Err      error
+	}{
+		{
+			Profile:  "ibm_trusted_profile_by_id",
+			Expected: byID,
+		},
+		{
+			Profile: "ibm_trusted_profile_by_name",
+			Expected: sharedConfig{
+				Profile:               "ibm_trusted_profile_by_name",
+				IBMTrustedProfileName: "ibm_trusted_profile_name",
+				IBMIAMAccountID:       "ibm_iam_account_id",
+				IBMServiceInstanceID:  "ibm_trusted_profile_service_instance_id",
+				IBMSourceProfileName:  "ibm_trusted_profile_by_id",
+				IBMSourceProfile:      &byID,
+			},
+		},
+		{
+			Profile: "ibm_trusted_profile_cr_token",
+			Expected: sharedConfig{
+				Profile:             "ibm_trusted_profile_cr_token",
+				IBMTrustedProfileID: "ibm_trusted_profile_id",
+				IBMCRTokenFilePath:  "ibm_cr_token_file_path",
+			},
+		},
+		{
+			Profile: "ibm_trusted_profile_cycle_a",
+			Err: SharedConfigIBMTrustedProfileError{
+				Profile:       "ibm_trusted_profile_cycle_b",
+				SourceProfile: "ibm_trusted_profile_cycle_a",
+				Reason:        "source profiles form a cycle",
+			},
+		},
+		{
+			Profile: "ibm_trusted_profile_no_source_creds",
+			Err: SharedConfigIBMTrustedProfileError{
+				Profile:       "ibm_trusted_profile_no_source_creds",
+				SourceProfile: "complete_creds",
+				Reason:        "source profile has no IBM IAM credentials",
+			},
+		},
+		{
+			Profile: "ibm_trusted_profile_missing_source",
+			Err: SharedConfigIBMTrustedProfileError{
+				Profile:       "ibm_trusted_profile_missing_source",
+				SourceProfile: "does_not_exist",
+				Reason:        "source profile does not exist",
+			},
+		},
+		{
+			Profile: "ibm_trusted_profile_no_creds",
+			Err: SharedConfigIBMTrustedProfileError{
+				Profile: "ibm_trusted_profile_no_creds",
+				Reason:  "ibm_api_key_id, ibm_cr_token_file_path or ibm_source_profile is required with the trusted profile",
+			},
+		},
+		{
+			Profile: "ibm_trusted_profile_name_no_account",
+			Err: SharedConfigIBMTrustedProfileError{
+				Profile: "ibm_trusted_profile_name_no_account",
+				Reason:  "ibm_iam_account_id is required with ibm_trusted_profile_name",
+			},
+		},
+		{
+			Profile: "ibm_trusted_profile_id_and_name",
+			Err: SharedConfigIBMTrustedProfileError{
+				Profile: "ibm_trusted_profile_id_and_name",
+				Reason:  "only one of ibm_trusted_profile_id or ibm_trusted_profile_name can be set",
+			},
+		},
+		{
+			Profile: "ibm_trusted_profile_source_collision",
+			Err: SharedConfigIBMTrustedProfileError{
+				Profile:       "ibm_trusted_profile_source_collision",
+				SourceProfile: "ibm_service_id",
+				Reason:        "ibm_source_profile cannot be set with ibm_api_key_id or ibm_cr_token_file_path",
+			},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i)+"_"+c.Profile, func(t *testing.T) {
+			cfg, err := loadSharedConfig(c.Profile, []string{testConfigFilename}, true)
+			if c.Err != nil {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				if _, ok := err.(SharedConfigLoadError); !ok {
+					t.Errorf("expect SharedConfigLoadError, got %T", err)
+				}
+				if e, a := c.Err.Error(), err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect %v, to be in %v", e, a)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expected, cfg; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			if e, a := "ibm_service_id_api_key", cfg.ibmCredentialsSource().IBMAPIKeyID; c.Expected.IBMSourceProfile != nil && e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+// IBM COS SDK Code -- END
@@ -39,7 +39,8 @@ func Sign(req *request.Request) {
 	// The objects includes:
 	//		IBM IAM Token
 	//		IBM IAM Service Instance ID
-	value, err := req.Config.Credentials.Get()
+	// The request context stops waiting for the token when canceled
+	value, err := req.Config.Credentials.GetWithContext(req.Context())
 	if err != nil {
 		if logger != nil {
 			logger.Log(debugLog, signRequestHandlerLog, "CREDENTIAL GET ERROR", err)
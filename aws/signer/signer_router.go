@@ -74,6 +74,13 @@ func (r requestSignerRouter) delegateRequestToSigner(req *request.Request) {
 		logger = nil
 	}
 
+	if aws.BoolValue(req.Config.UseHMACSigning) && req.Config.HMACCredentials != nil {
+		if logger != nil {
+			logger.Log(debugLog, signerRouterLog, "Using HMACCredentials")
+		}
+		req.Config.Credentials = req.Config.HMACCredentials
+	}
+
 	if req.Config.Credentials == credentials.AnonymousCredentials {
 		if logger != nil {
 			logger.Log(debugLog, signerRouterLog, "AnonymousCredentials")
@@ -81,7 +88,7 @@ func (r requestSignerRouter) delegateRequestToSigner(req *request.Request) {
 		return
 	}
 
-	value, err := req.Config.Credentials.Get()
+	value, err := req.Config.Credentials.GetWithContext(req.Context())
 	if err != nil {
 		if logger != nil {
 			logger.Log(debugLog, signerRouterLog, "CREDENTIAL GET ERROR", err)
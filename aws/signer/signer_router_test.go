@@ -104,3 +104,35 @@ func TestRouterMissingHandler(t *testing.T) {
 	assert.Equal(t, true, strings.Contains(err.Error(), "No Handler Found for Type "+marker))
 
 }
+
+func TestRouterHMACSigning(t *testing.T) {
+
+	rt := getMockRouter()
+	s := awstesting.NewClient(aws.NewConfig().WithMaxRetries(0).
+		WithHMACCredentials(buildCredentials("2")))
+	s.Handlers.Clear()
+	s.Handlers.Sign.PushBackNamed(rt)
+
+	cases := []struct {
+		UseHMACSigning *bool
+		Options        []request.Option
+		Expect         string
+	}{
+		{Expect: "sg1"},
+		{UseHMACSigning: aws.Bool(false), Expect: "sg1"},
+		{UseHMACSigning: aws.Bool(true), Expect: "sg2"},
+		{UseHMACSigning: aws.Bool(true), Options: []request.Option{request.WithHMACSigning(false)}, Expect: "sg1"},
+		{Options: []request.Option{request.WithHMACSigning(true)}, Expect: "sg2"},
+	}
+
+	for i, c := range cases {
+		r := s.NewRequest(&request.Operation{Name: "Operation"}, nil, nil)
+		r.Config.Credentials = buildCredentials("1")
+		r.Config.UseHMACSigning = c.UseHMACSigning
+		r.ApplyOptions(c.Options...)
+		err := r.Send()
+		assert.Equal(t, nil, err, "unpexpected error")
+		assert.Equal(t, c.Expect, r.HTTPRequest.Header.Get("signer"), fmt.Sprintf("%d, Signer did not Match", i))
+	}
+
+}
@@ -11,13 +11,104 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	// IBM COS SDK Code -- START
+	"path/filepath"
+	"strings"
+	// IBM COS SDK Code -- END
 
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	// IBM COS SDK Code -- START
+	"github.com/IBM/ibm-cos-sdk-go/awstesting/recording"
+	// IBM COS SDK Code -- END
 )
 
-// Session is a shared session for all integration tests to use.
-var Session = session.Must(session.NewSession())
+// IBM COS SDK Code -- START
+
+// RecordingDirEnvVar is the environment variable of the directory the
+// integration tests record their HTTP interactions to, or replay them from,
+// depending on the AWS_SDK_GO_RECORDING mode. Replay is the default mode.
+//
+// Each test package has its own recording in the directory, named after the
+// package's path in the SDK, e.g. service_s3_s3crypto.json. A relative
+// directory is relative to the root of the SDK, so all packages of
+// "go test ./..." share it.
+const RecordingDirEnvVar = "AWS_SDK_GO_RECORDING_DIR"
+
+// Recorder records or replays the HTTP interactions of the integration
+// session, nil when AWS_SDK_GO_RECORDING_DIR is not set.
+var Recorder = newRecorder()
+
+func newRecorder() *recording.Recorder {
+	dir := os.Getenv(RecordingDirEnvVar)
+	if len(dir) == 0 {
+		return nil
+	}
+
+	path, err := recordingPath(dir)
+	if err != nil {
+		panic(err)
+	}
+	r, err := recording.New(path, recording.ModeFromEnv(recording.ModeReplay))
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// recordingPath returns the path of the recording of the test package, the
+// working directory of the test, in the recording directory.
+func recordingPath(dir string) (string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	root := wd
+	for {
+		if _, err := os.Stat(filepath.Join(root, "go.mod")); err == nil {
+			break
+		}
+		parent := filepath.Dir(root)
+		if parent == root {
+			return "", fmt.Errorf("integration: go.mod not found above %s", wd)
+		}
+		root = parent
+	}
+
+	pkg, err := filepath.Rel(root, wd)
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(root, dir)
+	}
+	name := strings.Replace(filepath.ToSlash(pkg), "/", "_", -1) + ".json"
+	return filepath.Join(dir, name), nil
+}
+
+// Session is a shared session for all integration tests to use. The session
+// uses the Recorder as HTTP client, for both service and IAM token requests.
+var Session = newSession()
+
+func newSession() *session.Session {
+	cfg := &aws.Config{}
+	if Recorder != nil {
+		cfg.HTTPClient = Recorder.HTTPClient()
+	}
+	return session.Must(session.NewSession(cfg))
+}
+
+// StopRecording saves the recorded HTTP interactions of the integration
+// session. Must be called once the tests have completed, e.g. in TestMain.
+func StopRecording() error {
+	if Recorder == nil {
+		return nil
+	}
+	return Recorder.Stop()
+}
+
+// IBM COS SDK Code -- END
 
 func init() {
 	logLevel := Session.Config.LogLevel
@@ -36,6 +127,15 @@ func init() {
 // UniqueID returns a unique UUID-like identifier for use in generating
 // resources for integration tests.
 func UniqueID() string {
+	// IBM COS SDK Code -- START
+	if Recorder != nil {
+		return Recorder.UniqueID(uniqueID)
+	}
+	return uniqueID()
+}
+
+func uniqueID() string {
+	// IBM COS SDK Code -- END
 	uuid := make([]byte, 16)
 	io.ReadFull(rand.Reader, uuid)
 	return fmt.Sprintf("%x", uuid)
@@ -193,5 +193,11 @@ func benchDownload(b *testing.B, bucket, key string, body io.WriterAt, sdkConfig
 func TestMain(m *testing.M) {
 	benchConfig.SetupFlags("", flag.CommandLine)
 	flag.Parse()
-	os.Exit(m.Run())
+	// IBM COS SDK Code -- START
+	result := m.Run()
+	if err := integration.StopRecording(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	os.Exit(result)
+	// IBM COS SDK Code -- END
 }
@@ -0,0 +1,402 @@
+// Package recording provides an http.RoundTripper recording HTTP interactions
+// to golden files, and replaying them, so integration tests can run offline
+// and deterministically.
+//
+// In ModeRecord requests are sent with the real transport, and the
+// interactions are saved to the file by Stop with credentials, signatures and
+// IBM IAM tokens scrubbed. In ModeReplay responses are served from the file,
+// matching requests on method, path, canonical query and the MatchHeaders.
+//
+//	rec, err := recording.New("testdata/put_object.json", recording.ModeFromEnv(recording.ModeReplay))
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	defer rec.Stop()
+//
+//	sess := session.Must(session.NewSession(&aws.Config{HTTPClient: rec.HTTPClient()}))
+//
+// The HTTP client is also used by the IBM IAM token manager of the session's
+// credentials, so token requests are recorded and replayed too. A custom CA
+// bundle cannot be loaded into the recorder's client by the session, set the
+// recorder's Transport instead.
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode is the mode of a Recorder.
+type Mode string
+
+// Recorder modes.
+const (
+	// ModeReplay serves recorded responses, without network access.
+	ModeReplay Mode = "replay"
+
+	// ModeRecord sends requests with the real transport and records the
+	// interactions.
+	ModeRecord Mode = "record"
+)
+
+// ModeEnvVar is the environment variable read by ModeFromEnv.
+const ModeEnvVar = "AWS_SDK_GO_RECORDING"
+
+// ModeFromEnv returns the mode of the AWS_SDK_GO_RECORDING environment
+// variable, or the default mode if it is not set.
+func ModeFromEnv(defaultMode Mode) Mode {
+	if mode := Mode(os.Getenv(ModeEnvVar)); len(mode) != 0 {
+		return mode
+	}
+	return defaultMode
+}
+
+// DefaultMatchHeaders are the request headers matched on replay by default.
+var DefaultMatchHeaders = []string{
+	"Range",
+	"If-Match",
+	"If-None-Match",
+	"X-Amz-Copy-Source",
+	"X-Amz-Copy-Source-Range",
+}
+
+// DefaultMaxRequestBodySize is the largest request body recorded. Request
+// bodies are not matched on replay, larger bodies are recorded by size only.
+const DefaultMaxRequestBodySize = 64 * 1024
+
+// Recording is the content of a recording file.
+type Recording struct {
+	// Unique IDs returned by Recorder.UniqueID, in order.
+	IDs []string `json:"ids,omitempty"`
+
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   Body        `json:"body"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body"`
+}
+
+// Body is a recorded HTTP body, stored as text when valid UTF-8.
+type Body struct {
+	Text   string `json:"text,omitempty"`
+	Base64 []byte `json:"base64,omitempty"`
+
+	// Size of the body, set for request bodies too large to be recorded.
+	Size int64 `json:"size,omitempty"`
+}
+
+func newBody(b []byte) Body {
+	if utf8.Valid(b) {
+		return Body{Text: string(b)}
+	}
+	return Body{Base64: b}
+}
+
+// Bytes returns the content of the body.
+func (b Body) Bytes() []byte {
+	if len(b.Base64) != 0 {
+		return b.Base64
+	}
+	return []byte(b.Text)
+}
+
+// Recorder is an http.RoundTripper recording or replaying HTTP interactions.
+// A Recorder must be created with New, and is safe for concurrent use.
+type Recorder struct {
+	// Path of the recording file.
+	Path string
+
+	Mode Mode
+
+	// Transport sending the requests in ModeRecord. Defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// Request headers matched on replay, in addition to the method, path and
+	// canonical query.
+	MatchHeaders []string
+
+	// Largest request body recorded.
+	MaxRequestBodySize int64
+
+	// Scrubbers applied to each interaction before it is recorded. Defaults
+	// to DefaultScrubbers.
+	Scrubbers []Scrubber
+
+	m         sync.Mutex
+	recording Recording
+	used      []bool
+	nextID    int
+}
+
+// New creates a Recorder for the recording file. In ModeReplay the recording
+// file is loaded, and must exist. Pass in additional functional options to
+// customize the recorder's behavior.
+func New(path string, mode Mode, options ...func(*Recorder)) (*Recorder, error) {
+	r := &Recorder{
+		Path:               path,
+		Mode:               mode,
+		Transport:          http.DefaultTransport,
+		MatchHeaders:       DefaultMatchHeaders,
+		MaxRequestBodySize: DefaultMaxRequestBodySize,
+		Scrubbers:          DefaultScrubbers,
+	}
+
+	for _, option := range options {
+		option(r)
+	}
+
+	switch r.Mode {
+	case ModeRecord:
+	case ModeReplay:
+		b, err := ioutil.ReadFile(r.Path)
+		if err != nil {
+			return nil, fmt.Errorf("recording: failed to read recording, %v", err)
+		}
+		if err := json.Unmarshal(b, &r.recording); err != nil {
+			return nil, fmt.Errorf("recording: failed to decode recording %s, %v", r.Path, err)
+		}
+		r.used = make([]bool, len(r.recording.Interactions))
+	default:
+		return nil, fmt.Errorf("recording: unknown mode %q", r.Mode)
+	}
+
+	return r, nil
+}
+
+// HTTPClient returns an HTTP client using the recorder as transport, for
+// aws.Config.HTTPClient.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// UniqueID returns a random ID in ModeRecord, recording it, and the recorded
+// IDs in order in ModeReplay. Tests naming resources with UniqueID send the
+// same requests on replay.
+func (r *Recorder) UniqueID(generate func() string) string {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.Mode == ModeRecord {
+		id := generate()
+		r.recording.IDs = append(r.recording.IDs, id)
+		return id
+	}
+
+	if r.nextID < len(r.recording.IDs) {
+		id := r.recording.IDs[r.nextID]
+		r.nextID++
+		return id
+	}
+	return generate()
+}
+
+// Stop saves the recorded interactions to the recording file in ModeRecord.
+func (r *Recorder) Stop() error {
+	if r.Mode != ModeRecord {
+		return nil
+	}
+
+	r.m.Lock()
+	b, err := json.MarshalIndent(&r.recording, "", "  ")
+	r.m.Unlock()
+	if err != nil {
+		return fmt.Errorf("recording: failed to encode recording, %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.Path), 0755); err != nil {
+		return fmt.Errorf("recording: failed to create recording directory, %v", err)
+	}
+	if err := ioutil.WriteFile(r.Path, append(b, '\n'), 0644); err != nil {
+		return fmt.Errorf("recording: failed to write recording, %v", err)
+	}
+	return nil
+}
+
+// RoundTrip records or replays the request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.Mode == ModeRecord {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		reqBody = b
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+
+	resp, err := r.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: req.Header.Clone(),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       newBody(respBody),
+		},
+	}
+	if int64(len(reqBody)) <= r.MaxRequestBodySize {
+		interaction.Request.Body = newBody(reqBody)
+	} else {
+		interaction.Request.Body = Body{Size: int64(len(reqBody))}
+	}
+	for _, scrub := range r.Scrubbers {
+		scrub(interaction)
+	}
+
+	r.m.Lock()
+	r.recording.Interactions = append(r.recording.Interactions, interaction)
+	r.m.Unlock()
+
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction matching the
+// request. Once all matching interactions are used, the last one is replayed
+// again, e.g. for polling.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		ioutil.ReadAll(req.Body)
+		req.Body.Close()
+	}
+	key := r.matchKey(req.Method, req.URL.String(), req.Header)
+
+	r.m.Lock()
+	found := -1
+	for i, interaction := range r.recording.Interactions {
+		if r.matchKey(interaction.Request.Method, interaction.Request.URL, interaction.Request.Header) != key {
+			continue
+		}
+		found = i
+		if !r.used[i] {
+			break
+		}
+	}
+	if found >= 0 {
+		r.used[found] = true
+	}
+	r.m.Unlock()
+
+	if found < 0 {
+		return nil, fmt.Errorf("recording: no recorded interaction for %s %s in %s",
+			req.Method, req.URL.String(), r.Path)
+	}
+
+	interaction := r.recording.Interactions[found]
+	body := interaction.Response.Body.Bytes()
+	for _, rewrite := range replayRewriters {
+		body = rewrite(interaction, body)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        interaction.Response.Header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// matchKey returns the key requests are matched on, the method, path,
+// canonical query and match headers.
+func (r *Recorder) matchKey(method, rawURL string, header http.Header) string {
+	var b strings.Builder
+	b.WriteString(method)
+	b.WriteString(" ")
+	b.WriteString(canonicalURL(rawURL))
+	for _, name := range r.MatchHeaders {
+		if values := header.Values(name); len(values) != 0 {
+			b.WriteString("\n" + http.CanonicalHeaderKey(name) + ": " + strings.Join(values, ","))
+		}
+	}
+	return b.String()
+}
+
+// canonicalURL returns the path and sorted query of the URL, without the
+// query parameters scrubbed from recordings.
+func canonicalURL(rawURL string) string {
+	path, query := rawURL, ""
+	if i := strings.Index(rawURL, "?"); i >= 0 {
+		path, query = rawURL[:i], rawURL[i+1:]
+	}
+	if i := strings.Index(path, "://"); i >= 0 {
+		path = path[i+3:]
+		if j := strings.Index(path, "/"); j >= 0 {
+			path = path[j:]
+		} else {
+			path = "/"
+		}
+	}
+
+	var params []string
+	for _, param := range strings.Split(query, "&") {
+		if len(param) == 0 {
+			continue
+		}
+		name := param
+		if i := strings.Index(param, "="); i >= 0 {
+			name = param[:i]
+		}
+		if _, ok := scrubbedQueryParams[strings.ToLower(name)]; ok {
+			continue
+		}
+		params = append(params, param)
+	}
+	sort.Strings(params)
+
+	if len(params) == 0 {
+		return path
+	}
+	return path + "?" + strings.Join(params, "&")
+}
@@ -0,0 +1,297 @@
+package recording_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/awstesting"
+	"github.com/IBM/ibm-cos-sdk-go/awstesting/recording"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+const (
+	testAPIKey      = "test-secret-api-key"
+	testAccessToken = "test-secret-access-token"
+)
+
+// newServer returns a server responding to IAM token requests and to
+// PutObject and GetObject requests of a single object.
+func newServer(t *testing.T) *httptest.Server {
+	var m sync.Mutex
+	objects := map[string]string{}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/identity/token" {
+			r.ParseForm()
+			if e, a := testAPIKey, r.PostForm.Get("apikey"); e != a {
+				t.Errorf("expect %v api key, got %v", e, a)
+			}
+			fmt.Fprintf(w, `{"access_token":%q,"refresh_token":"test-secret-refresh-token","token_type":"Bearer","expires_in":3600,"expiration":%d}`,
+				testAccessToken, time.Now().Unix()+3600)
+			return
+		}
+
+		if e, a := "Bearer "+testAccessToken, r.Header.Get("Authorization"); e != a {
+			t.Errorf("expect %v authorization, got %v", e, a)
+		}
+		m.Lock()
+		defer m.Unlock()
+		switch r.Method {
+		case "PUT":
+			b, _ := ioutil.ReadAll(r.Body)
+			objects[r.URL.Path] = string(b)
+			w.Header().Set("ETag", `"etag"`)
+		case "GET":
+			body, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if r.Header.Get("Range") == "bytes=0-4" {
+				body = body[:5]
+			}
+			w.Header().Set("Set-Cookie", "session=test-secret-cookie")
+			fmt.Fprint(w, body)
+		}
+	}))
+}
+
+func newClient(rec *recording.Recorder, endpoint string) *s3.S3 {
+	cfg := &aws.Config{
+		Endpoint:         aws.String(endpoint),
+		Region:           aws.String("us-south"),
+		S3ForcePathStyle: aws.Bool(true),
+		HTTPClient:       rec.HTTPClient(),
+	}
+	cfg.Credentials = ibmiam.NewStaticCredentials(cfg, endpoint+"/identity/token", testAPIKey, "instance-id")
+	return s3.New(session.Must(session.NewSession(cfg)))
+}
+
+func getObject(t *testing.T, svc *s3.S3, key string, rangeHeader *string) string {
+	resp, err := svc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String(key),
+		Range:  rangeHeader,
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return string(b)
+}
+
+func TestRecorder_RecordReplay(t *testing.T) {
+	env := awstesting.StashEnv()
+	defer awstesting.PopEnv(env)
+
+	dir, err := ioutil.TempDir("", "recording")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "testdata", "recording.json")
+
+	var keys []string
+	run := func(rec *recording.Recorder, endpoint string) {
+		svc := newClient(rec, endpoint)
+		key := rec.UniqueID(func() string { return fmt.Sprintf("key-%d", time.Now().UnixNano()) })
+		keys = append(keys, key)
+
+		_, err := svc.PutObject(&s3.PutObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String(key),
+			Body:   strings.NewReader("hello world"),
+		})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "hello world", getObject(t, svc, key, nil); e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+		if e, a := "hello", getObject(t, svc, key, aws.String("bytes=0-4")); e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	}
+
+	server := newServer(t)
+	rec, err := recording.New(path, recording.ModeRecord)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	run(rec, server.URL)
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	server.Close()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	for _, secret := range []string{testAPIKey, testAccessToken, "test-secret-refresh-token", "test-secret-cookie"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("expect %v to be scrubbed from recording", secret)
+		}
+	}
+	var r recording.Recording
+	if err := json.Unmarshal(b, &r); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 4, len(r.Interactions); e != a {
+		t.Errorf("expect %v interactions, got %v", e, a)
+	}
+
+	// The server is closed, responses are replayed from the recording.
+	rec, err = recording.New(path, recording.ModeReplay)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	run(rec, server.URL)
+	if e, a := keys[0], keys[1]; e != a {
+		t.Errorf("expect replayed unique ID %v, got %v", e, a)
+	}
+
+	svc := newClient(rec, server.URL)
+	_, err = svc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("not-recorded"),
+	})
+	if err == nil || !strings.Contains(err.Error(), "no recorded interaction") {
+		t.Errorf("expect no recorded interaction error, got %v", err)
+	}
+}
+
+func TestRecorder_ReplayIAMTokenExpiration(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recording")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "recording.json")
+
+	r := recording.Recording{
+		Interactions: []*recording.Interaction{{
+			Request: recording.Request{
+				Method: "POST",
+				URL:    "https://iam.cloud.ibm.com/identity/token",
+				Header: http.Header{"Content-Type": []string{"application/x-www-form-urlencoded"}},
+				Body:   recording.Body{Text: "apikey=REDACTED&grant_type=urn%3Aibm%3Aparams%3Aoauth%3Agrant-type%3Aapikey"},
+			},
+			Response: recording.Response{
+				StatusCode: http.StatusOK,
+				Body:       recording.Body{Text: `{"access_token":"REDACTED","expires_in":3600,"expiration":1000}`},
+			},
+		}},
+	}
+	b, _ := json.Marshal(r)
+	if err := ioutil.WriteFile(path, b, 0644); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	rec, err := recording.New(path, recording.ModeReplay)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	resp, err := rec.HTTPClient().Post("https://iam.cloud.ibm.com/identity/token",
+		"application/x-www-form-urlencoded", strings.NewReader("apikey=key&grant_type=urn%3Aibm%3Aparams%3Aoauth%3Agrant-type%3Aapikey"))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		Expiration  int64  `json:"expiration"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := recording.Redacted, token.AccessToken; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if now := time.Now().Unix(); token.Expiration < now+3500 || token.Expiration > now+3700 {
+		t.Errorf("expect expiration in an hour, got %v", token.Expiration)
+	}
+}
+
+func TestRecorder_ReplayMatching(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recording")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "recording.json")
+
+	interaction := func(method, url, rangeHeader, body string) *recording.Interaction {
+		i := &recording.Interaction{
+			Request:  recording.Request{Method: method, URL: url, Header: http.Header{}},
+			Response: recording.Response{StatusCode: http.StatusOK, Body: recording.Body{Text: body}},
+		}
+		if len(rangeHeader) != 0 {
+			i.Request.Header.Set("Range", rangeHeader)
+		}
+		return i
+	}
+	r := recording.Recording{
+		Interactions: []*recording.Interaction{
+			interaction("GET", "https://host/bucket/key?b=2&a=1&X-Amz-Signature=REDACTED", "", "first"),
+			interaction("GET", "https://host/bucket/key?a=1&b=2", "", "second"),
+			interaction("GET", "https://host/bucket/key?a=1&b=2", "bytes=0-1", "range"),
+			interaction("HEAD", "https://host/bucket/key", "", "head"),
+		},
+	}
+	b, _ := json.Marshal(r)
+	if err := ioutil.WriteFile(path, b, 0644); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	rec, err := recording.New(path, recording.ModeReplay)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	cases := []struct {
+		URL, Range, Expect string
+	}{
+		{"https://other/bucket/key?a=1&b=2&X-Amz-Signature=abc", "", "first"},
+		{"https://other/bucket/key?b=2&a=1", "", "second"},
+		{"https://other/bucket/key?a=1&b=2", "bytes=0-1", "range"},
+		// Matching interactions are used, the last one is replayed again.
+		{"https://other/bucket/key?a=1&b=2", "", "second"},
+	}
+	for i, c := range cases {
+		req, _ := http.NewRequest("GET", c.URL, nil)
+		if len(c.Range) != 0 {
+			req.Header.Set("Range", c.Range)
+		}
+		resp, err := rec.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		b, _ := ioutil.ReadAll(resp.Body)
+		if e, a := c.Expect, string(b); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+
+	req, _ := http.NewRequest("GET", "https://host/bucket/key?a=1", nil)
+	if _, err := rec.RoundTrip(req); err == nil {
+		t.Errorf("expect error for unmatched query")
+	}
+}
@@ -0,0 +1,165 @@
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Redacted replaces scrubbed values in recordings.
+const Redacted = "REDACTED"
+
+// Scrubber removes secrets from an interaction before it is recorded.
+type Scrubber func(*Interaction)
+
+// DefaultScrubbers remove credentials, signatures and IBM IAM tokens from
+// the recorded interactions.
+var DefaultScrubbers = []Scrubber{
+	ScrubHeaders,
+	ScrubQuery,
+	ScrubIAMToken,
+}
+
+// Headers scrubbed by ScrubHeaders, canonical.
+var scrubbedHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Amz-Security-Token",
+	"X-Amz-Server-Side-Encryption-Customer-Key",
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key",
+}
+
+// Query parameters scrubbed by ScrubQuery, lower case. The parameters are
+// not matched on replay, as presigned URLs differ on each run.
+var scrubbedQueryParams = map[string]struct{}{
+	"x-amz-signature":      {},
+	"x-amz-credential":     {},
+	"x-amz-security-token": {},
+	"x-amz-date":           {},
+	"signature":            {},
+	"awsaccesskeyid":       {},
+}
+
+// Form fields of IAM token requests scrubbed by ScrubIAMToken.
+var scrubbedFormFields = []string{
+	"apikey",
+	"refresh_token",
+	"password",
+	"passcode",
+	"client_secret",
+	"cr_token",
+}
+
+// Fields of IAM token responses scrubbed by ScrubIAMToken.
+var scrubbedTokenFields = []string{
+	"access_token",
+	"refresh_token",
+	"delegated_refresh_token",
+}
+
+// ScrubHeaders redacts the credential and signature headers of the request
+// and response.
+func ScrubHeaders(i *Interaction) {
+	for _, name := range scrubbedHeaders {
+		if _, ok := i.Request.Header[name]; ok {
+			i.Request.Header.Set(name, Redacted)
+		}
+		if _, ok := i.Response.Header[name]; ok {
+			i.Response.Header.Set(name, Redacted)
+		}
+	}
+}
+
+// ScrubQuery redacts the signature and credential query parameters of the
+// request URL, e.g. of presigned URLs.
+func ScrubQuery(i *Interaction) {
+	u, err := url.Parse(i.Request.URL)
+	if err != nil || len(u.RawQuery) == 0 {
+		return
+	}
+
+	params := strings.Split(u.RawQuery, "&")
+	for j, param := range params {
+		name := param
+		if k := strings.Index(param, "="); k >= 0 {
+			name = param[:k]
+		}
+		if _, ok := scrubbedQueryParams[strings.ToLower(name)]; ok {
+			params[j] = name + "=" + Redacted
+		}
+	}
+	u.RawQuery = strings.Join(params, "&")
+	i.Request.URL = u.String()
+}
+
+// ScrubIAMToken redacts the API key of IBM IAM token requests, and the
+// tokens of their responses.
+func ScrubIAMToken(i *Interaction) {
+	if !isIAMTokenRequest(i) {
+		return
+	}
+
+	if form, err := url.ParseQuery(i.Request.Body.Text); err == nil {
+		for _, name := range scrubbedFormFields {
+			if _, ok := form[name]; ok {
+				form.Set(name, Redacted)
+			}
+		}
+		i.Request.Body = Body{Text: form.Encode()}
+	}
+
+	var token map[string]json.RawMessage
+	if err := json.Unmarshal(i.Response.Body.Bytes(), &token); err != nil {
+		return
+	}
+	for _, name := range scrubbedTokenFields {
+		if _, ok := token[name]; ok {
+			token[name] = json.RawMessage(strconv.Quote(Redacted))
+		}
+	}
+	if b, err := json.Marshal(token); err == nil {
+		i.Response.Body = Body{Text: string(b)}
+	}
+}
+
+func isIAMTokenRequest(i *Interaction) bool {
+	return i.Request.Method == "POST" &&
+		strings.HasPrefix(i.Request.Header.Get("Content-Type"), "application/x-www-form-urlencoded") &&
+		strings.Contains(i.Request.Body.Text, "grant_type=")
+}
+
+// replayRewriter rewrites the response body of a replayed interaction.
+type replayRewriter func(i *Interaction, body []byte) []byte
+
+var replayRewriters = []replayRewriter{
+	rewriteIAMTokenExpiration,
+}
+
+// rewriteIAMTokenExpiration sets the expiration of replayed IBM IAM tokens
+// relative to the current time, so the token manager does not treat the
+// recorded tokens as expired.
+func rewriteIAMTokenExpiration(i *Interaction, body []byte) []byte {
+	if !isIAMTokenRequest(i) || !bytes.Contains(body, []byte(`"expiration"`)) {
+		return body
+	}
+
+	var token map[string]json.RawMessage
+	if err := json.Unmarshal(body, &token); err != nil {
+		return body
+	}
+	expiresIn, err := strconv.ParseInt(string(token["expires_in"]), 10, 64)
+	if err != nil {
+		return body
+	}
+	token["expiration"] = json.RawMessage(strconv.FormatInt(time.Now().Unix()+expiresIn, 10))
+
+	b, err := json.Marshal(token)
+	if err != nil {
+		return body
+	}
+	return b
+}
@@ -0,0 +1,75 @@
+//go:build example
+// +build example
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3audit"
+)
+
+// Audits buckets against a JSON audit policy, printing a JSON report per
+// bucket. Exits with status 1 if any bucket fails a check.
+//
+// Usage:
+// auditBucket -policy <policy.json> <bucket> [<bucket>...]
+//
+//	-policy <file> // required
+//	-endpoint <endpoint>
+//	-region <region>
+func main() {
+	policyPtr := flag.String("policy", "", "JSON audit policy file")
+	endpointPtr := flag.String("endpoint", "", "IBM COS endpoint")
+	regionPtr := flag.String("region", "", "region of your buckets")
+	flag.Parse()
+
+	if len(*policyPtr) == 0 || flag.NArg() == 0 {
+		exitErrorf("usage: auditBucket -policy <policy.json> <bucket> [<bucket>...]")
+	}
+
+	policy, err := s3audit.LoadPolicy(*policyPtr)
+	if err != nil {
+		exitErrorf("failed to load policy, %v", err)
+	}
+
+	cfg := aws.NewConfig().WithS3BucketLocationRouting(true)
+	if len(*endpointPtr) != 0 {
+		cfg.WithEndpoint(*endpointPtr)
+	}
+	if len(*regionPtr) != 0 {
+		cfg.WithRegion(*regionPtr)
+	}
+	sess := session.Must(session.NewSession(cfg))
+
+	auditor := s3audit.NewAuditor(s3.New(sess))
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+
+	passed := true
+	for _, bucket := range flag.Args() {
+		report, err := auditor.Audit(aws.BackgroundContext(), bucket, policy)
+		if err != nil {
+			exitErrorf("failed to audit bucket %s, %v", bucket, err)
+		}
+		if err := enc.Encode(report); err != nil {
+			exitErrorf("failed to encode report, %v", err)
+		}
+		passed = passed && report.Passed()
+	}
+
+	if !passed {
+		os.Exit(1)
+	}
+}
+
+func exitErrorf(msg string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, msg+"\n", args...)
+	os.Exit(2)
+}
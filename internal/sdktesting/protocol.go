@@ -0,0 +1,50 @@
+package sdktesting
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// ProtocolTestResponse is the HTTP response of an output protocol test case.
+type ProtocolTestResponse struct {
+	StatusCode int `json:"status_code"`
+	Headers    map[string]string
+	Body       string
+}
+
+// ProtocolTestResponses returns the responses of the output protocol test
+// cases of the protocol, e.g. "rest-xml", in models/protocol_tests. Used to
+// seed the corpora of fuzz tests of the protocol's decoders.
+func ProtocolTestResponses(protocol string) ([]ProtocolTestResponse, error) {
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		return nil, fmt.Errorf("unable to find protocol tests")
+	}
+	path := filepath.Join(filepath.Dir(file), "..", "..",
+		"models", "protocol_tests", "output", protocol+".json")
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var suites []struct {
+		Cases []struct {
+			Response ProtocolTestResponse
+		}
+	}
+	if err := json.Unmarshal(b, &suites); err != nil {
+		return nil, fmt.Errorf("failed to decode %s, %v", path, err)
+	}
+
+	var resps []ProtocolTestResponse
+	for _, s := range suites {
+		for _, c := range s.Cases {
+			resps = append(resps, c.Response)
+		}
+	}
+	return resps, nil
+}
@@ -3,4 +3,5 @@
 package endpoints
 
 //go:generate go run -tags codegen ../../private/model/cli/gen-endpoints/main.go -model ./endpoints.json -out ../../aws/endpoints/defaults.go
+//go:generate go run -tags codegen ../../private/model/cli/gen-endpoints/main.go -ibm-cos -model ./ibm_cos_endpoints.json -out ../../aws/endpoints/ibm_cos_defaults.go
 //go:generate gofmt -s -w ../../aws/endpoints
@@ -0,0 +1,226 @@
+//go:build codegen
+// +build codegen
+
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// FakeGoCode returns the Go code of the configurable fake of the service
+// interface, generated in the service's interface package.
+func (a *API) FakeGoCode() string {
+	a.resetImports()
+	a.AddImport("fmt")
+	a.AddImport("sync")
+	a.AddSDKImport("aws")
+	a.AddSDKImport("aws/awserr")
+	a.AddSDKImport("aws/client/metadata")
+	a.AddSDKImport("aws/request")
+	a.AddImport(a.ImportPath())
+
+	var buf bytes.Buffer
+	if err := tplFake.Execute(&buf, a); err != nil {
+		panic(fmt.Sprintf("failed to execute %s template, %v", tplFake.Name(), err))
+	}
+
+	return a.importsGoCode() + strings.TrimSpace(buf.String())
+}
+
+// tplFake defines the template of the fake implementing the service
+// interface. Each method calls the function field of the method when set,
+// falling back to the function of its context, or non-context, variant.
+// Paginators fall back to a single page of the operation. Methods without
+// a function return a FakeNotImplemented error.
+var tplFake = template.Must(template.New("fake").Parse(`
+// ErrCodeFakeNotImplemented is the error code returned by Fake{{ .StructName }} for
+// methods without a function set.
+const ErrCodeFakeNotImplemented = "FakeNotImplemented"
+
+// FakeCall is a method call recorded by Fake{{ .StructName }}.
+type FakeCall struct {
+	// Name of the method called, e.g. "{{ (index .OperationList 0).ExportedName }}WithContext".
+	Method string
+
+	// Arguments of the call. Variadic options are recorded as a single
+	// slice argument.
+	Args []interface{}
+}
+
+// Fake{{ .StructName }} is a configurable fake of {{ .StructName }}API for unit testing code
+// calling the {{ .PackageName }}.{{ .StructName }} service client.
+//
+// Each method calls its function field when set, or the function of its
+// WithContext, or non-context, variant. Paginators not set call the
+// operation's function for a single page. Methods without a function return
+// an error with the ErrCodeFakeNotImplemented code. All calls are recorded,
+// and returned by Calls.
+//
+//    fake := &{{ .InterfacePackageName }}.Fake{{ .StructName }}{
+//        {{ (index .OperationList 0).ExportedName }}Func: func(input {{ (index .OperationList 0).InputRef.GoTypeWithPkgName }}) ({{ (index .OperationList 0).OutputRef.GoTypeWithPkgName }}, error) {
+//            // fake response
+//        },
+//    }
+//
+//    myFunc(fake)
+//
+//    calls := fake.CallsTo("{{ (index .OperationList 0).ExportedName }}")
+type Fake{{ .StructName }} struct {
+	{{- range $_, $o := .OperationList }}
+	{{ $o.ExportedName }}Func func({{ $o.InputRef.GoTypeWithPkgName }}) ({{ $o.OutputRef.GoTypeWithPkgName }}, error)
+	{{ $o.ExportedName }}WithContextFunc func(aws.Context, {{ $o.InputRef.GoTypeWithPkgName }}, ...request.Option) ({{ $o.OutputRef.GoTypeWithPkgName }}, error)
+	{{ $o.ExportedName }}RequestFunc func({{ $o.InputRef.GoTypeWithPkgName }}) (*request.Request, {{ $o.OutputRef.GoTypeWithPkgName }})
+	{{- if $o.Paginator }}
+	{{ $o.ExportedName }}PagesFunc func({{ $o.InputRef.GoTypeWithPkgName }}, func({{ $o.OutputRef.GoTypeWithPkgName }}, bool) bool) error
+	{{ $o.ExportedName }}PagesWithContextFunc func(aws.Context, {{ $o.InputRef.GoTypeWithPkgName }}, func({{ $o.OutputRef.GoTypeWithPkgName }}, bool) bool, ...request.Option) error
+	{{- end }}
+	{{ end }}
+	{{- range $_, $w := .Waiters }}
+	WaitUntil{{ $w.Name }}Func func({{ $w.Operation.InputRef.GoTypeWithPkgName }}) error
+	WaitUntil{{ $w.Name }}WithContextFunc func(aws.Context, {{ $w.Operation.InputRef.GoTypeWithPkgName }}, ...request.WaiterOption) error
+	{{ end }}
+
+	m     sync.Mutex
+	calls []FakeCall
+}
+
+var _ {{ .StructName }}API = (*Fake{{ .StructName }})(nil)
+
+// Calls returns the calls of the fake, in order.
+func (f *Fake{{ .StructName }}) Calls() []FakeCall {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	return append([]FakeCall{}, f.calls...)
+}
+
+// CallsTo returns the calls of the fake to the method, in order.
+func (f *Fake{{ .StructName }}) CallsTo(method string) []FakeCall {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	var calls []FakeCall
+	for _, c := range f.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Reset clears the calls recorded by the fake.
+func (f *Fake{{ .StructName }}) Reset() {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	f.calls = nil
+}
+
+func (f *Fake{{ .StructName }}) record(method string, args ...interface{}) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	f.calls = append(f.calls, FakeCall{Method: method, Args: args})
+}
+
+func (f *Fake{{ .StructName }}) notImplemented(method string) error {
+	return awserr.New(ErrCodeFakeNotImplemented,
+		fmt.Sprintf("Fake{{ .StructName }}.%s not implemented", method), nil)
+}
+
+// notImplementedRequest returns a request failing with a FakeNotImplemented
+// error when sent.
+func (f *Fake{{ .StructName }}) notImplementedRequest(method string, input, output interface{}) *request.Request {
+	r := request.New(aws.Config{}, metadata.ClientInfo{ServiceName: {{ .PackageName }}.ServiceName},
+		request.Handlers{}, nil, &request.Operation{Name: method}, input, output)
+	r.Error = f.notImplemented(method + "Request")
+	return r
+}
+{{ range $_, $o := .OperationList }}
+// {{ $o.ExportedName }} calls {{ $o.ExportedName }}Func.
+func (f *Fake{{ $.StructName }}) {{ $o.ExportedName }}(input {{ $o.InputRef.GoTypeWithPkgName }}) ({{ $o.OutputRef.GoTypeWithPkgName }}, error) {
+	f.record("{{ $o.ExportedName }}", input)
+	return f.call{{ $o.ExportedName }}(aws.BackgroundContext(), input, false)
+}
+
+// {{ $o.ExportedName }}WithContext calls {{ $o.ExportedName }}WithContextFunc.
+func (f *Fake{{ $.StructName }}) {{ $o.ExportedName }}WithContext(ctx aws.Context, input {{ $o.InputRef.GoTypeWithPkgName }}, opts ...request.Option) ({{ $o.OutputRef.GoTypeWithPkgName }}, error) {
+	f.record("{{ $o.ExportedName }}WithContext", ctx, input, opts)
+	return f.call{{ $o.ExportedName }}(ctx, input, true, opts...)
+}
+
+func (f *Fake{{ $.StructName }}) call{{ $o.ExportedName }}(ctx aws.Context, input {{ $o.InputRef.GoTypeWithPkgName }}, withContext bool, opts ...request.Option) ({{ $o.OutputRef.GoTypeWithPkgName }}, error) {
+	if f.{{ $o.ExportedName }}WithContextFunc != nil && (withContext || f.{{ $o.ExportedName }}Func == nil) {
+		return f.{{ $o.ExportedName }}WithContextFunc(ctx, input, opts...)
+	}
+	if f.{{ $o.ExportedName }}Func != nil {
+		return f.{{ $o.ExportedName }}Func(input)
+	}
+	return nil, f.notImplemented("{{ $o.ExportedName }}")
+}
+
+// {{ $o.ExportedName }}Request calls {{ $o.ExportedName }}RequestFunc.
+func (f *Fake{{ $.StructName }}) {{ $o.ExportedName }}Request(input {{ $o.InputRef.GoTypeWithPkgName }}) (*request.Request, {{ $o.OutputRef.GoTypeWithPkgName }}) {
+	f.record("{{ $o.ExportedName }}Request", input)
+	if f.{{ $o.ExportedName }}RequestFunc != nil {
+		return f.{{ $o.ExportedName }}RequestFunc(input)
+	}
+	output := &{{ $o.OutputRef.Shape.GoTypeWithPkgNameElem }}{}
+	return f.notImplementedRequest("{{ $o.ExportedName }}", input, output), output
+}
+{{ if $o.Paginator }}
+// {{ $o.ExportedName }}Pages calls {{ $o.ExportedName }}PagesFunc.
+func (f *Fake{{ $.StructName }}) {{ $o.ExportedName }}Pages(input {{ $o.InputRef.GoTypeWithPkgName }}, fn func({{ $o.OutputRef.GoTypeWithPkgName }}, bool) bool) error {
+	f.record("{{ $o.ExportedName }}Pages", input, fn)
+	return f.call{{ $o.ExportedName }}Pages(aws.BackgroundContext(), input, fn, false)
+}
+
+// {{ $o.ExportedName }}PagesWithContext calls {{ $o.ExportedName }}PagesWithContextFunc.
+func (f *Fake{{ $.StructName }}) {{ $o.ExportedName }}PagesWithContext(ctx aws.Context, input {{ $o.InputRef.GoTypeWithPkgName }}, fn func({{ $o.OutputRef.GoTypeWithPkgName }}, bool) bool, opts ...request.Option) error {
+	f.record("{{ $o.ExportedName }}PagesWithContext", ctx, input, fn, opts)
+	return f.call{{ $o.ExportedName }}Pages(ctx, input, fn, true, opts...)
+}
+
+func (f *Fake{{ $.StructName }}) call{{ $o.ExportedName }}Pages(ctx aws.Context, input {{ $o.InputRef.GoTypeWithPkgName }}, fn func({{ $o.OutputRef.GoTypeWithPkgName }}, bool) bool, withContext bool, opts ...request.Option) error {
+	if f.{{ $o.ExportedName }}PagesWithContextFunc != nil && (withContext || f.{{ $o.ExportedName }}PagesFunc == nil) {
+		return f.{{ $o.ExportedName }}PagesWithContextFunc(ctx, input, fn, opts...)
+	}
+	if f.{{ $o.ExportedName }}PagesFunc != nil {
+		return f.{{ $o.ExportedName }}PagesFunc(input, fn)
+	}
+	output, err := f.call{{ $o.ExportedName }}(ctx, input, withContext, opts...)
+	if err != nil {
+		return err
+	}
+	fn(output, true)
+	return nil
+}
+{{ end }}
+{{- end }}
+{{ range $_, $w := .Waiters }}
+// WaitUntil{{ $w.Name }} calls WaitUntil{{ $w.Name }}Func.
+func (f *Fake{{ $.StructName }}) WaitUntil{{ $w.Name }}(input {{ $w.Operation.InputRef.GoTypeWithPkgName }}) error {
+	f.record("WaitUntil{{ $w.Name }}", input)
+	return f.callWaitUntil{{ $w.Name }}(aws.BackgroundContext(), input, false)
+}
+
+// WaitUntil{{ $w.Name }}WithContext calls WaitUntil{{ $w.Name }}WithContextFunc.
+func (f *Fake{{ $.StructName }}) WaitUntil{{ $w.Name }}WithContext(ctx aws.Context, input {{ $w.Operation.InputRef.GoTypeWithPkgName }}, opts ...request.WaiterOption) error {
+	f.record("WaitUntil{{ $w.Name }}WithContext", ctx, input, opts)
+	return f.callWaitUntil{{ $w.Name }}(ctx, input, true, opts...)
+}
+
+func (f *Fake{{ $.StructName }}) callWaitUntil{{ $w.Name }}(ctx aws.Context, input {{ $w.Operation.InputRef.GoTypeWithPkgName }}, withContext bool, opts ...request.WaiterOption) error {
+	if f.WaitUntil{{ $w.Name }}WithContextFunc != nil && (withContext || f.WaitUntil{{ $w.Name }}Func == nil) {
+		return f.WaitUntil{{ $w.Name }}WithContextFunc(ctx, input, opts...)
+	}
+	if f.WaitUntil{{ $w.Name }}Func != nil {
+		return f.WaitUntil{{ $w.Name }}Func(input)
+	}
+	return f.notImplemented("WaitUntil{{ $w.Name }}")
+}
+{{ end }}
+`))
@@ -76,8 +76,14 @@ func loadAPI(modelPath, baseImport string, opts ...func(*API)) (*API, error) {
 
 	modelFile := filepath.Base(modelPath)
 	modelDir := filepath.Dir(modelPath)
+	// IBM COS SDK Code -- START
+	attachModel := a.Attach
+	if IsSmithyModelFile(modelFile) {
+		attachModel = a.AttachSmithy
+	}
+	// IBM COS SDK Code -- END
 	err := attachModelFiles(modelDir,
-		modelLoader{modelFile, a.Attach, true},
+		modelLoader{modelFile, attachModel, true},
 		modelLoader{"docs-2.json", a.AttachDocs, false},
 		modelLoader{"paginators-1.json", a.AttachPaginators, false},
 		modelLoader{"waiters-2.json", a.AttachWaiters, false},
@@ -0,0 +1,826 @@
+//go:build codegen
+// +build codegen
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// SmithyModelFilename is the filename of Smithy JSON AST models loaded in
+// place of the api-2.json model of a service version. Smithy IDL models are
+// converted to the JSON AST with the Smithy CLI's ast command.
+//
+//	models/apis/<service>/<version>/smithy.json
+//
+// The docs-2.json, paginators-1.json, waiters-2.json, examples-1.json and
+// smoke.json files of the service version are attached to Smithy models the
+// same as api-2.json models.
+const SmithyModelFilename = "smithy.json"
+
+// IsSmithyModelFile returns if the model file is a Smithy JSON AST model.
+func IsSmithyModelFile(filename string) bool {
+	return filepath.Base(filename) == SmithyModelFilename
+}
+
+// smithyModel is a Smithy JSON AST model.
+type smithyModel struct {
+	Smithy   string
+	Metadata map[string]interface{}
+	Shapes   map[string]*smithyShape
+}
+
+// smithyShape is a shape of a Smithy JSON AST model.
+type smithyShape struct {
+	Type   string
+	Traits smithyTraits
+
+	// Aggregate shapes.
+	Members map[string]*smithyMember
+	Member  *smithyMember
+	Key     *smithyMember
+	Value   *smithyMember
+
+	// Service, resource and operation shapes.
+	Version              string
+	Operations           []smithyTarget
+	Resources            []smithyTarget
+	CollectionOperations []smithyTarget
+	Create               *smithyTarget
+	Put                  *smithyTarget
+	Read                 *smithyTarget
+	Update               *smithyTarget
+	Delete               *smithyTarget
+	List                 *smithyTarget
+	Input                *smithyTarget
+	Output               *smithyTarget
+	Errors               []smithyTarget
+}
+
+// smithyMember is a member of an aggregate Smithy shape.
+type smithyMember struct {
+	Target string
+	Traits smithyTraits
+}
+
+// smithyTarget is a reference to a Smithy shape.
+type smithyTarget struct {
+	Target string
+}
+
+// smithyTraits are the traits applied to a Smithy shape or member, by
+// absolute shape ID.
+type smithyTraits map[string]json.RawMessage
+
+// Has returns if the trait is applied.
+func (t smithyTraits) Has(id string) bool {
+	_, ok := t[id]
+	return ok
+}
+
+// String returns the value of the string trait, or "" if not applied.
+func (t smithyTraits) String(id string) string {
+	var v string
+	t.Decode(id, &v)
+	return v
+}
+
+// Decode decodes the value of the trait into v. Returns false if the trait
+// is not applied or cannot be decoded into v.
+func (t smithyTraits) Decode(id string, v interface{}) bool {
+	raw, ok := t[id]
+	if !ok {
+		return false
+	}
+	return json.Unmarshal(raw, v) == nil
+}
+
+// Smithy trait shape IDs converted by the loader.
+const (
+	smithyTraitDocumentation     = "smithy.api#documentation"
+	smithyTraitDeprecated        = "smithy.api#deprecated"
+	smithyTraitSensitive         = "smithy.api#sensitive"
+	smithyTraitTitle             = "smithy.api#title"
+	smithyTraitRequired          = "smithy.api#required"
+	smithyTraitEnum              = "smithy.api#enum"
+	smithyTraitEnumValue         = "smithy.api#enumValue"
+	smithyTraitLength            = "smithy.api#length"
+	smithyTraitRange             = "smithy.api#range"
+	smithyTraitStreaming         = "smithy.api#streaming"
+	smithyTraitError             = "smithy.api#error"
+	smithyTraitHTTP              = "smithy.api#http"
+	smithyTraitHTTPError         = "smithy.api#httpError"
+	smithyTraitHTTPLabel         = "smithy.api#httpLabel"
+	smithyTraitHTTPQuery         = "smithy.api#httpQuery"
+	smithyTraitHTTPQueryParams   = "smithy.api#httpQueryParams"
+	smithyTraitHTTPHeader        = "smithy.api#httpHeader"
+	smithyTraitHTTPPrefixHeaders = "smithy.api#httpPrefixHeaders"
+	smithyTraitHTTPPayload       = "smithy.api#httpPayload"
+	smithyTraitHTTPResponseCode  = "smithy.api#httpResponseCode"
+	smithyTraitHTTPChecksumReq   = "smithy.api#httpChecksumRequired"
+	smithyTraitJSONName          = "smithy.api#jsonName"
+	smithyTraitXMLName           = "smithy.api#xmlName"
+	smithyTraitXMLAttribute      = "smithy.api#xmlAttribute"
+	smithyTraitXMLFlattened      = "smithy.api#xmlFlattened"
+	smithyTraitXMLNamespace      = "smithy.api#xmlNamespace"
+	smithyTraitTimestampFormat   = "smithy.api#timestampFormat"
+	smithyTraitIdempotencyToken  = "smithy.api#idempotencyToken"
+	smithyTraitHostLabel         = "smithy.api#hostLabel"
+	smithyTraitEndpoint          = "smithy.api#endpoint"
+	smithyTraitPaginated         = "smithy.api#paginated"
+	smithyTraitAuth              = "smithy.api#auth"
+	smithyTraitOptionalAuth      = "smithy.api#optionalAuth"
+	smithyTraitAWSService        = "aws.api#service"
+	smithyTraitSigV4             = "aws.auth#sigv4"
+	smithyTraitUnsignedPayload   = "aws.auth#unsignedPayload"
+	smithyTraitHTTPChecksum      = "aws.protocols#httpChecksum"
+	smithyTraitAWSQueryError     = "aws.protocols#awsQueryError"
+	smithyTraitWaitable          = "smithy.waiters#waitable"
+)
+
+// smithyProtocols maps the Smithy protocol traits to the API protocols, and
+// JSON version of the JSON RPC protocols.
+var smithyProtocols = map[string][2]string{
+	"aws.protocols#restXml":    {"rest-xml"},
+	"aws.protocols#restJson1":  {"rest-json"},
+	"aws.protocols#awsJson1_0": {"json", "1.0"},
+	"aws.protocols#awsJson1_1": {"json", "1.1"},
+	"aws.protocols#awsQuery":   {"query"},
+	"aws.protocols#ec2Query":   {"ec2"},
+}
+
+// smithyTimestampFormats maps the Smithy timestamp formats to the API
+// timestamp formats.
+var smithyTimestampFormats = map[string]string{
+	"date-time":     "iso8601",
+	"http-date":     "rfc822",
+	"epoch-seconds": "unixTimestamp",
+}
+
+// smithySimpleTypes maps the Smithy simple shape types to the API shape
+// types.
+var smithySimpleTypes = map[string]string{
+	"blob":      "blob",
+	"boolean":   "boolean",
+	"string":    "string",
+	"enum":      "string",
+	"byte":      "byte",
+	"short":     "short",
+	"integer":   "integer",
+	"intEnum":   "integer",
+	"long":      "long",
+	"float":     "float",
+	"double":    "double",
+	"timestamp": "timestamp",
+}
+
+// smithyPrelude maps the prelude shapes of the Smithy smithy.api namespace
+// to their shape types.
+var smithyPrelude = map[string]string{
+	"Blob":             "blob",
+	"Boolean":          "boolean",
+	"PrimitiveBoolean": "boolean",
+	"String":           "string",
+	"Byte":             "byte",
+	"PrimitiveByte":    "byte",
+	"Short":            "short",
+	"PrimitiveShort":   "short",
+	"Integer":          "integer",
+	"PrimitiveInteger": "integer",
+	"Long":             "long",
+	"PrimitiveLong":    "long",
+	"Float":            "float",
+	"PrimitiveFloat":   "float",
+	"Double":           "double",
+	"PrimitiveDouble":  "double",
+	"Timestamp":        "timestamp",
+}
+
+// smithyUnit is the shape ID of the Smithy unit type, used by operations
+// without input or output.
+const smithyUnit = "smithy.api#Unit"
+
+// AttachSmithy opens a Smithy JSON AST model file by name, and converts the
+// model's service into the API's metadata, operations and shapes.
+func (a *API) AttachSmithy(filename string) error {
+	a.path = filepath.Dir(filename)
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var m smithyModel
+	if err := json.NewDecoder(f).Decode(&m); err != nil {
+		return fmt.Errorf("failed to decode %s, err: %v", filename, err)
+	}
+
+	return a.attachSmithyModel(&m)
+}
+
+// AttachSmithyString will unmarshal a raw Smithy JSON AST model string, and
+// setup the API.
+func (a *API) AttachSmithyString(str string) error {
+	var m smithyModel
+	if err := json.Unmarshal([]byte(str), &m); err != nil {
+		return fmt.Errorf("failed to decode Smithy model, %v", err)
+	}
+	if err := a.attachSmithyModel(&m); err != nil {
+		return err
+	}
+
+	return a.Setup()
+}
+
+func (a *API) attachSmithyModel(m *smithyModel) error {
+	if !strings.HasPrefix(m.Smithy, "1.") && !strings.HasPrefix(m.Smithy, "2.") {
+		return fmt.Errorf("unsupported Smithy model version %q", m.Smithy)
+	}
+
+	c := &smithyConverter{model: m, api: a, names: map[string]string{}}
+	return c.convert()
+}
+
+// smithyConverter converts the service of a Smithy model into an API.
+type smithyConverter struct {
+	model *smithyModel
+	api   *API
+
+	serviceID string
+	service   *smithyShape
+
+	// Absolute shape IDs converted, by API shape name.
+	names map[string]string
+}
+
+func (c *smithyConverter) convert() error {
+	if err := c.findService(); err != nil {
+		return err
+	}
+	if err := c.convertService(); err != nil {
+		return err
+	}
+
+	c.api.Operations = map[string]*Operation{}
+	c.api.Shapes = map[string]*Shape{}
+
+	opIDs, err := c.serviceOperations()
+	if err != nil {
+		return err
+	}
+	pagination := paginationDefinitions{API: c.api, Pagination: map[string]Paginator{}}
+	waiters := waiterDefinitions{API: c.api, Waiters: map[string]Waiter{}}
+	for _, id := range opIDs {
+		if err := c.convertOperation(id, pagination.Pagination, waiters.Waiters); err != nil {
+			return err
+		}
+	}
+
+	if err := pagination.setup(); err != nil {
+		return err
+	}
+	return waiters.setup()
+}
+
+// findService finds the single service shape of the model.
+func (c *smithyConverter) findService() error {
+	var ids []string
+	for id, s := range c.model.Shapes {
+		if s.Type == "service" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) != 1 {
+		sort.Strings(ids)
+		return fmt.Errorf("Smithy model must contain one service shape, found %d, %v",
+			len(ids), ids)
+	}
+
+	c.serviceID, c.service = ids[0], c.model.Shapes[ids[0]]
+	return nil
+}
+
+func (c *smithyConverter) convertService() error {
+	s, md := c.service, &c.api.Metadata
+	name := smithyShapeName(c.serviceID)
+
+	md.APIVersion = s.Version
+	md.ServiceFullName = s.Traits.String(smithyTraitTitle)
+	c.api.Documentation = docstring(s.Traits.String(smithyTraitDocumentation))
+
+	var svc struct {
+		SdkID          string `json:"sdkId"`
+		EndpointPrefix string `json:"endpointPrefix"`
+	}
+	if s.Traits.Decode(smithyTraitAWSService, &svc) {
+		md.ServiceID = svc.SdkID
+		md.EndpointPrefix = svc.EndpointPrefix
+	}
+	if len(md.EndpointPrefix) == 0 {
+		md.EndpointPrefix = strings.ToLower(name)
+	}
+	if len(md.ServiceID) == 0 {
+		md.ServiceID = name
+	}
+	if len(md.ServiceFullName) == 0 {
+		md.ServiceFullName = md.ServiceID
+	}
+	// The sdkId names the service clients generated from Smithy models.
+	md.ServiceAbbreviation = md.ServiceID
+	md.UID = md.EndpointPrefix + "-" + md.APIVersion
+
+	var sigv4 struct {
+		Name string `json:"name"`
+	}
+	if s.Traits.Decode(smithyTraitSigV4, &sigv4) {
+		md.SignatureVersion = "v4"
+		md.SigningName = sigv4.Name
+	}
+
+	for trait, protocol := range smithyProtocols {
+		if s.Traits.Has(trait) {
+			md.Protocol, md.JSONVersion = protocol[0], protocol[1]
+		}
+	}
+	if len(md.Protocol) == 0 {
+		return UnsupportedAPIModelError{
+			Err: fmt.Errorf("service %s has no supported protocol trait", c.serviceID),
+		}
+	}
+	if md.Protocol == "json" {
+		md.TargetPrefix = name
+	}
+
+	return nil
+}
+
+// serviceOperations returns the sorted shape IDs of the operations bound to
+// the service, directly or by its resources.
+func (c *smithyConverter) serviceOperations() ([]string, error) {
+	ids := map[string]bool{}
+	var walk func(s *smithyShape) error
+	walk = func(s *smithyShape) error {
+		for _, t := range s.Operations {
+			ids[t.Target] = true
+		}
+		for _, t := range s.CollectionOperations {
+			ids[t.Target] = true
+		}
+		for _, t := range []*smithyTarget{s.Create, s.Put, s.Read, s.Update, s.Delete, s.List} {
+			if t != nil {
+				ids[t.Target] = true
+			}
+		}
+		for _, t := range s.Resources {
+			r, ok := c.model.Shapes[t.Target]
+			if !ok {
+				return fmt.Errorf("resource %s not found", t.Target)
+			}
+			if err := walk(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(c.service); err != nil {
+		return nil, err
+	}
+
+	list := make([]string, 0, len(ids))
+	for id := range ids {
+		list = append(list, id)
+	}
+	sort.Strings(list)
+	return list, nil
+}
+
+func (c *smithyConverter) convertOperation(id string, pagination map[string]Paginator, waiters map[string]Waiter) error {
+	s, ok := c.model.Shapes[id]
+	if !ok || s.Type != "operation" {
+		return fmt.Errorf("operation %s not found", id)
+	}
+	name := smithyShapeName(id)
+	if _, ok := c.api.Operations[name]; ok {
+		return fmt.Errorf("operation %s conflicts with another operation of the same name", id)
+	}
+
+	op := &Operation{
+		Name:          name,
+		Documentation: docstring(s.Traits.String(smithyTraitDocumentation)),
+	}
+	op.Deprecated, op.DeprecatedMsg = smithyDeprecated(s.Traits)
+
+	var http struct {
+		Method string `json:"method"`
+		URI    string `json:"uri"`
+		Code   uint   `json:"code"`
+	}
+	if s.Traits.Decode(smithyTraitHTTP, &http) {
+		op.HTTP = HTTPInfo{Method: http.Method, RequestURI: http.URI, ResponseCode: http.Code}
+	} else {
+		op.HTTP = HTTPInfo{Method: "POST", RequestURI: "/"}
+	}
+
+	var err error
+	if s.Input != nil && s.Input.Target != smithyUnit {
+		if op.InputRef.ShapeName, err = c.shape(s.Input.Target); err != nil {
+			return err
+		}
+		if c.api.Metadata.Protocol == "rest-xml" {
+			op.InputRef.XMLNamespace = c.xmlNamespace()
+		}
+	}
+	if s.Output != nil && s.Output.Target != smithyUnit {
+		if op.OutputRef.ShapeName, err = c.shape(s.Output.Target); err != nil {
+			return err
+		}
+	}
+	for _, t := range append(append([]smithyTarget{}, s.Errors...), c.service.Errors...) {
+		ref := ShapeRef{}
+		if ref.ShapeName, err = c.shape(t.Target); err != nil {
+			return err
+		}
+		op.ErrorRefs = append(op.ErrorRefs, ref)
+	}
+
+	var endpoint EndpointTrait
+	if s.Traits.Decode(smithyTraitEndpoint, &endpoint) {
+		op.Endpoint = &endpoint
+	}
+	s.Traits.Decode(smithyTraitHTTPChecksum, &op.HTTPChecksum)
+	op.IsHttpChecksumRequired = s.Traits.Has(smithyTraitHTTPChecksumReq)
+
+	var auth []string
+	switch {
+	case s.Traits.Decode(smithyTraitAuth, &auth) && len(auth) == 0,
+		s.Traits.Has(smithyTraitOptionalAuth):
+		op.AuthType = NoneAuthType
+	case s.Traits.Has(smithyTraitUnsignedPayload):
+		op.AuthType = V4UnsignedBodyAuthType
+	}
+
+	if p, ok := c.paginator(s); ok {
+		pagination[name] = p
+	}
+	if err := c.waiters(name, s, waiters); err != nil {
+		return err
+	}
+
+	c.api.Operations[name] = op
+	return nil
+}
+
+// paginator returns the paginator of the operation, merged with the
+// service's pagination defaults.
+func (c *smithyConverter) paginator(s *smithyShape) (Paginator, bool) {
+	var svc, op struct {
+		InputToken  string `json:"inputToken"`
+		OutputToken string `json:"outputToken"`
+		PageSize    string `json:"pageSize"`
+	}
+	if !s.Traits.Decode(smithyTraitPaginated, &op) {
+		return Paginator{}, false
+	}
+	c.service.Traits.Decode(smithyTraitPaginated, &svc)
+	if len(op.InputToken) == 0 {
+		op.InputToken = svc.InputToken
+	}
+	if len(op.OutputToken) == 0 {
+		op.OutputToken = svc.OutputToken
+	}
+	if len(op.PageSize) == 0 {
+		op.PageSize = svc.PageSize
+	}
+	if len(op.InputToken) == 0 || len(op.OutputToken) == 0 {
+		return Paginator{}, false
+	}
+
+	return Paginator{
+		InputTokens:  op.InputToken,
+		OutputTokens: op.OutputToken,
+		LimitKey:     op.PageSize,
+	}, true
+}
+
+// smithyWaiter is a waiter of the Smithy waitable trait.
+type smithyWaiter struct {
+	Documentation string
+	MinDelay      int
+	MaxDelay      int
+	Acceptors     []struct {
+		State   string
+		Matcher struct {
+			Success   *bool
+			ErrorType string
+			Output    *struct {
+				Path       string
+				Expected   string
+				Comparator string
+			}
+		}
+	}
+}
+
+// smithyWaiterMaxWait is the max wait time, in seconds, of the typed waiters
+// of Smithy waiters, which do not define one.
+const smithyWaiterMaxWait = 600
+
+// waiters converts the Smithy waiters of the operation into typed waiters,
+// backing off with jitter between the waiter's min and max delay, for up to
+// smithyWaiterMaxWait seconds.
+func (c *smithyConverter) waiters(opName string, s *smithyShape, waiters map[string]Waiter) error {
+	var defs map[string]smithyWaiter
+	if !s.Traits.Decode(smithyTraitWaitable, &defs) {
+		return nil
+	}
+
+	for name, def := range defs {
+		w := Waiter{
+			OperationName: opName,
+			MinDelay:      def.MinDelay,
+			MaxDelay:      def.MaxDelay,
+		}
+		if w.MinDelay == 0 {
+			w.MinDelay = 2
+		}
+		if w.MaxDelay == 0 {
+			w.MaxDelay = 120
+		}
+		// Stop after as many attempts as waiting the min delay between each
+		// would take, if the max wait time is disabled.
+		w.MaxWait = smithyWaiterMaxWait
+		w.MaxAttempts = w.MaxWait / w.MinDelay
+
+		for _, a := range def.Acceptors {
+			acceptor := WaiterAcceptor{State: a.State}
+			switch m := a.Matcher; {
+			case m.Success != nil:
+				acceptor.Matcher, acceptor.Expected = "status", 200
+				if !*m.Success {
+					return fmt.Errorf("waiter %s: success false matcher not supported", name)
+				}
+			case len(m.ErrorType) != 0:
+				acceptor.Matcher, acceptor.Expected = "error", smithyShapeName(m.ErrorType)
+			case m.Output != nil:
+				acceptor.Argument = m.Output.Path
+				acceptor.Expected = m.Output.Expected
+				switch m.Output.Comparator {
+				case "stringEquals":
+					acceptor.Matcher = "path"
+				case "booleanEquals":
+					acceptor.Matcher, acceptor.Expected = "path", m.Output.Expected == "true"
+				case "allStringEquals":
+					acceptor.Matcher = "pathAll"
+				case "anyStringEquals":
+					acceptor.Matcher = "pathAny"
+				default:
+					return fmt.Errorf("waiter %s: unsupported comparator %s",
+						name, m.Output.Comparator)
+				}
+			default:
+				return fmt.Errorf("waiter %s: unsupported acceptor matcher", name)
+			}
+			w.Acceptors = append(w.Acceptors, acceptor)
+		}
+
+		if _, ok := waiters[name]; ok {
+			return fmt.Errorf("waiter %s of operation %s conflicts with another waiter", name, opName)
+		}
+		waiters[name] = w
+	}
+
+	return nil
+}
+
+// shape converts the shape, and the shapes it targets, returning the API
+// shape name of the shape.
+func (c *smithyConverter) shape(id string) (string, error) {
+	name := smithyShapeName(id)
+	if prev, ok := c.names[name]; ok {
+		if prev != id {
+			return "", fmt.Errorf("shape %s conflicts with shape %s of the same name", id, prev)
+		}
+		return name, nil
+	}
+	c.names[name] = id
+
+	s, ok := c.model.Shapes[id]
+	if !ok {
+		typ, ok := smithyPrelude[name]
+		if !ok || !strings.HasPrefix(id, "smithy.api#") {
+			return "", fmt.Errorf("shape %s not found", id)
+		}
+		c.api.Shapes[name] = &Shape{API: c.api, ShapeName: name, Type: typ}
+		return name, nil
+	}
+
+	shape := &Shape{
+		API:           c.api,
+		ShapeName:     name,
+		Documentation: docstring(s.Traits.String(smithyTraitDocumentation)),
+		Sensitive:     s.Traits.Has(smithyTraitSensitive),
+		Streaming:     s.Traits.Has(smithyTraitStreaming),
+	}
+	shape.Deprecated, shape.DeprecatedMsg = smithyDeprecated(s.Traits)
+	shape.Min = smithyMin(s.Traits)
+	c.api.Shapes[name] = shape
+
+	switch s.Type {
+	case "structure":
+		return name, c.structure(id, s, shape)
+	case "list", "set":
+		shape.Type = "list"
+		shape.Flattened = s.Traits.Has(smithyTraitXMLFlattened)
+		return name, c.memberRef(s.Member, &shape.MemberRef)
+	case "map":
+		shape.Type = "map"
+		shape.Flattened = s.Traits.Has(smithyTraitXMLFlattened)
+		if err := c.memberRef(s.Key, &shape.KeyRef); err != nil {
+			return "", err
+		}
+		return name, c.memberRef(s.Value, &shape.ValueRef)
+	case "document":
+		shape.Type = "structure"
+		shape.Document = true
+		return name, nil
+	}
+
+	typ, ok := smithySimpleTypes[s.Type]
+	if !ok {
+		return "", UnsupportedAPIModelError{
+			Err: fmt.Errorf("shape %s has unsupported type %s", id, s.Type),
+		}
+	}
+	shape.Type = typ
+	shape.TimestampFormat = smithyTimestampFormats[s.Traits.String(smithyTraitTimestampFormat)]
+
+	switch s.Type {
+	case "string":
+		var enum []struct {
+			Value string `json:"value"`
+		}
+		s.Traits.Decode(smithyTraitEnum, &enum)
+		for _, e := range enum {
+			shape.Enum = append(shape.Enum, e.Value)
+		}
+	case "enum":
+		for _, n := range sortedSmithyMembers(s.Members) {
+			v := s.Members[n].Traits.String(smithyTraitEnumValue)
+			if len(v) == 0 {
+				v = n
+			}
+			shape.Enum = append(shape.Enum, v)
+		}
+	}
+
+	return name, nil
+}
+
+func (c *smithyConverter) structure(id string, s *smithyShape, shape *Shape) error {
+	shape.Type = "structure"
+	shape.MemberRefs = map[string]*ShapeRef{}
+	shape.LocationName = s.Traits.String(smithyTraitXMLName)
+
+	if s.Traits.Has(smithyTraitError) {
+		shape.Exception = true
+		s.Traits.Decode(smithyTraitHTTPError, &shape.ErrorInfo.HTTPStatusCode)
+
+		var queryErr struct {
+			Code             string `json:"code"`
+			HTTPResponseCode int    `json:"httpResponseCode"`
+		}
+		if s.Traits.Decode(smithyTraitAWSQueryError, &queryErr) {
+			shape.ErrorInfo.Code = queryErr.Code
+			shape.ErrorInfo.HTTPStatusCode = queryErr.HTTPResponseCode
+		}
+	}
+
+	for _, n := range sortedSmithyMembers(s.Members) {
+		m := s.Members[n]
+		ref := &ShapeRef{
+			API:           c.api,
+			Documentation: docstring(m.Traits.String(smithyTraitDocumentation)),
+		}
+		if err := c.memberRef(m, ref); err != nil {
+			return fmt.Errorf("member %s of %s, %v", n, id, err)
+		}
+		shape.MemberRefs[n] = ref
+
+		if m.Traits.Has(smithyTraitRequired) {
+			shape.Required = append(shape.Required, n)
+		}
+
+		var prefix string
+		switch {
+		case m.Traits.Has(smithyTraitHTTPLabel):
+			ref.Location, ref.LocationName = "uri", n
+		case m.Traits.Has(smithyTraitHTTPQuery):
+			ref.Location, ref.LocationName = "querystring", m.Traits.String(smithyTraitHTTPQuery)
+		case m.Traits.Has(smithyTraitHTTPQueryParams):
+			ref.Location = "querystring"
+		case m.Traits.Has(smithyTraitHTTPHeader):
+			ref.Location, ref.LocationName = "header", m.Traits.String(smithyTraitHTTPHeader)
+		case m.Traits.Decode(smithyTraitHTTPPrefixHeaders, &prefix):
+			ref.Location, ref.LocationName = "headers", prefix
+		case m.Traits.Has(smithyTraitHTTPResponseCode):
+			ref.Location = "statusCode"
+		case m.Traits.Has(smithyTraitHTTPPayload):
+			shape.Payload = n
+			if c.model.Shapes[m.Target] != nil && c.model.Shapes[m.Target].Type == "structure" &&
+				c.api.Metadata.Protocol == "rest-xml" {
+				if name := c.model.Shapes[m.Target].Traits.String(smithyTraitXMLName); len(name) != 0 {
+					ref.LocationName = name
+				} else {
+					ref.LocationName = n
+				}
+				if len(ref.XMLNamespace.URI) == 0 {
+					ref.XMLNamespace = c.xmlNamespace()
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// memberRef sets the reference to the target of the member, and the
+// member's serialization traits.
+func (c *smithyConverter) memberRef(m *smithyMember, ref *ShapeRef) error {
+	if m == nil {
+		return fmt.Errorf("missing member")
+	}
+
+	var err error
+	if ref.ShapeName, err = c.shape(m.Target); err != nil {
+		return err
+	}
+	ref.API = c.api
+
+	t := m.Traits
+	if name := t.String(smithyTraitJSONName); len(name) != 0 && c.api.Metadata.Protocol != "rest-xml" {
+		ref.LocationName = name
+	}
+	if name := t.String(smithyTraitXMLName); len(name) != 0 {
+		ref.LocationName = name
+	}
+	ref.XMLAttribute = t.Has(smithyTraitXMLAttribute)
+	ref.Flattened = t.Has(smithyTraitXMLFlattened)
+	t.Decode(smithyTraitXMLNamespace, &ref.XMLNamespace)
+	ref.TimestampFormat = smithyTimestampFormats[t.String(smithyTraitTimestampFormat)]
+	ref.IdempotencyToken = t.Has(smithyTraitIdempotencyToken)
+	ref.HostLabel = t.Has(smithyTraitHostLabel)
+	ref.Deprecated, ref.DeprecatedMsg = smithyDeprecated(t)
+
+	return nil
+}
+
+// xmlNamespace returns the XML namespace of the service.
+func (c *smithyConverter) xmlNamespace() XMLInfo {
+	var ns XMLInfo
+	c.service.Traits.Decode(smithyTraitXMLNamespace, &ns)
+	return ns
+}
+
+// smithyShapeName returns the name of the absolute shape ID, without the
+// namespace.
+func smithyShapeName(id string) string {
+	if i := strings.LastIndex(id, "#"); i >= 0 {
+		return id[i+1:]
+	}
+	return id
+}
+
+func smithyDeprecated(t smithyTraits) (bool, string) {
+	var v struct {
+		Message string `json:"message"`
+	}
+	if !t.Decode(smithyTraitDeprecated, &v) {
+		return false, ""
+	}
+	return true, v.Message
+}
+
+// smithyMin returns the min of the length or range trait.
+func smithyMin(t smithyTraits) float64 {
+	var v struct {
+		Min float64 `json:"min"`
+	}
+	if t.Decode(smithyTraitLength, &v) || t.Decode(smithyTraitRange, &v) {
+		return v.Min
+	}
+	return 0
+}
+
+func sortedSmithyMembers(members map[string]*smithyMember) []string {
+	names := make([]string, 0, len(members))
+	for n := range members {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
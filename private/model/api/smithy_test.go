@@ -0,0 +1,318 @@
+//go:build codegen
+// +build codegen
+
+package api
+
+import (
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testSmithyModel = `{
+	"smithy": "2.0",
+	"shapes": {
+		"com.ibm.cos#Cos": {
+			"type": "service",
+			"version": "2024-01-01",
+			"operations": [{"target": "com.ibm.cos#PutThing"}],
+			"resources": [{"target": "com.ibm.cos#Thing"}],
+			"traits": {
+				"aws.protocols#restXml": {},
+				"aws.auth#sigv4": {"name": "s3"},
+				"aws.api#service": {"sdkId": "Cos Test", "endpointPrefix": "cos"},
+				"smithy.api#title": "IBM Cloud Object Storage Test",
+				"smithy.api#xmlNamespace": {"uri": "http://s3.amazonaws.com/doc/2006-03-01/"},
+				"smithy.api#paginated": {"inputToken": "Marker", "outputToken": "NextMarker"}
+			}
+		},
+		"com.ibm.cos#Thing": {
+			"type": "resource",
+			"read": {"target": "com.ibm.cos#GetThing"},
+			"list": {"target": "com.ibm.cos#ListThings"}
+		},
+		"com.ibm.cos#GetThing": {
+			"type": "operation",
+			"input": {"target": "com.ibm.cos#GetThingRequest"},
+			"output": {"target": "com.ibm.cos#ThingProtection"},
+			"errors": [{"target": "com.ibm.cos#NoSuchThing"}],
+			"traits": {
+				"smithy.api#http": {"method": "GET", "uri": "/{Bucket}?protection", "code": 200},
+				"smithy.api#documentation": "<p>Returns the protection of a thing.</p>",
+				"smithy.waiters#waitable": {
+					"ThingProtected": {
+						"minDelay": 5,
+						"acceptors": [
+							{"state": "success", "matcher": {"output": {"path": "Status", "expected": "Locked", "comparator": "stringEquals"}}},
+							{"state": "retry", "matcher": {"errorType": "com.ibm.cos#NoSuchThing"}}
+						]
+					}
+				}
+			}
+		},
+		"com.ibm.cos#GetThingRequest": {
+			"type": "structure",
+			"members": {
+				"Bucket": {"target": "smithy.api#String", "traits": {"smithy.api#httpLabel": {}, "smithy.api#required": {}}},
+				"ExpectedOwner": {"target": "smithy.api#String", "traits": {"smithy.api#httpHeader": "x-amz-expected-bucket-owner"}}
+			}
+		},
+		"com.ibm.cos#ThingProtection": {
+			"type": "structure",
+			"members": {
+				"Status": {"target": "com.ibm.cos#ProtectionStatus"},
+				"Days": {"target": "smithy.api#Integer", "traits": {"smithy.api#xmlName": "RetentionDays"}},
+				"Since": {"target": "com.ibm.cos#Time", "traits": {"smithy.api#timestampFormat": "date-time"}}
+			},
+			"traits": {"smithy.api#xmlName": "Protection"}
+		},
+		"com.ibm.cos#ProtectionStatus": {
+			"type": "enum",
+			"members": {
+				"LOCKED": {"target": "smithy.api#Unit", "traits": {"smithy.api#enumValue": "Locked"}},
+				"UNLOCKED": {"target": "smithy.api#Unit"}
+			}
+		},
+		"com.ibm.cos#Time": {"type": "timestamp"},
+		"com.ibm.cos#NoSuchThing": {
+			"type": "structure",
+			"members": {"Message": {"target": "smithy.api#String"}},
+			"traits": {"smithy.api#error": "client", "smithy.api#httpError": 404}
+		},
+		"com.ibm.cos#ListThings": {
+			"type": "operation",
+			"input": {"target": "com.ibm.cos#ListThingsRequest"},
+			"output": {"target": "com.ibm.cos#ListThingsResult"},
+			"traits": {
+				"smithy.api#http": {"method": "GET", "uri": "/"},
+				"smithy.api#paginated": {"items": "Things", "pageSize": "MaxThings"}
+			}
+		},
+		"com.ibm.cos#ListThingsRequest": {
+			"type": "structure",
+			"members": {
+				"Marker": {"target": "smithy.api#String", "traits": {"smithy.api#httpQuery": "marker"}},
+				"MaxThings": {"target": "smithy.api#Integer", "traits": {"smithy.api#httpQuery": "max-things"}}
+			}
+		},
+		"com.ibm.cos#ListThingsResult": {
+			"type": "structure",
+			"members": {
+				"NextMarker": {"target": "smithy.api#String"},
+				"Things": {"target": "com.ibm.cos#ThingList", "traits": {"smithy.api#xmlFlattened": {}}}
+			}
+		},
+		"com.ibm.cos#ThingList": {
+			"type": "list",
+			"member": {"target": "com.ibm.cos#ThingProtection", "traits": {"smithy.api#xmlName": "Thing"}}
+		},
+		"com.ibm.cos#PutThing": {
+			"type": "operation",
+			"input": {"target": "com.ibm.cos#PutThingRequest"},
+			"output": {"target": "smithy.api#Unit"},
+			"traits": {
+				"smithy.api#http": {"method": "PUT", "uri": "/{Bucket}/{Key+}"},
+				"aws.auth#unsignedPayload": {}
+			}
+		},
+		"com.ibm.cos#PutThingRequest": {
+			"type": "structure",
+			"members": {
+				"Bucket": {"target": "smithy.api#String", "traits": {"smithy.api#httpLabel": {}, "smithy.api#required": {}}},
+				"Key": {"target": "smithy.api#String", "traits": {"smithy.api#httpLabel": {}, "smithy.api#required": {}}},
+				"Metadata": {"target": "com.ibm.cos#Metadata", "traits": {"smithy.api#httpPrefixHeaders": "x-amz-meta-"}},
+				"Body": {"target": "com.ibm.cos#Body", "traits": {"smithy.api#httpPayload": {}}}
+			}
+		},
+		"com.ibm.cos#Metadata": {
+			"type": "map",
+			"key": {"target": "smithy.api#String"},
+			"value": {"target": "smithy.api#String"}
+		},
+		"com.ibm.cos#Body": {"type": "blob", "traits": {"smithy.api#streaming": {}}}
+	}
+}`
+
+func TestAttachSmithyString(t *testing.T) {
+	a := &API{BaseImportPath: "github.com/IBM/ibm-cos-sdk-go/service"}
+	if err := a.AttachSmithyString(testSmithyModel); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	md := a.Metadata
+	if e, a := "rest-xml", md.Protocol; e != a {
+		t.Errorf("expect %v protocol, got %v", e, a)
+	}
+	if e, a := "2024-01-01", md.APIVersion; e != a {
+		t.Errorf("expect %v version, got %v", e, a)
+	}
+	if e, a := "s3", md.SigningName; e != a {
+		t.Errorf("expect %v signing name, got %v", e, a)
+	}
+	if e, a := "cos", md.EndpointPrefix; e != a {
+		t.Errorf("expect %v endpoint prefix, got %v", e, a)
+	}
+	if e, a := "costest", a.PackageName(); e != a {
+		t.Errorf("expect %v package, got %v", e, a)
+	}
+
+	var ops []string
+	for _, o := range a.OperationList() {
+		ops = append(ops, o.ExportedName)
+	}
+	if e, a := []string{"GetThing", "ListThings", "PutThing"}, ops; !reflect.DeepEqual(e, a) {
+		t.Fatalf("expect %v operations, got %v", e, a)
+	}
+
+	get := a.Operations["GetThing"]
+	if e, a := (HTTPInfo{Method: "GET", RequestURI: "/{Bucket}?protection", ResponseCode: 200}), get.HTTP; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if !strings.Contains(get.Documentation, "Returns the protection of a thing.") {
+		t.Errorf("expect documentation, got %q", get.Documentation)
+	}
+	if e, a := "GetThingInput", get.InputRef.Shape.ShapeName; e != a {
+		t.Errorf("expect %v input, got %v", e, a)
+	}
+	in := get.InputRef.Shape
+	if e, a := "uri", in.MemberRefs["Bucket"].Location; e != a {
+		t.Errorf("expect %v location, got %v", e, a)
+	}
+	if !in.IsRequired("Bucket") {
+		t.Errorf("expect Bucket required")
+	}
+	if e, a := "x-amz-expected-bucket-owner", in.MemberRefs["ExpectedOwner"].LocationName; e != a {
+		t.Errorf("expect %v header, got %v", e, a)
+	}
+
+	out := get.OutputRef.Shape
+	if e, a := "RetentionDays", out.MemberRefs["Days"].LocationName; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "iso8601", out.MemberRefs["Since"].TimestampFormat; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := []string{"Locked", "UNLOCKED"}, out.MemberRefs["Status"].Shape.Enum; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v enum, got %v", e, a)
+	}
+
+	if e, a := 1, len(get.ErrorRefs); e != a {
+		t.Fatalf("expect %v error, got %v", e, a)
+	}
+	if s := get.ErrorRefs[0].Shape; !s.Exception || s.ErrorInfo.HTTPStatusCode != 404 {
+		t.Errorf("expect 404 exception, got %v, %v", s.Exception, s.ErrorInfo.HTTPStatusCode)
+	}
+
+	list := a.Operations["ListThings"]
+	if list.Paginator == nil {
+		t.Fatalf("expect paginator")
+	}
+	if e, a := []string{"Marker"}, list.Paginator.InputTokens; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v input tokens, got %v", e, a)
+	}
+	if e, a := "MaxThings", list.Paginator.LimitKey; e != a {
+		t.Errorf("expect %v limit key, got %v", e, a)
+	}
+	if !list.OutputRef.Shape.MemberRefs["Things"].Flattened {
+		t.Errorf("expect Things flattened")
+	}
+
+	put := a.Operations["PutThing"]
+	if e, a := V4UnsignedBodyAuthType, put.AuthType; e != a {
+		t.Errorf("expect %v auth, got %v", e, a)
+	}
+	if e, a := "Body", put.InputRef.Shape.Payload; e != a {
+		t.Errorf("expect %v payload, got %v", e, a)
+	}
+	if e, a := "headers", put.InputRef.Shape.MemberRefs["Metadata"].Location; e != a {
+		t.Errorf("expect %v location, got %v", e, a)
+	}
+	if e, a := "PutThingOutput", put.OutputRef.Shape.ShapeName; e != a {
+		t.Errorf("expect %v output, got %v", e, a)
+	}
+
+	if e, a := 1, len(a.Waiters); e != a {
+		t.Fatalf("expect %v waiter, got %v", e, a)
+	}
+	w := a.Waiters[0]
+	if e, a := "ThingProtected", w.Name; e != a {
+		t.Errorf("expect %v waiter, got %v", e, a)
+	}
+	if !w.Typed() || w.MinDelay != 5 || w.MaxDelay != 120 {
+		t.Errorf("expect typed waiter with 5s to 120s delay, got %v, %v", w.MinDelay, w.MaxDelay)
+	}
+	if e, a := 120, w.MaxAttempts; e != a {
+		t.Errorf("expect %v max attempts, got %v", e, a)
+	}
+	if e, a := "NoSuchThing", w.Acceptors[1].Expected; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	for _, code := range []string{a.APIGoCode(), a.WaitersGoCode()} {
+		if _, err := parser.ParseFile(token.NewFileSet(), "api.go", "package costest\n\n"+code, 0); err != nil {
+			t.Errorf("expect generated code to parse, got %v", err)
+		}
+	}
+}
+
+func TestAttachSmithyString_Errors(t *testing.T) {
+	cases := map[string]struct {
+		Model     string
+		ExpectErr string
+	}{
+		"no service": {
+			Model:     `{"smithy": "2.0", "shapes": {}}`,
+			ExpectErr: "must contain one service shape",
+		},
+		"no protocol": {
+			Model:     `{"smithy": "2.0", "shapes": {"a#S": {"type": "service", "version": "1"}}}`,
+			ExpectErr: "no supported protocol",
+		},
+		"unsupported version": {
+			Model:     `{"smithy": "3.0", "shapes": {}}`,
+			ExpectErr: "unsupported Smithy model version",
+		},
+		"missing shape": {
+			Model: `{"smithy": "2.0", "shapes": {
+				"a#S": {"type": "service", "version": "1", "operations": [{"target": "a#Op"}],
+					"traits": {"aws.protocols#restJson1": {}}},
+				"a#Op": {"type": "operation", "input": {"target": "a#Missing"}}
+			}}`,
+			ExpectErr: "shape a#Missing not found",
+		},
+		"conflicting names": {
+			Model: `{"smithy": "2.0", "shapes": {
+				"a#S": {"type": "service", "version": "1", "operations": [{"target": "a#Op"}],
+					"traits": {"aws.protocols#restJson1": {}}},
+				"a#Op": {"type": "operation", "input": {"target": "a#In"}},
+				"a#In": {"type": "structure", "members": {
+					"A": {"target": "a#Name"}, "B": {"target": "b#Name"}
+				}},
+				"a#Name": {"type": "string"},
+				"b#Name": {"type": "string"}
+			}}`,
+			ExpectErr: "conflicts with shape",
+		},
+		"union": {
+			Model: `{"smithy": "2.0", "shapes": {
+				"a#S": {"type": "service", "version": "1", "operations": [{"target": "a#Op"}],
+					"traits": {"aws.protocols#restJson1": {}}},
+				"a#Op": {"type": "operation", "input": {"target": "a#U"}},
+				"a#U": {"type": "union", "members": {"A": {"target": "smithy.api#String"}}}
+			}}`,
+			ExpectErr: "unsupported type union",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			a := &API{}
+			err := a.AttachSmithyString(c.Model)
+			if err == nil || !strings.Contains(err.Error(), c.ExpectErr) {
+				t.Errorf("expect error to contain %q, got %v", c.ExpectErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,500 @@
+//go:build codegen
+// +build codegen
+
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// TypedPackageName returns the package name of the typed client of the API.
+func (a *API) TypedPackageName() string {
+	return a.PackageName() + "typed"
+}
+
+// TypedClientGoCode returns the Go code of the typed client of the API,
+// generated in the API's typed package alongside the service client.
+//
+// The typed client calls the operations of the service interface with
+// context-first methods taking per-call functional options. Its shapes
+// mirror the service client's shapes, with optional scalar members as
+// aws.Optional values, required scalar members as values, and enums as
+// typed strings. Event stream operations are not generated.
+func (a *API) TypedClientGoCode() string {
+	c := newTypedClient(a)
+
+	a.resetImports()
+	a.AddImport("context")
+	a.AddSDKImport("aws")
+	a.AddSDKImport("aws/request")
+	a.AddImport(a.ImportPath())
+	a.AddImport(a.ImportPath() + "/" + a.InterfacePackageName())
+
+	var buf bytes.Buffer
+	if err := tplTypedClient.Execute(&buf, c); err != nil {
+		panic(fmt.Sprintf("failed to execute %s template, %v", tplTypedClient.Name(), err))
+	}
+
+	return a.importsGoCode() + strings.TrimSpace(buf.String())
+}
+
+// typedClient is the operations and shapes of the typed client of an API.
+type typedClient struct {
+	API        *API
+	Operations []*Operation
+	Structs    []*Shape
+	Enums      []*Shape
+
+	// Structure shapes converted to, and from, the service client's shapes.
+	toSDK   map[string]bool
+	fromSDK map[string]bool
+}
+
+func newTypedClient(a *API) *typedClient {
+	c := &typedClient{
+		API:     a,
+		toSDK:   map[string]bool{},
+		fromSDK: map[string]bool{},
+	}
+
+	enums := map[string]*Shape{}
+	structs := map[string]*Shape{}
+	for _, o := range a.OperationList() {
+		if o.EventStreamAPI != nil {
+			continue
+		}
+		c.Operations = append(c.Operations, o)
+		c.walk(o.InputRef.Shape, c.toSDK, structs, enums)
+		c.walk(o.OutputRef.Shape, c.fromSDK, structs, enums)
+	}
+
+	c.Structs = sortedShapes(structs)
+	c.Enums = sortedShapes(enums)
+	return c
+}
+
+// walk adds the structure and enum shapes reachable from the shape.
+func (c *typedClient) walk(s *Shape, visited map[string]bool, structs, enums map[string]*Shape) {
+	switch {
+	case s.IsEnum():
+		enums[s.ShapeName] = s
+	case s.Type == "list":
+		c.walk(s.MemberRef.Shape, visited, structs, enums)
+	case s.Type == "map":
+		c.walk(s.ValueRef.Shape, visited, structs, enums)
+	case s.Type == "structure":
+		if visited[s.ShapeName] {
+			return
+		}
+		visited[s.ShapeName] = true
+		structs[s.ShapeName] = s
+		for _, name := range s.MemberNames() {
+			if ref := s.MemberRefs[name]; !ref.Shape.IsEventStream {
+				c.walk(ref.Shape, visited, structs, enums)
+			}
+		}
+	}
+}
+
+func sortedShapes(shapes map[string]*Shape) []*Shape {
+	names := make([]string, 0, len(shapes))
+	for name := range shapes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	list := make([]*Shape, 0, len(names))
+	for _, name := range names {
+		list = append(list, shapes[name])
+	}
+	return list
+}
+
+// ToSDK returns if the structure shape is converted to the service client's
+// shape.
+func (c *typedClient) ToSDK(s *Shape) bool {
+	return c.toSDK[s.ShapeName]
+}
+
+// FromSDK returns if the structure shape is converted from the service
+// client's shape.
+func (c *typedClient) FromSDK(s *Shape) bool {
+	return c.fromSDK[s.ShapeName]
+}
+
+// Members returns the names of the members of the structure shape generated
+// in the typed client.
+func (c *typedClient) Members(s *Shape) []string {
+	var names []string
+	for _, name := range s.MemberNames() {
+		if !s.MemberRefs[name].Shape.IsEventStream {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// isStreamingPayload returns if the member is the streaming payload of the
+// structure, typed as an io.ReadSeeker or io.ReadCloser.
+func isStreamingPayload(s *Shape, name string, ref *ShapeRef) bool {
+	return (ref.Streaming || ref.Shape.Streaming) && s.Payload == name
+}
+
+// isOptionalScalar returns if the member is a scalar not required by the
+// structure, typed as an aws.Optional.
+func isOptionalScalar(s *Shape, name string, ref *ShapeRef) bool {
+	return ref.UseIndirection() && !s.IsRequired(name)
+}
+
+// FieldType returns the Go type of the member of the structure shape.
+func (c *typedClient) FieldType(s *Shape, name string) string {
+	ref := s.MemberRefs[name]
+	switch {
+	case isStreamingPayload(s, name, ref):
+		c.API.AddImport("io")
+		if strings.HasSuffix(s.ShapeName, "Output") {
+			return "io.ReadCloser"
+		}
+		return "io.ReadSeeker"
+	case ref.JSONValue:
+		return "aws.JSONValue"
+	case ref.Shape.Type == "structure":
+		return "*" + ref.Shape.ShapeName
+	case isOptionalScalar(s, name, ref):
+		return "aws.Optional[" + c.elemType(ref.Shape) + "]"
+	default:
+		return c.elemType(ref.Shape)
+	}
+}
+
+// elemType returns the Go value type of the shape.
+func (c *typedClient) elemType(s *Shape) string {
+	switch {
+	case s.IsEnum(), s.Type == "structure":
+		return s.ShapeName
+	case s.Type == "list":
+		return "[]" + c.elemType(s.MemberRef.Shape)
+	case s.Type == "map":
+		return "map[string]" + c.elemType(s.ValueRef.Shape)
+	case s.Type == "timestamp":
+		c.API.AddImport("time")
+		return "time.Time"
+	}
+	return strings.TrimPrefix(s.GoTypeWithPkgName(), "*")
+}
+
+// scalarFuncs are the aws package functions converting scalar values to, and
+// from, pointers.
+var scalarFuncs = map[string][2]string{
+	"boolean":   {"aws.Bool", "aws.BoolValue"},
+	"string":    {"aws.String", "aws.StringValue"},
+	"character": {"aws.String", "aws.StringValue"},
+	"byte":      {"aws.Int64", "aws.Int64Value"},
+	"short":     {"aws.Int64", "aws.Int64Value"},
+	"integer":   {"aws.Int64", "aws.Int64Value"},
+	"long":      {"aws.Int64", "aws.Int64Value"},
+	"float":     {"aws.Float64", "aws.Float64Value"},
+	"double":    {"aws.Float64", "aws.Float64Value"},
+	"timestamp": {"aws.Time", "aws.TimeValue"},
+}
+
+// ToSDKMember returns the Go code converting the member of the structure
+// shape, of the value v, to the service client's member.
+func (c *typedClient) ToSDKMember(s *Shape, name string) string {
+	ref := s.MemberRefs[name]
+	v := "v." + name
+	switch {
+	case isStreamingPayload(s, name, ref), ref.JSONValue:
+		return v
+	case ref.Shape.Type == "structure":
+		return v + ".toSDK()"
+	case isOptionalScalar(s, name, ref):
+		if ref.Shape.IsEnum() {
+			return "enumPtr(" + v + ")"
+		}
+		return v + ".Ptr()"
+	case ref.UseIndirection():
+		if ref.Shape.IsEnum() {
+			return "aws.String(string(" + v + "))"
+		}
+		return scalarFuncs[ref.Shape.Type][0] + "(" + v + ")"
+	}
+	return c.toSDKCollection(ref.Shape, v)
+}
+
+// FromSDKMember returns the Go code converting the service client's member
+// of the structure shape, of the value v, to the typed member.
+func (c *typedClient) FromSDKMember(s *Shape, name string) string {
+	ref := s.MemberRefs[name]
+	v := "v." + name
+	switch {
+	case isStreamingPayload(s, name, ref), ref.JSONValue:
+		return v
+	case ref.Shape.Type == "structure":
+		return "new" + ref.Shape.ShapeName + "(" + v + ")"
+	case isOptionalScalar(s, name, ref):
+		if ref.Shape.IsEnum() {
+			return "enumOptional[" + ref.Shape.ShapeName + "](" + v + ")"
+		}
+		return "aws.OptionalOf(" + v + ")"
+	case ref.UseIndirection():
+		if ref.Shape.IsEnum() {
+			return ref.Shape.ShapeName + "(aws.StringValue(" + v + "))"
+		}
+		return scalarFuncs[ref.Shape.Type][1] + "(" + v + ")"
+	}
+	return c.fromSDKCollection(ref.Shape, v)
+}
+
+// toSDKCollection returns the Go code converting the list, map or blob
+// value v to the service client's type.
+func (c *typedClient) toSDKCollection(s *Shape, v string) string {
+	switch s.Type {
+	case "list":
+		return "sliceOf(" + v + ", " + c.toSDKFunc(s.MemberRef.Shape) + ")"
+	case "map":
+		return "mapOf(" + v + ", " + c.toSDKFunc(s.ValueRef.Shape) + ")"
+	}
+	return v
+}
+
+// fromSDKCollection returns the Go code converting the service client's
+// list, map or blob value v to the typed value.
+func (c *typedClient) fromSDKCollection(s *Shape, v string) string {
+	switch s.Type {
+	case "list":
+		return "sliceOf(" + v + ", " + c.fromSDKFunc(s.MemberRef.Shape) + ")"
+	case "map":
+		return "mapOf(" + v + ", " + c.fromSDKFunc(s.ValueRef.Shape) + ")"
+	}
+	return v
+}
+
+// toSDKFunc returns the Go code of the function converting list elements,
+// or map values, of the shape to the service client's type.
+func (c *typedClient) toSDKFunc(s *Shape) string {
+	switch {
+	case s.IsEnum():
+		return "enumToSDK[" + s.ShapeName + "]"
+	case s.Type == "structure":
+		return fmt.Sprintf("func(v %s) %s { return v.toSDK() }",
+			s.ShapeName, s.GoTypeWithPkgName())
+	case s.Type == "list", s.Type == "map":
+		return fmt.Sprintf("func(v %s) %s { return %s }",
+			c.elemType(s), s.GoTypeWithPkgName(), c.toSDKCollection(s, "v"))
+	case s.Type == "blob", s.Type == "jsonvalue":
+		return "unchanged[" + c.elemType(s) + "]"
+	}
+	return scalarFuncs[s.Type][0]
+}
+
+// fromSDKFunc returns the Go code of the function converting the service
+// client's list elements, or map values, of the shape to the typed value.
+func (c *typedClient) fromSDKFunc(s *Shape) string {
+	switch {
+	case s.IsEnum():
+		return "enumFromSDK[" + s.ShapeName + "]"
+	case s.Type == "structure":
+		return fmt.Sprintf("func(v %s) %s { return deref(new%s(v)) }",
+			s.GoTypeWithPkgName(), s.ShapeName, s.ShapeName)
+	case s.Type == "list", s.Type == "map":
+		return fmt.Sprintf("func(v %s) %s { return %s }",
+			s.GoTypeWithPkgName(), c.elemType(s), c.fromSDKCollection(s, "v"))
+	case s.Type == "blob", s.Type == "jsonvalue":
+		return "unchanged[" + c.elemType(s) + "]"
+	}
+	return scalarFuncs[s.Type][1]
+}
+
+// tplTypedClient defines the template of the typed client. Each operation
+// method converts its input to the service client's input, calls the
+// operation's WithContext method of the service interface, and converts the
+// output back.
+var tplTypedClient = template.Must(template.New("typedClient").Funcs(template.FuncMap{
+	"GetDeprecatedMsg": getDeprecatedMessage,
+}).Parse(`
+{{ $pkg := .API.PackageName -}}
+{{ $iface := printf "%s.%sAPI" .API.InterfacePackageName .API.StructName -}}
+// Client is a context-first client of the {{ .API.NiceName }} API operations.
+type Client struct {
+	api      {{ $iface }}
+	defaults Options
+}
+
+// New returns a Client calling the operations of the service client, or of
+// any implementation of {{ $iface }}. The options are applied to each
+// operation call before the call's options.
+func New(api {{ $iface }}, optFns ...func(*Options)) *Client {
+	c := &Client{api: api}
+	for _, fn := range optFns {
+		fn(&c.defaults)
+	}
+	return c
+}
+
+// Options are the options of an operation call.
+type Options struct {
+	// RequestOptions are applied to the request of the operation.
+	RequestOptions []request.Option
+}
+
+// WithRequestOptions returns an option adding the request options to the
+// operation call.
+func WithRequestOptions(opts ...request.Option) func(*Options) {
+	return func(o *Options) {
+		o.RequestOptions = append(o.RequestOptions, opts...)
+	}
+}
+
+// options returns the client's options updated by the call's options.
+func (c *Client) options(optFns []func(*Options)) Options {
+	o := Options{
+		RequestOptions: append([]request.Option{}, c.defaults.RequestOptions...),
+	}
+	for _, fn := range optFns {
+		fn(&o)
+	}
+	return o
+}
+
+{{ range $_, $o := .Operations }}
+// {{ $o.ExportedName }} calls the {{ $.API.NiceName }} API operation {{ $o.ExportedName }}.
+//
+// See {{ $pkg }}.{{ $.API.StructName }}.{{ $o.ExportedName }} for the documentation of the operation.
+{{- if $o.Deprecated }}
+//
+// Deprecated: {{ GetDeprecatedMsg $o.DeprecatedMsg $o.ExportedName }}
+{{- end }}
+func (c *Client) {{ $o.ExportedName }}(ctx context.Context, input *{{ $o.InputRef.Shape.ShapeName }}, ` +
+	`optFns ...func(*Options)) (*{{ $o.OutputRef.Shape.ShapeName }}, error) {
+	o := c.options(optFns)
+	out, err := c.api.{{ $o.ExportedName }}WithContext(ctx, input.toSDK(), o.RequestOptions...)
+	if err != nil {
+		return nil, err
+	}
+	return new{{ $o.OutputRef.Shape.ShapeName }}(out), nil
+}
+{{ end }}
+
+{{ range $_, $s := .Structs }}
+// {{ $s.ShapeName }} is the value-typed {{ $pkg }}.{{ $s.ShapeName }}.
+type {{ $s.ShapeName }} struct {
+	{{- range $_, $name := $.Members $s }}
+	{{ $name }} {{ $.FieldType $s $name }}
+	{{- end }}
+}
+{{ if $.ToSDK $s }}
+func (v *{{ $s.ShapeName }}) toSDK() *{{ $pkg }}.{{ $s.ShapeName }} {
+	if v == nil {
+		return nil
+	}
+	return &{{ $pkg }}.{{ $s.ShapeName }}{
+		{{- range $_, $name := $.Members $s }}
+		{{ $name }}: {{ $.ToSDKMember $s $name }},
+		{{- end }}
+	}
+}
+{{ end }}
+{{- if $.FromSDK $s }}
+func new{{ $s.ShapeName }}(v *{{ $pkg }}.{{ $s.ShapeName }}) *{{ $s.ShapeName }} {
+	if v == nil {
+		return nil
+	}
+	return &{{ $s.ShapeName }}{
+		{{- range $_, $name := $.Members $s }}
+		{{ $name }}: {{ $.FromSDKMember $s $name }},
+		{{- end }}
+	}
+}
+{{ end }}
+{{ end }}
+
+{{ range $_, $s := .Enums }}
+// {{ $s.ShapeName }} is the typed {{ $pkg }}.{{ $s.ShapeName }} enum.
+type {{ $s.ShapeName }} string
+
+const (
+	{{ range $index, $elem := $s.Enum -}}
+	{{ $name := index $s.EnumConsts $index -}}
+	// {{ $name }} is a {{ $s.ShapeName }} enum value
+	{{ $name }} {{ $s.ShapeName }} = "{{ $elem }}"
+
+	{{ end }}
+)
+
+// Values returns all elements of the {{ $s.ShapeName }} enum.
+func ({{ $s.ShapeName }}) Values() []{{ $s.ShapeName }} {
+	return []{{ $s.ShapeName }}{
+		{{ range $index, $elem := $s.Enum -}}
+		{{ index $s.EnumConsts $index }},
+		{{ end }}
+	}
+}
+{{ end }}
+
+// sliceOf returns the elements of the slice converted by fn, or nil if the
+// slice is nil.
+func sliceOf[T, U any](v []T, fn func(T) U) []U {
+	if v == nil {
+		return nil
+	}
+	out := make([]U, len(v))
+	for i := range v {
+		out[i] = fn(v[i])
+	}
+	return out
+}
+
+// mapOf returns the values of the map converted by fn, or nil if the map is
+// nil.
+func mapOf[T, U any](v map[string]T, fn func(T) U) map[string]U {
+	if v == nil {
+		return nil
+	}
+	out := make(map[string]U, len(v))
+	for k, e := range v {
+		out[k] = fn(e)
+	}
+	return out
+}
+
+// deref returns the value the pointer refers to, or the zero value of T if
+// the pointer is nil.
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
+func unchanged[T any](v T) T {
+	return v
+}
+
+func enumToSDK[T ~string](v T) *string {
+	return aws.String(string(v))
+}
+
+func enumFromSDK[T ~string](v *string) T {
+	return T(aws.StringValue(v))
+}
+
+func enumPtr[T ~string](v aws.Optional[T]) *string {
+	if e, ok := v.Get(); ok {
+		return enumToSDK(e)
+	}
+	return nil
+}
+
+func enumOptional[T ~string](v *string) aws.Optional[T] {
+	if v == nil {
+		return aws.None[T]()
+	}
+	return aws.Some(T(*v))
+}
+`))
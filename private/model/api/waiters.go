@@ -40,8 +40,62 @@ type Waiter struct {
 	OperationName string `json:"operation"`
 	Operation     *Operation
 	Acceptors     []WaiterAcceptor
+
+	// IBM COS SDK Code -- START
+	// MinDelay and MaxDelay, in seconds, of the jittered backoff of typed
+	// waiters. Waiters with a MinDelay are generated as request.TypedWaiter.
+	MinDelay int
+	MaxDelay int
+	// MaxWait is the total time, in seconds, a typed waiter waits. Typed
+	// waiters also require a MaxAttempts, stopping them if the max wait time
+	// is disabled.
+	MaxWait int
+	// Description of the condition the waiter waits for, if any.
+	Description string
+	// IBM COS SDK Code -- END
+}
+
+// IBM COS SDK Code -- START
+
+// Typed returns if the waiter is generated as a request.TypedWaiter.
+func (w *Waiter) Typed() bool {
+	return w.MinDelay > 0
+}
+
+// Validate returns an error if the waiter definition cannot be generated.
+func (w *Waiter) Validate() error {
+	if w.Typed() && w.MaxAttempts <= 0 {
+		return fmt.Errorf("typed waiter %s: maxAttempts is required", w.Name)
+	}
+	return nil
 }
 
+// TypedMatcher returns the Go code of the request.TypedAcceptor matcher of
+// the acceptor of the typed waiter.
+//
+// The argument of "function" matchers is the name of a matcher function of
+// the service package, for conditions the other matchers cannot express,
+// e.g. parsing a header.
+func (w *Waiter) TypedMatcher(a WaiterAcceptor) string {
+	outType := w.Operation.OutputRef.GoType()
+	switch a.Matcher {
+	case "status":
+		return fmt.Sprintf("request.MatchStatus[%s](%s)", outType, a.ExpectedString())
+	case "error":
+		return fmt.Sprintf("request.MatchErrorCode[%s](%s)", outType, a.ExpectedString())
+	case "path", "pathAll":
+		return fmt.Sprintf("request.MatchPath[%s](%q, %s)", outType, a.Argument, a.ExpectedString())
+	case "pathAny":
+		return fmt.Sprintf("request.MatchPathAny[%s](%q, %s)", outType, a.Argument, a.ExpectedString())
+	case "function":
+		return a.Argument
+	default:
+		panic(fmt.Sprintf("waiter %s: unsupported typed waiter matcher %s", w.Name, a.Matcher))
+	}
+}
+
+// IBM COS SDK Code -- END
+
 // WaitersGoCode generates and returns Go code for each of the waiters of
 // this API.
 func (a *API) WaitersGoCode() string {
@@ -100,6 +154,11 @@ func (p *waiterDefinitions) setup() error {
 		if e.Operation == nil {
 			continue
 		}
+		// IBM COS SDK Code -- START
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		// IBM COS SDK Code -- END
 		p.API.Waiters = append(p.API.Waiters, e)
 	}
 
@@ -111,6 +170,10 @@ var waiterTmpls = template.Must(template.New("waiterTmpls").Funcs(
 		"titleCase": func(v string) string {
 			return strings.Title(v)
 		},
+		// IBM COS SDK Code -- START
+		"commentify": commentify,
+		"wrap":       wrap,
+		// IBM COS SDK Code -- END
 	},
 ).Parse(`
 {{ define "waiter"}}
@@ -164,6 +227,69 @@ func (c *{{ .Operation.API.StructName }}) WaitUntil{{ .Name }}WithContext(` +
 }
 {{- end }}
 
+{{ define "typed waiter"}}
+// WaitUntil{{ .Name }} uses the {{ .Operation.API.NiceName }} API operation
+// {{ .OperationName }} to wait for a condition to be met before returning.
+// If the condition is not met within the max attempt window, or max wait time,
+// an error will be returned.
+{{- if .Description }}
+//
+{{ commentify (wrap .Description 72) }}
+{{- end }}
+func (c *{{ .Operation.API.StructName }}) WaitUntil{{ .Name }}(input {{ .Operation.InputRef.GoType }}) error {
+	return c.WaitUntil{{ .Name }}WithContext(aws.BackgroundContext(), input)
+}
+
+// WaitUntil{{ .Name }}WithContext is an extended version of WaitUntil{{ .Name }}.
+// With the support for passing in a context and options to configure the
+// Waiter and the underlying request options.
+//
+{{ if eq .MinDelay .MaxDelay -}}
+// The waiter waits {{ .MinDelay }} seconds between attempts, for up to
+// {{ .MaxAttempts }} attempts{{ if .MaxWait }} or {{ .MaxWait }} seconds{{ end }}.
+{{- else -}}
+// The waiter backs off from {{ .MinDelay }} to {{ .MaxDelay }} seconds between attempts, with
+// jitter, for up to {{ .MaxAttempts }} attempts{{ if .MaxWait }} or {{ .MaxWait }} seconds{{ end }}.
+{{- end }}
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *{{ .Operation.API.StructName }}) WaitUntil{{ .Name }}WithContext(` +
+	`ctx aws.Context, input {{ .Operation.InputRef.GoType }}, opts ...request.WaiterOption) error {
+	w := request.TypedWaiter[{{ .Operation.OutputRef.GoType }}]{
+		Name:    "WaitUntil{{ .Name }}",
+		MaxAttempts: {{ .MaxAttempts }},
+		Delay: request.JitteredWaiterDelay({{ .MinDelay }} * time.Second, {{ .MaxDelay }} * time.Second),
+		MaxWait: {{ .MaxWait }} * time.Second,
+		Acceptors: []request.TypedAcceptor[{{ .Operation.OutputRef.GoType }}]{
+			{{ range $_, $a := .Acceptors }}{
+				State:    request.{{ titleCase .State }}WaiterState,
+				Matcher:  {{ $.TypedMatcher $a }},
+			},
+			{{ end }}
+		},
+		Logger: c.Config.Logger,
+		NewRequest: func(opts []request.Option) (*request.Request, {{ .Operation.OutputRef.GoType }}, error) {
+			var inCpy {{ .Operation.InputRef.GoType }}
+			if input != nil  {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, out := c.{{ .OperationName }}Request(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, out, nil
+		},
+	}
+	w.ApplyOptions(opts...)
+
+	_, err := w.WaitWithContext(ctx)
+	return err
+}
+{{- end }}
+
 {{ define "waiter interface" }}
 WaitUntil{{ .Name }}({{ .Operation.InputRef.GoTypeWithPkgName }}) error
 WaitUntil{{ .Name }}WithContext(aws.Context, {{ .Operation.InputRef.GoTypeWithPkgName }}, ...request.WaiterOption) error
@@ -183,8 +309,14 @@ func (w *Waiter) InterfaceSignature() string {
 
 // GoCode returns the generated Go code for an individual waiter.
 func (w *Waiter) GoCode() string {
+	// IBM COS SDK Code -- START
+	tmpl := "waiter"
+	if w.Typed() {
+		tmpl = "typed waiter"
+	}
+	// IBM COS SDK Code -- END
 	var buf bytes.Buffer
-	if err := waiterTmpls.ExecuteTemplate(&buf, "waiter", w); err != nil {
+	if err := waiterTmpls.ExecuteTemplate(&buf, tmpl, w); err != nil {
 		panic(err)
 	}
 
@@ -64,6 +64,13 @@ func main() {
 	var strictServiceId bool
 	flag.BoolVar(&strictServiceId, "use-service-id", false, "enforce strict usage of the serviceId from the model")
 
+	// IBM COS SDK Code -- START
+	var typedClients string
+	flag.StringVar(&typedClients, "typed-clients", "",
+		"Comma separated `list` of service packages to also generate typed clients for.",
+	)
+	// IBM COS SDK Code -- END
+
 	flag.Usage = usage
 	flag.Parse()
 
@@ -140,6 +147,14 @@ func main() {
 			API:        a,
 			PackageDir: pkgDir,
 		}
+		// IBM COS SDK Code -- START
+		for _, pkg := range strings.Split(typedClients, ",") {
+			if pkg == a.PackageName() {
+				g.Typed = true
+				os.MkdirAll(filepath.Join(pkgDir, a.TypedPackageName()), 0775)
+			}
+		}
+		// IBM COS SDK Code -- END
 
 		wg.Add(1)
 		go func() {
@@ -154,6 +169,10 @@ func main() {
 type generateInfo struct {
 	*api.API
 	PackageDir string
+	// IBM COS SDK Code -- START
+	// Typed is set if the typed client of the API is generated.
+	Typed bool
+	// IBM COS SDK Code -- END
 }
 
 var excludeServices = map[string]struct{}{
@@ -177,6 +196,12 @@ func writeServiceFiles(g *generateInfo, pkgDir string) {
 	Must(writeAPIFile(g))
 	Must(writeServiceFile(g))
 	Must(writeInterfaceFile(g))
+	// IBM COS SDK Code -- START
+	Must(writeFakeFile(g))
+	if g.Typed {
+		Must(writeTypedClientFile(g))
+	}
+	// IBM COS SDK Code -- END
 	Must(writeWaitersFile(g))
 	Must(writeAPIErrorsFile(g))
 	Must(writeExamplesFile(g))
@@ -266,6 +291,46 @@ func writeInterfaceFile(g *generateInfo) error {
 	)
 }
 
+// IBM COS SDK Code -- START
+
+// writeFakeFile writes out the fake of the service interface.
+func writeFakeFile(g *generateInfo) error {
+	return writeGoFile(filepath.Join(g.PackageDir, g.API.InterfacePackageName(), "fake.go"),
+		codeLayout,
+		"",
+		g.API.InterfacePackageName(),
+		g.API.FakeGoCode(),
+	)
+}
+
+// writeTypedClientFile writes out the typed client of the service.
+func writeTypedClientFile(g *generateInfo) error {
+	const pkgDoc = `
+// Package %s provides a context-first client of the
+// %s operations, with per-call functional
+// options, value-typed optional fields and typed enums.
+//
+// The client calls the operations of the %s package's service client, or
+// of any implementation of its interface, and is generated alongside it.
+// Event stream operations, paginators and waiters are only available on the
+// service client.
+//
+//    svc := %s.New(%s.New(sess))
+//    out, err := svc.%s(ctx, &%s.%s{...},
+//        %s.WithRequestOptions(request.WithLogLevel(aws.LogDebug)))`
+	pkg := g.API.TypedPackageName()
+	op := g.API.OperationList()[0]
+	return writeGoFile(filepath.Join(g.PackageDir, pkg, "api.go"),
+		codeLayout,
+		fmt.Sprintf(pkgDoc, pkg, g.API.Metadata.ServiceFullName, g.API.PackageName(),
+			pkg, g.API.PackageName(), op.ExportedName, pkg, op.InputRef.Shape.ShapeName, pkg),
+		pkg,
+		g.API.TypedClientGoCode(),
+	)
+}
+
+// IBM COS SDK Code -- END
+
 func writeWaitersFile(g *generateInfo) error {
 	if len(g.API.Waiters) == 0 {
 		return nil
@@ -21,10 +21,13 @@ import (
 //
 //	-model The definition file to use
 //	-out The output file to generate
+//	-ibm-cos The definition file is an IBM COS endpoints model
 func main() {
 	var modelName, outName string
+	var ibmCOS bool
 	flag.StringVar(&modelName, "model", "", "Endpoints definition model")
 	flag.StringVar(&outName, "out", "", "File to write generated endpoints to.")
+	flag.BoolVar(&ibmCOS, "ibm-cos", false, "Generate IBM COS endpoints from the definition model")
 	flag.Parse()
 
 	if len(modelName) == 0 || len(outName) == 0 {
@@ -47,6 +50,13 @@ func main() {
 		}
 	}()
 
+	if ibmCOS {
+		if err := endpoints.CodeGenIBMCOSModel(modelFile, outFile); err != nil {
+			exitErrorf("failed to codegen IBM COS model, %v", err)
+		}
+		return
+	}
+
 	if err := endpoints.CodeGenModel(modelFile, outFile, func(o *endpoints.CodeGenOptions) {
 		o.DisableGenerateServiceIDs = true
 	}); err != nil {
@@ -0,0 +1,594 @@
+//go:build codegen
+// +build codegen
+
+// Command gen-iface-fakes generates configurable fakes of the interfaces
+// declared in a Go source file, e.g. the s3manageriface package.
+//
+//	gen-iface-fakes -in interface.go -out fake.go \
+//	    FakeUploader=UploaderAPI,UploadWithIterator
+//
+// Each fake implements the listed interfaces with a function field per
+// method. A method with a WithContext variant falls back to the function of
+// the variant, and the other way around.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+func main() {
+	in := flag.String("in", "interface.go", "Go source file declaring the interfaces")
+	out := flag.String("out", "fake.go", "file the fakes are written to")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		exitErrorf("usage: gen-iface-fakes -in <file> -out <file> <Fake>=<Interface>[,<Interface>...]...")
+	}
+
+	src, err := generate(*in, flag.Args())
+	if err != nil {
+		exitErrorf("failed to generate fakes of %s, %v", *in, err)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		exitErrorf("failed to write %s, %v", *out, err)
+	}
+}
+
+func exitErrorf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+type fakeFile struct {
+	Package string
+	Imports []string
+	Fakes   []*fake
+}
+
+// StdImports returns the imports of standard library packages.
+func (f *fakeFile) StdImports() []string {
+	var paths []string
+	for _, path := range f.Imports {
+		if !strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// OtherImports returns the imports of packages outside the standard library.
+func (f *fakeFile) OtherImports() []string {
+	var paths []string
+	for _, path := range f.Imports {
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// ExampleMethod returns the name of a method recorded by the fakes, for the
+// FakeCall doc comment. WithContext variants are preferred.
+func (f *fakeFile) ExampleMethod() string {
+	m := f.Fakes[0].Methods[0]
+	if m.WithContext != nil {
+		return m.WithContext.Name
+	}
+	return m.Name
+}
+
+type fake struct {
+	Name       string
+	Interfaces []string
+	Methods    []*method
+}
+
+// InterfaceList returns the names of the interfaces the fake implements,
+// for its doc comment.
+func (f *fake) InterfaceList() string {
+	switch n := len(f.Interfaces); n {
+	case 1:
+		return f.Interfaces[0]
+	default:
+		return strings.Join(f.Interfaces[:n-1], ", ") + " and " + f.Interfaces[n-1]
+	}
+}
+
+type param struct {
+	Name     string
+	Type     string
+	Variadic bool
+}
+
+type method struct {
+	Name    string
+	Params  []param
+	Results []string
+	Zeros   []string
+
+	// WithContext is the variant of the method taking an aws.Context as its
+	// first parameter, if the interfaces have one. Methods that are the
+	// WithContext variant of another method are generated with it.
+	WithContext *method
+	variantOf   *method
+}
+
+// Signature returns the named parameters of the method.
+func (m *method) Signature() string {
+	parts := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		if p.Variadic {
+			parts[i] = p.Name + " ..." + p.Type
+		} else {
+			parts[i] = p.Name + " " + p.Type
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// CallArgs returns the arguments forwarding the parameters of the method.
+func (m *method) CallArgs() string {
+	parts := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		parts[i] = p.Name
+		if p.Variadic {
+			parts[i] += "..."
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// RecordArgs returns the arguments recorded by calls of the method.
+func (m *method) RecordArgs() string {
+	parts := []string{strconv.Quote(m.Name)}
+	for _, p := range m.Params {
+		parts = append(parts, p.Name)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// FuncType returns the type of the function field of the method.
+func (m *method) FuncType() string {
+	parts := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		if p.Variadic {
+			parts[i] = "..." + p.Type
+		} else {
+			parts[i] = p.Type
+		}
+	}
+	return "func(" + strings.Join(parts, ", ") + ") " + m.ResultList()
+}
+
+// ResultList returns the results of the method.
+func (m *method) ResultList() string {
+	if len(m.Results) == 1 {
+		return m.Results[0]
+	}
+	return "(" + strings.Join(m.Results, ", ") + ")"
+}
+
+// NotImplemented returns the values returned by the method without a
+// function.
+func (m *method) NotImplemented(fakeName string) string {
+	return strings.Join(append(append([]string{}, m.Zeros...),
+		fmt.Sprintf("notImplemented(%q, %q)", fakeName, m.Name)), ", ")
+}
+
+// Fallback returns the parameters of the function calling either variant of
+// the method, with the context first and the variadic parameter last.
+func (m *method) Fallback() string {
+	v := m.WithContext
+	parts := []string{v.Params[0].Name + " " + v.Params[0].Type}
+	variadic := ""
+	for _, p := range m.Params {
+		if p.Variadic {
+			variadic = p.Name + " ..." + p.Type
+			continue
+		}
+		parts = append(parts, p.Name+" "+p.Type)
+	}
+	parts = append(parts, "withContext bool")
+	if len(variadic) != 0 {
+		parts = append(parts, variadic)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// FallbackArgs returns the arguments calling the fallback function of the
+// method with the context.
+func (m *method) FallbackArgs(ctx string, withContext bool) string {
+	parts := []string{ctx}
+	variadic := ""
+	for _, p := range m.Params {
+		if p.Variadic {
+			variadic = p.Name + "..."
+			continue
+		}
+		parts = append(parts, p.Name)
+	}
+	parts = append(parts, strconv.FormatBool(withContext))
+	if len(variadic) != 0 {
+		parts = append(parts, variadic)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func generate(filename string, specs []string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ifaces := map[string]*ast.InterfaceType{}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if it, ok := ts.Type.(*ast.InterfaceType); ok {
+				ifaces[ts.Name.Name] = it
+			}
+		}
+	}
+
+	imports := map[string]string{}
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = path
+	}
+	awsPath, ok := imports["aws"]
+	if !ok {
+		return nil, fmt.Errorf("%s does not import the aws package", filename)
+	}
+
+	used := map[string]struct{}{
+		"fmt":               {},
+		"sync":              {},
+		awsPath + "/awserr": {},
+	}
+	f := &fakeFile{Package: file.Name.Name}
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid fake %q, expect <Fake>=<Interface>[,<Interface>...]", spec)
+		}
+		fk := &fake{Name: parts[0], Interfaces: strings.Split(parts[1], ",")}
+		for _, name := range fk.Interfaces {
+			it, ok := ifaces[name]
+			if !ok {
+				return nil, fmt.Errorf("interface %s not found", name)
+			}
+			for _, field := range it.Methods.List {
+				ft, ok := field.Type.(*ast.FuncType)
+				if !ok {
+					return nil, fmt.Errorf("embedded interfaces of %s are not supported", name)
+				}
+				m, err := newMethod(fset, field.Names[0].Name, ft)
+				if err != nil {
+					return nil, fmt.Errorf("%s.%s, %v", name, field.Names[0].Name, err)
+				}
+				fk.Methods = append(fk.Methods, m)
+				ast.Inspect(ft, func(n ast.Node) bool {
+					if sel, ok := n.(*ast.SelectorExpr); ok {
+						if id, ok := sel.X.(*ast.Ident); ok {
+							if path, ok := imports[id.Name]; ok {
+								used[path] = struct{}{}
+							}
+						}
+					}
+					return true
+				})
+			}
+		}
+		pairContextVariants(fk.Methods)
+		for _, m := range fk.Methods {
+			if m.WithContext != nil {
+				used[awsPath] = struct{}{}
+			}
+		}
+		f.Fakes = append(f.Fakes, fk)
+	}
+
+	for path := range used {
+		f.Imports = append(f.Imports, path)
+	}
+	sort.Strings(f.Imports)
+
+	var buf bytes.Buffer
+	if err := tplFakes.Execute(&buf, f); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code, %v\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
+
+func newMethod(fset *token.FileSet, name string, ft *ast.FuncType) (*method, error) {
+	m := &method{Name: name}
+
+	names := map[string]int{}
+	for _, field := range ft.Params.List {
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			p := param{}
+			typ := field.Type
+			if ell, ok := typ.(*ast.Ellipsis); ok {
+				p.Variadic = true
+				typ = ell.Elt
+			}
+			p.Type = exprString(fset, typ)
+			p.Name = paramName(p, names)
+			m.Params = append(m.Params, p)
+		}
+	}
+
+	if ft.Results == nil {
+		return nil, fmt.Errorf("method must return an error")
+	}
+	for _, field := range ft.Results.List {
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			m.Results = append(m.Results, exprString(fset, field.Type))
+			m.Zeros = append(m.Zeros, zeroValue(fset, field.Type))
+		}
+	}
+	if m.Results[len(m.Results)-1] != "error" {
+		return nil, fmt.Errorf("method must return an error")
+	}
+	m.Zeros = m.Zeros[:len(m.Zeros)-1]
+
+	return m, nil
+}
+
+// pairContextVariants links the methods to their WithContext variants.
+func pairContextVariants(methods []*method) {
+	byName := map[string]*method{}
+	for _, m := range methods {
+		byName[m.Name] = m
+	}
+	for _, m := range methods {
+		v, ok := byName[m.Name+"WithContext"]
+		if !ok || len(v.Params) != len(m.Params)+1 || v.Params[0].Type != "aws.Context" ||
+			v.ResultList() != m.ResultList() {
+			continue
+		}
+		same := true
+		for i, p := range m.Params {
+			if q := v.Params[i+1]; p.Type != q.Type || p.Variadic != q.Variadic {
+				same = false
+			}
+		}
+		if same {
+			m.WithContext = v
+			v.variantOf = m
+		}
+	}
+}
+
+// IsVariant returns if the method is generated with the method it is the
+// WithContext variant of.
+func (m *method) IsVariant() bool {
+	return m.variantOf != nil
+}
+
+// paramName returns the name of the parameter, derived from its type.
+func paramName(p param, names map[string]int) string {
+	typ := strings.TrimLeft(p.Type, "*[]")
+	if i := strings.LastIndex(typ, "."); i >= 0 {
+		typ = typ[i+1:]
+	}
+
+	var name string
+	switch {
+	case p.Variadic:
+		name = "options"
+	case p.Type == "aws.Context":
+		name = "ctx"
+	case strings.HasSuffix(typ, "Input"):
+		name = "input"
+	case strings.HasSuffix(typ, "Iterator"):
+		name = "iter"
+	case p.Type == "io.WriterAt" || p.Type == "io.Writer":
+		name = "w"
+	case p.Type == "io.Reader":
+		name = "r"
+	default:
+		runes := []rune(typ)
+		runes[0] = unicode.ToLower(runes[0])
+		name = string(runes)
+		if token.Lookup(name).IsKeyword() {
+			name += "Arg"
+		}
+	}
+
+	names[name]++
+	if n := names[name]; n > 1 {
+		name += strconv.Itoa(n)
+	}
+	return name
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, fset, expr)
+	return buf.String()
+}
+
+// zeroValue returns the zero value of the type.
+func zeroValue(fset *token.FileSet, expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr, *ast.ArrayType, *ast.MapType, *ast.FuncType, *ast.InterfaceType, *ast.ChanType:
+		if at, ok := t.(*ast.ArrayType); ok && at.Len != nil {
+			return exprString(fset, expr) + "{}"
+		}
+		return "nil"
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return `""`
+		case "bool":
+			return "false"
+		case "error":
+			return "nil"
+		case "int", "int8", "int16", "int32", "int64",
+			"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+			"float32", "float64", "byte", "rune":
+			return "0"
+		}
+	}
+	return "*new(" + exprString(fset, expr) + ")"
+}
+
+var tplFakes = template.Must(template.New("fakes").Parse(`// Code generated by private/model/cli/gen-iface-fakes/main.go. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	{{- range $_, $path := .StdImports }}
+	"{{ $path }}"
+	{{- end }}
+{{ range $_, $path := .OtherImports }}
+	"{{ $path }}"
+	{{- end }}
+)
+
+// ErrCodeFakeNotImplemented is the error code returned by the fakes for
+// methods without a function set.
+const ErrCodeFakeNotImplemented = "FakeNotImplemented"
+
+// FakeCall is a method call recorded by a fake.
+type FakeCall struct {
+	// Name of the method called, e.g. "{{ .ExampleMethod }}".
+	Method string
+
+	// Arguments of the call. Variadic options are recorded as a single
+	// slice argument.
+	Args []interface{}
+}
+
+// fakeCalls records the calls of a fake.
+type fakeCalls struct {
+	m     sync.Mutex
+	calls []FakeCall
+}
+
+// Calls returns the calls of the fake, in order.
+func (f *fakeCalls) Calls() []FakeCall {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	return append([]FakeCall{}, f.calls...)
+}
+
+// CallsTo returns the calls of the fake to the method, in order.
+func (f *fakeCalls) CallsTo(method string) []FakeCall {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	var calls []FakeCall
+	for _, c := range f.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Reset clears the calls recorded by the fake.
+func (f *fakeCalls) Reset() {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	f.calls = nil
+}
+
+func (f *fakeCalls) record(method string, args ...interface{}) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	f.calls = append(f.calls, FakeCall{Method: method, Args: args})
+}
+
+func notImplemented(fake, method string) error {
+	return awserr.New(ErrCodeFakeNotImplemented,
+		fmt.Sprintf("%s.%s not implemented", fake, method), nil)
+}
+{{ range $_, $f := .Fakes }}
+{{ range $_, $i := $f.Interfaces -}}
+var _ {{ $i }} = (*{{ $f.Name }})(nil)
+{{ end }}
+// {{ $f.Name }} is a configurable fake of {{ $f.InterfaceList }}
+// for unit testing.
+//
+// Each method calls its function field when set, or the function of its
+// WithContext, or non-context, variant. Methods without a function return
+// an error with the ErrCodeFakeNotImplemented code. All calls are recorded,
+// and returned by Calls.
+type {{ $f.Name }} struct {
+	{{- range $_, $m := $f.Methods }}
+	{{ $m.Name }}Func {{ $m.FuncType }}
+	{{- end }}
+
+	fakeCalls
+}
+{{ range $_, $m := $f.Methods }}{{ if not $m.IsVariant }}{{ if $m.WithContext }}{{ $v := $m.WithContext }}
+// {{ $m.Name }} calls {{ $m.Name }}Func.
+func (f *{{ $f.Name }}) {{ $m.Name }}({{ $m.Signature }}) {{ $m.ResultList }} {
+	f.record({{ $m.RecordArgs }})
+	return f.call{{ $m.Name }}({{ $m.FallbackArgs "aws.BackgroundContext()" false }})
+}
+
+// {{ $v.Name }} calls {{ $v.Name }}Func.
+func (f *{{ $f.Name }}) {{ $v.Name }}({{ $v.Signature }}) {{ $v.ResultList }} {
+	f.record({{ $v.RecordArgs }})
+	return f.call{{ $m.Name }}({{ $m.FallbackArgs (index $v.Params 0).Name true }})
+}
+
+func (f *{{ $f.Name }}) call{{ $m.Name }}({{ $m.Fallback }}) {{ $m.ResultList }} {
+	if f.{{ $v.Name }}Func != nil && (withContext || f.{{ $m.Name }}Func == nil) {
+		return f.{{ $v.Name }}Func({{ $v.CallArgs }})
+	}
+	if f.{{ $m.Name }}Func != nil {
+		return f.{{ $m.Name }}Func({{ $m.CallArgs }})
+	}
+	return {{ $m.NotImplemented $f.Name }}
+}
+{{ else }}
+// {{ $m.Name }} calls {{ $m.Name }}Func.
+func (f *{{ $f.Name }}) {{ $m.Name }}({{ $m.Signature }}) {{ $m.ResultList }} {
+	f.record({{ $m.RecordArgs }})
+	if f.{{ $m.Name }}Func != nil {
+		return f.{{ $m.Name }}Func({{ $m.CallArgs }})
+	}
+	return {{ $m.NotImplemented $f.Name }}
+}
+{{ end }}{{ end }}{{ end }}{{ end }}`))
@@ -0,0 +1,42 @@
+package eventstream
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+)
+
+func FuzzDecode(f *testing.F) {
+	for _, class := range []string{"positive", "negative"} {
+		cases, err := readTests("testdata/encoded/" + class)
+		if err != nil {
+			f.Fatalf("expect test cases, got %v", err)
+		}
+		for _, encoded := range cases {
+			f.Add(encoded)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		logger := aws.LoggerFunc(func(...interface{}) {})
+		decoder := NewDecoder(bytes.NewReader(data), DecodeWithLogger(logger))
+		msg, err := decoder.Decode(nil)
+		if err != nil {
+			return
+		}
+
+		var buf bytes.Buffer
+		if err := NewEncoder(&buf).Encode(msg); err != nil {
+			t.Fatalf("expect decoded message to encode, got %v", err)
+		}
+		actual, err := Decode(&buf, nil)
+		if err != nil {
+			t.Fatalf("expect encoded message to decode, got %v", err)
+		}
+		if e, a := msg, actual; !reflect.DeepEqual(e, a) {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	})
+}
@@ -151,7 +151,9 @@ func decodeHeaderValue(r io.Reader) (Value, error) {
 		err = tv.decode(r)
 		v = tv
 	default:
-		panic(fmt.Sprintf("unknown value type %d", raw.Type))
+		// IBM COS SDK Code -- START
+		return nil, fmt.Errorf("unknown value type %d", raw.Type)
+		// IBM COS SDK Code -- END
 	}
 
 	// Error could be EOF, let caller deal with it
@@ -144,6 +144,19 @@ func TestHeader_DecodeValues(t *testing.T) {
 	}
 }
 
+// IBM COS SDK Code -- START
+func TestHeader_DecodeValues_UnknownType(t *testing.T) {
+	v, err := decodeHeaderValue(bytes.NewBuffer([]byte{48, 1, 2, 3}))
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if v != nil {
+		t.Errorf("expect no value, got %v", v)
+	}
+}
+
+// IBM COS SDK Code -- END
+
 func TestValue_Decode(t *testing.T) {
 	for i, c := range testValueEncodingCases {
 		if c.Decode == nil {
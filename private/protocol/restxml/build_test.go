@@ -6613,6 +6613,604 @@ type InputService27TestShapeInputService27TestCaseOperation1Output struct {
 	_ struct{} `type:"structure"`
 }
 
+// InputService28ProtocolTest provides the API operation methods for making requests to
+// . See this package's package overview docs
+// for details on the service.
+//
+// InputService28ProtocolTest methods are safe to use concurrently. It is not safe to
+// modify mutate any of the struct's properties though.
+type InputService28ProtocolTest struct {
+	*client.Client
+}
+
+// New creates a new instance of the InputService28ProtocolTest client with a session.
+// If additional configuration is needed for the client instance use the optional
+// aws.Config parameter to add your extra config.
+//
+// Example:
+//
+//	mySession := session.Must(session.NewSession())
+//
+//	// Create a InputService28ProtocolTest client from just a session.
+//	svc := inputservice28protocoltest.New(mySession)
+//
+//	// Create a InputService28ProtocolTest client with additional configuration
+//	svc := inputservice28protocoltest.New(mySession, aws.NewConfig().WithRegion("us-west-2"))
+func NewInputService28ProtocolTest(p client.ConfigProvider, cfgs ...*aws.Config) *InputService28ProtocolTest {
+
+	//IBM Specific code START
+	// CheckForConflictingIamCredentials checks if both ApiKey and TrustedProfileID are set
+	// in environment variables. If both are set, it returns an error indicating the conflict.
+	// only one of them should be set.
+	userCfg := false
+	for _, cfg := range cfgs {
+		if cfg.Credentials != nil {
+			userCfg = true
+			break
+		}
+	}
+	if !userCfg {
+		if err := ibmiam.CheckForConflictingIamCredentials(); err != nil {
+			panic(err)
+		}
+	}
+	//IBM Specific code END
+
+	c := p.ClientConfig("inputservice28protocoltest", cfgs...)
+	if c.SigningNameDerived || len(c.SigningName) == 0 {
+	}
+	return newInputService28ProtocolTestClient(*c.Config, c.Handlers, c.PartitionID, c.Endpoint, c.SigningRegion, c.SigningName, c.ResolvedRegion)
+}
+
+// newClient creates, initializes and returns a new service client instance.
+func newInputService28ProtocolTestClient(cfg aws.Config, handlers request.Handlers, partitionID, endpoint, signingRegion, signingName, resolvedRegion string) *InputService28ProtocolTest {
+	svc := &InputService28ProtocolTest{
+		Client: client.New(
+			cfg,
+			metadata.ClientInfo{
+				ServiceName:    "InputService28ProtocolTest",
+				ServiceID:      "InputService28ProtocolTest",
+				SigningName:    signingName,
+				SigningRegion:  signingRegion,
+				PartitionID:    partitionID,
+				Endpoint:       endpoint,
+				APIVersion:     "2014-01-01",
+				ResolvedRegion: resolvedRegion,
+			},
+			handlers,
+		),
+	}
+
+	// Handlers
+	svc.Handlers.Sign.PushBackNamed(signer.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
+
+	return svc
+}
+
+// newRequest creates a new request for a InputService28ProtocolTest operation and runs any
+// custom request initialization.
+func (c *InputService28ProtocolTest) newRequest(op *request.Operation, params, data interface{}) *request.Request {
+	req := c.NewRequest(op, params, data)
+
+	return req
+}
+
+const opInputService28TestCaseOperation1 = "OperationName"
+
+// InputService28TestCaseOperation1Request generates a "aws/request.Request" representing the
+// client's request for the InputService28TestCaseOperation1 operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See InputService28TestCaseOperation1 for more information on using the InputService28TestCaseOperation1
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the InputService28TestCaseOperation1Request method.
+//	req, resp := client.InputService28TestCaseOperation1Request(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+func (c *InputService28ProtocolTest) InputService28TestCaseOperation1Request(input *InputService28TestShapeInputService28TestCaseOperation1Input) (req *request.Request, output *InputService28TestShapeInputService28TestCaseOperation1Output) {
+	op := &request.Operation{
+		Name:       opInputService28TestCaseOperation1,
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?policy",
+	}
+
+	if input == nil {
+		input = &InputService28TestShapeInputService28TestCaseOperation1Input{}
+	}
+
+	output = &InputService28TestShapeInputService28TestCaseOperation1Output{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(restxml.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// InputService28TestCaseOperation1 API operation for .
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for 's
+// API operation InputService28TestCaseOperation1 for usage and error information.
+func (c *InputService28ProtocolTest) InputService28TestCaseOperation1(input *InputService28TestShapeInputService28TestCaseOperation1Input) (*InputService28TestShapeInputService28TestCaseOperation1Output, error) {
+	req, out := c.InputService28TestCaseOperation1Request(input)
+	return out, req.Send()
+}
+
+// InputService28TestCaseOperation1WithContext is the same as InputService28TestCaseOperation1 with the addition of
+// the ability to pass a context and additional request options.
+//
+// See InputService28TestCaseOperation1 for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *InputService28ProtocolTest) InputService28TestCaseOperation1WithContext(ctx aws.Context, input *InputService28TestShapeInputService28TestCaseOperation1Input, opts ...request.Option) (*InputService28TestShapeInputService28TestCaseOperation1Output, error) {
+	req, out := c.InputService28TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opInputService28TestCaseOperation2 = "OperationName"
+
+// InputService28TestCaseOperation2Request generates a "aws/request.Request" representing the
+// client's request for the InputService28TestCaseOperation2 operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See InputService28TestCaseOperation2 for more information on using the InputService28TestCaseOperation2
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the InputService28TestCaseOperation2Request method.
+//	req, resp := client.InputService28TestCaseOperation2Request(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+func (c *InputService28ProtocolTest) InputService28TestCaseOperation2Request(input *InputService28TestShapeInputService28TestCaseOperation2Input) (req *request.Request, output *InputService28TestShapeInputService28TestCaseOperation2Output) {
+	op := &request.Operation{
+		Name:       opInputService28TestCaseOperation2,
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?tagging",
+	}
+
+	if input == nil {
+		input = &InputService28TestShapeInputService28TestCaseOperation2Input{}
+	}
+
+	output = &InputService28TestShapeInputService28TestCaseOperation2Output{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(restxml.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// InputService28TestCaseOperation2 API operation for .
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for 's
+// API operation InputService28TestCaseOperation2 for usage and error information.
+func (c *InputService28ProtocolTest) InputService28TestCaseOperation2(input *InputService28TestShapeInputService28TestCaseOperation2Input) (*InputService28TestShapeInputService28TestCaseOperation2Output, error) {
+	req, out := c.InputService28TestCaseOperation2Request(input)
+	return out, req.Send()
+}
+
+// InputService28TestCaseOperation2WithContext is the same as InputService28TestCaseOperation2 with the addition of
+// the ability to pass a context and additional request options.
+//
+// See InputService28TestCaseOperation2 for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *InputService28ProtocolTest) InputService28TestCaseOperation2WithContext(ctx aws.Context, input *InputService28TestShapeInputService28TestCaseOperation2Input, opts ...request.Option) (*InputService28TestShapeInputService28TestCaseOperation2Output, error) {
+	req, out := c.InputService28TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opInputService28TestCaseOperation3 = "OperationName"
+
+// InputService28TestCaseOperation3Request generates a "aws/request.Request" representing the
+// client's request for the InputService28TestCaseOperation3 operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See InputService28TestCaseOperation3 for more information on using the InputService28TestCaseOperation3
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the InputService28TestCaseOperation3Request method.
+//	req, resp := client.InputService28TestCaseOperation3Request(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+func (c *InputService28ProtocolTest) InputService28TestCaseOperation3Request(input *InputService28TestShapeInputService28TestCaseOperation3Input) (req *request.Request, output *InputService28TestShapeInputService28TestCaseOperation3Output) {
+	op := &request.Operation{
+		Name:       opInputService28TestCaseOperation3,
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?notification",
+	}
+
+	if input == nil {
+		input = &InputService28TestShapeInputService28TestCaseOperation3Input{}
+	}
+
+	output = &InputService28TestShapeInputService28TestCaseOperation3Output{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(restxml.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// InputService28TestCaseOperation3 API operation for .
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for 's
+// API operation InputService28TestCaseOperation3 for usage and error information.
+func (c *InputService28ProtocolTest) InputService28TestCaseOperation3(input *InputService28TestShapeInputService28TestCaseOperation3Input) (*InputService28TestShapeInputService28TestCaseOperation3Output, error) {
+	req, out := c.InputService28TestCaseOperation3Request(input)
+	return out, req.Send()
+}
+
+// InputService28TestCaseOperation3WithContext is the same as InputService28TestCaseOperation3 with the addition of
+// the ability to pass a context and additional request options.
+//
+// See InputService28TestCaseOperation3 for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *InputService28ProtocolTest) InputService28TestCaseOperation3WithContext(ctx aws.Context, input *InputService28TestShapeInputService28TestCaseOperation3Input, opts ...request.Option) (*InputService28TestShapeInputService28TestCaseOperation3Output, error) {
+	req, out := c.InputService28TestCaseOperation3Request(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+type InputService28TestShapeInputService28TestCaseOperation1Input struct {
+	_ struct{} `locationName:"PolicyInputShape" type:"structure" payload:"Policy"`
+
+	// Bucket is a required field
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ConfirmRemoveSelfBucketAccess *bool `location:"header" locationName:"x-amz-confirm-remove-self-bucket-access" type:"boolean"`
+
+	// Policy is a required field
+	Policy *string `type:"string" required:"true"`
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *InputService28TestShapeInputService28TestCaseOperation1Input) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputService28TestShapeInputService28TestCaseOperation1Input"}
+	if s.Bucket == nil {
+		invalidParams.Add(request.NewErrParamRequired("Bucket"))
+	}
+	if s.Bucket != nil && len(*s.Bucket) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Bucket", 1))
+	}
+	if s.Policy == nil {
+		invalidParams.Add(request.NewErrParamRequired("Policy"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBucket sets the Bucket field's value.
+func (s *InputService28TestShapeInputService28TestCaseOperation1Input) SetBucket(v string) *InputService28TestShapeInputService28TestCaseOperation1Input {
+	s.Bucket = &v
+	return s
+}
+
+// SetConfirmRemoveSelfBucketAccess sets the ConfirmRemoveSelfBucketAccess field's value.
+func (s *InputService28TestShapeInputService28TestCaseOperation1Input) SetConfirmRemoveSelfBucketAccess(v bool) *InputService28TestShapeInputService28TestCaseOperation1Input {
+	s.ConfirmRemoveSelfBucketAccess = &v
+	return s
+}
+
+// SetPolicy sets the Policy field's value.
+func (s *InputService28TestShapeInputService28TestCaseOperation1Input) SetPolicy(v string) *InputService28TestShapeInputService28TestCaseOperation1Input {
+	s.Policy = &v
+	return s
+}
+
+type InputService28TestShapeInputService28TestCaseOperation1Output struct {
+	_ struct{} `type:"structure"`
+}
+
+type InputService28TestShapeInputService28TestCaseOperation2Input struct {
+	_ struct{} `locationName:"TaggingInputShape" type:"structure" payload:"Tagging"`
+
+	// Bucket is a required field
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	// Tagging is a required field
+	Tagging *InputService28TestShapeTagging `locationName:"Tagging" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *InputService28TestShapeInputService28TestCaseOperation2Input) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputService28TestShapeInputService28TestCaseOperation2Input"}
+	if s.Bucket == nil {
+		invalidParams.Add(request.NewErrParamRequired("Bucket"))
+	}
+	if s.Bucket != nil && len(*s.Bucket) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Bucket", 1))
+	}
+	if s.Tagging == nil {
+		invalidParams.Add(request.NewErrParamRequired("Tagging"))
+	}
+	if s.Tagging != nil {
+		if err := s.Tagging.Validate(); err != nil {
+			invalidParams.AddNested("Tagging", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBucket sets the Bucket field's value.
+func (s *InputService28TestShapeInputService28TestCaseOperation2Input) SetBucket(v string) *InputService28TestShapeInputService28TestCaseOperation2Input {
+	s.Bucket = &v
+	return s
+}
+
+// SetTagging sets the Tagging field's value.
+func (s *InputService28TestShapeInputService28TestCaseOperation2Input) SetTagging(v *InputService28TestShapeTagging) *InputService28TestShapeInputService28TestCaseOperation2Input {
+	s.Tagging = v
+	return s
+}
+
+type InputService28TestShapeInputService28TestCaseOperation2Output struct {
+	_ struct{} `type:"structure"`
+}
+
+type InputService28TestShapeInputService28TestCaseOperation3Input struct {
+	_ struct{} `locationName:"NotificationInputShape" type:"structure" payload:"NotificationConfiguration"`
+
+	// Bucket is a required field
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	// NotificationConfiguration is a required field
+	NotificationConfiguration *InputService28TestShapeNotificationConfiguration `locationName:"NotificationConfiguration" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *InputService28TestShapeInputService28TestCaseOperation3Input) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputService28TestShapeInputService28TestCaseOperation3Input"}
+	if s.Bucket == nil {
+		invalidParams.Add(request.NewErrParamRequired("Bucket"))
+	}
+	if s.Bucket != nil && len(*s.Bucket) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Bucket", 1))
+	}
+	if s.NotificationConfiguration == nil {
+		invalidParams.Add(request.NewErrParamRequired("NotificationConfiguration"))
+	}
+	if s.NotificationConfiguration != nil {
+		if err := s.NotificationConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("NotificationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBucket sets the Bucket field's value.
+func (s *InputService28TestShapeInputService28TestCaseOperation3Input) SetBucket(v string) *InputService28TestShapeInputService28TestCaseOperation3Input {
+	s.Bucket = &v
+	return s
+}
+
+// SetNotificationConfiguration sets the NotificationConfiguration field's value.
+func (s *InputService28TestShapeInputService28TestCaseOperation3Input) SetNotificationConfiguration(v *InputService28TestShapeNotificationConfiguration) *InputService28TestShapeInputService28TestCaseOperation3Input {
+	s.NotificationConfiguration = v
+	return s
+}
+
+type InputService28TestShapeInputService28TestCaseOperation3Output struct {
+	_ struct{} `type:"structure"`
+}
+
+type InputService28TestShapeNotificationConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	TopicConfigurations []*InputService28TestShapeTopicConfiguration `locationName:"TopicConfiguration" type:"list" flattened:"true"`
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *InputService28TestShapeNotificationConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputService28TestShapeNotificationConfiguration"}
+	if s.TopicConfigurations != nil {
+		for i, v := range s.TopicConfigurations {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TopicConfigurations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetTopicConfigurations sets the TopicConfigurations field's value.
+func (s *InputService28TestShapeNotificationConfiguration) SetTopicConfigurations(v []*InputService28TestShapeTopicConfiguration) *InputService28TestShapeNotificationConfiguration {
+	s.TopicConfigurations = v
+	return s
+}
+
+type InputService28TestShapeTag struct {
+	_ struct{} `type:"structure"`
+
+	// Key is a required field
+	Key *string `type:"string" required:"true"`
+
+	// Value is a required field
+	Value *string `type:"string" required:"true"`
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *InputService28TestShapeTag) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputService28TestShapeTag"}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKey sets the Key field's value.
+func (s *InputService28TestShapeTag) SetKey(v string) *InputService28TestShapeTag {
+	s.Key = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *InputService28TestShapeTag) SetValue(v string) *InputService28TestShapeTag {
+	s.Value = &v
+	return s
+}
+
+type InputService28TestShapeTagging struct {
+	_ struct{} `type:"structure"`
+
+	// TagSet is a required field
+	TagSet []*InputService28TestShapeTag `locationNameList:"Tag" type:"list" required:"true"`
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *InputService28TestShapeTagging) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputService28TestShapeTagging"}
+	if s.TagSet == nil {
+		invalidParams.Add(request.NewErrParamRequired("TagSet"))
+	}
+	if s.TagSet != nil {
+		for i, v := range s.TagSet {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TagSet", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetTagSet sets the TagSet field's value.
+func (s *InputService28TestShapeTagging) SetTagSet(v []*InputService28TestShapeTag) *InputService28TestShapeTagging {
+	s.TagSet = v
+	return s
+}
+
+type InputService28TestShapeTopicConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// Events is a required field
+	Events []*string `locationName:"Event" type:"list" flattened:"true" required:"true"`
+
+	Id *string `type:"string"`
+
+	// TopicArn is a required field
+	TopicArn *string `locationName:"Topic" type:"string" required:"true"`
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *InputService28TestShapeTopicConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputService28TestShapeTopicConfiguration"}
+	if s.Events == nil {
+		invalidParams.Add(request.NewErrParamRequired("Events"))
+	}
+	if s.TopicArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("TopicArn"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEvents sets the Events field's value.
+func (s *InputService28TestShapeTopicConfiguration) SetEvents(v []*string) *InputService28TestShapeTopicConfiguration {
+	s.Events = v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *InputService28TestShapeTopicConfiguration) SetId(v string) *InputService28TestShapeTopicConfiguration {
+	s.Id = &v
+	return s
+}
+
+// SetTopicArn sets the TopicArn field's value.
+func (s *InputService28TestShapeTopicConfiguration) SetTopicArn(v string) *InputService28TestShapeTopicConfiguration {
+	s.TopicArn = &v
+	return s
+}
+
 //
 // Tests begin here
 //
@@ -8078,3 +8676,135 @@ func TestInputService27ProtocolTestHeaderWhitespaceCase1(t *testing.T) {
 		t.Errorf("expect header1 %v header value, got %v", e, a)
 	}
 }
+
+func TestInputService28ProtocolTestBucketGovernancePayloadsCase1(t *testing.T) {
+	svc := NewInputService28ProtocolTest(unit.Session, &aws.Config{Endpoint: aws.String("https://test")})
+	input := &InputService28TestShapeInputService28TestCaseOperation1Input{
+		Bucket:                        aws.String("bucket"),
+		ConfirmRemoveSelfBucketAccess: aws.Bool(true),
+		Policy:                        aws.String("{\"Statement\":[]}"),
+	}
+	req, _ := svc.InputService28TestCaseOperation1Request(input)
+	r := req.HTTPRequest
+
+	// build request
+	req.Build()
+	if req.Error != nil {
+		t.Errorf("expect no error, got %v", req.Error)
+	}
+	req.Sign()
+	if req.Error != nil {
+		t.Errorf("expect no error, got %v", req.Error)
+	}
+
+	// assert body
+	if r.Body == nil {
+		t.Errorf("expect body not to be nil")
+	}
+	body, _ := ioutil.ReadAll(r.Body)
+	if e, a := "{\"Statement\":[]}", util.Trim(string(body)); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	if e, a := int64(len(body)), r.ContentLength; e != a {
+		t.Errorf("expect serialized body length to match %v ContentLength, got %v", e, a)
+	}
+
+	// assert URL
+	awstesting.AssertURL(t, "https://test/bucket?policy", r.URL.String())
+
+	// assert headers
+	if e, a := "true", r.Header.Get("x-amz-confirm-remove-self-bucket-access"); e != a {
+		t.Errorf("expect x-amz-confirm-remove-self-bucket-access %v header value, got %v", e, a)
+	}
+}
+
+func TestInputService28ProtocolTestBucketGovernancePayloadsCase2(t *testing.T) {
+	svc := NewInputService28ProtocolTest(unit.Session, &aws.Config{Endpoint: aws.String("https://test")})
+	input := &InputService28TestShapeInputService28TestCaseOperation2Input{
+		Bucket: aws.String("bucket"),
+		Tagging: &InputService28TestShapeTagging{
+			TagSet: []*InputService28TestShapeTag{
+				{
+					Key:   aws.String("env"),
+					Value: aws.String("prod"),
+				},
+				{
+					Key:   aws.String("team"),
+					Value: aws.String("storage"),
+				},
+			},
+		},
+	}
+	req, _ := svc.InputService28TestCaseOperation2Request(input)
+	r := req.HTTPRequest
+
+	// build request
+	req.Build()
+	if req.Error != nil {
+		t.Errorf("expect no error, got %v", req.Error)
+	}
+	req.Sign()
+	if req.Error != nil {
+		t.Errorf("expect no error, got %v", req.Error)
+	}
+
+	// assert body
+	if r.Body == nil {
+		t.Errorf("expect body not to be nil")
+	}
+	body, _ := ioutil.ReadAll(r.Body)
+	awstesting.AssertXML(t, `<Tagging xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><TagSet><Tag><Key>env</Key><Value>prod</Value></Tag><Tag><Key>team</Key><Value>storage</Value></Tag></TagSet></Tagging>`, util.Trim(string(body)))
+
+	if e, a := int64(len(body)), r.ContentLength; e != a {
+		t.Errorf("expect serialized body length to match %v ContentLength, got %v", e, a)
+	}
+
+	// assert URL
+	awstesting.AssertURL(t, "https://test/bucket?tagging", r.URL.String())
+}
+
+func TestInputService28ProtocolTestBucketGovernancePayloadsCase3(t *testing.T) {
+	svc := NewInputService28ProtocolTest(unit.Session, &aws.Config{Endpoint: aws.String("https://test")})
+	input := &InputService28TestShapeInputService28TestCaseOperation3Input{
+		Bucket: aws.String("bucket"),
+		NotificationConfiguration: &InputService28TestShapeNotificationConfiguration{
+			TopicConfigurations: []*InputService28TestShapeTopicConfiguration{
+				{
+					Events: []*string{
+						aws.String("s3:ObjectCreated:*"),
+						aws.String("s3:ObjectRemoved:*"),
+					},
+					Id:       aws.String("id"),
+					TopicArn: aws.String("crn:v1:topic"),
+				},
+			},
+		},
+	}
+	req, _ := svc.InputService28TestCaseOperation3Request(input)
+	r := req.HTTPRequest
+
+	// build request
+	req.Build()
+	if req.Error != nil {
+		t.Errorf("expect no error, got %v", req.Error)
+	}
+	req.Sign()
+	if req.Error != nil {
+		t.Errorf("expect no error, got %v", req.Error)
+	}
+
+	// assert body
+	if r.Body == nil {
+		t.Errorf("expect body not to be nil")
+	}
+	body, _ := ioutil.ReadAll(r.Body)
+	awstesting.AssertXML(t, `<NotificationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><TopicConfiguration><Event>s3:ObjectCreated:*</Event><Event>s3:ObjectRemoved:*</Event><Id>id</Id><Topic>crn:v1:topic</Topic></TopicConfiguration></NotificationConfiguration>`, util.Trim(string(body)))
+
+	if e, a := int64(len(body)), r.ContentLength; e != a {
+		t.Errorf("expect serialized body length to match %v ContentLength, got %v", e, a)
+	}
+
+	// assert URL
+	awstesting.AssertURL(t, "https://test/bucket?notification", r.URL.String())
+}
@@ -0,0 +1,79 @@
+package restxml_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/client/metadata"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/internal/sdktesting"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol/restxml"
+)
+
+type fuzzOutput struct {
+	_ struct{} `type:"structure"`
+
+	Str      *string            `type:"string"`
+	Int      *int64             `type:"integer"`
+	Time     *time.Time         `type:"timestamp"`
+	List     []*string          `type:"list"`
+	Map      map[string]*string `type:"map"`
+	Header   *string            `location:"header" locationName:"X-Str" type:"string"`
+	IntHdr   *int64             `location:"header" locationName:"X-Int" type:"integer"`
+	TimeHdr  *time.Time         `location:"header" locationName:"X-Time" type:"timestamp"`
+	JSONHdr  aws.JSONValue      `location:"header" locationName:"X-Json" type:"jsonvalue"`
+	Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-" type:"map"`
+	Status   *int64             `location:"statusCode" type:"integer"`
+}
+
+type fuzzPayloadOutput struct {
+	_ struct{} `type:"structure" payload:"Body"`
+
+	Body io.ReadCloser `type:"blob"`
+}
+
+func FuzzUnmarshal(f *testing.F) {
+	resps, err := sdktesting.ProtocolTestResponses("rest-xml")
+	if err != nil {
+		f.Fatalf("expect protocol tests, got %v", err)
+	}
+	for _, resp := range resps {
+		for k, v := range resp.Headers {
+			f.Add(resp.StatusCode, k, v, []byte(resp.Body))
+		}
+		f.Add(resp.StatusCode, "", "", []byte(resp.Body))
+	}
+	f.Add(400, "x-amz-meta-K", "v", []byte(`<ErrorResponse><Error><Code>c</Code></Error></ErrorResponse>`))
+	f.Add(404, "X-Int", "1e400", []byte(`<Error><Code>NoSuchKey</Code><Message>m</Message></Error>`))
+
+	f.Fuzz(func(t *testing.T, status int, key, value string, body []byte) {
+		for _, out := range []interface{}{&fuzzOutput{}, &fuzzPayloadOutput{}} {
+			r := newFuzzRequest(out, status, key, value, body)
+			restxml.UnmarshalMeta(r)
+			restxml.Unmarshal(r)
+
+			r = newFuzzRequest(out, status, key, value, body)
+			restxml.UnmarshalError(r)
+			if r.Error == nil {
+				t.Errorf("expect error, got none")
+			}
+		}
+	})
+}
+
+func newFuzzRequest(out interface{}, status int, key, value string, body []byte) *request.Request {
+	r := request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil,
+		&request.Operation{Name: "Fuzz"}, nil, out)
+	r.HTTPResponse = &http.Response{
+		StatusCode: status,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+	}
+	r.HTTPResponse.Header[key] = []string{value}
+	return r
+}
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"encoding/xml"
 
+	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/aws/request"
 	"github.com/IBM/ibm-cos-sdk-go/private/protocol/query"
@@ -53,7 +54,14 @@ func Unmarshal(r *request.Request) {
 	if t := rest.PayloadType(r.Data); t == "structure" || t == "" {
 		defer r.HTTPResponse.Body.Close()
 		decoder := xml.NewDecoder(r.HTTPResponse.Body)
-		err := xmlutil.UnmarshalXML(r.Data, decoder, "")
+		// IBM COS SDK Code -- START
+		var err error
+		if aws.BoolValue(r.Config.UseStreamingXMLDecoder) {
+			err = xmlutil.UnmarshalXMLStream(r.Data, decoder)
+		} else {
+			err = xmlutil.UnmarshalXML(r.Data, decoder, "")
+		}
+		// IBM COS SDK Code -- END
 		if err != nil {
 			r.Error = awserr.NewRequestFailure(
 				awserr.New(request.ErrCodeSerialization,
@@ -3222,6 +3222,390 @@ func OutputService15TestShapeItemType_Values() []string {
 	}
 }
 
+// OutputService16ProtocolTest provides the API operation methods for making requests to
+// . See this package's package overview docs
+// for details on the service.
+//
+// OutputService16ProtocolTest methods are safe to use concurrently. It is not safe to
+// modify mutate any of the struct's properties though.
+type OutputService16ProtocolTest struct {
+	*client.Client
+}
+
+// New creates a new instance of the OutputService16ProtocolTest client with a session.
+// If additional configuration is needed for the client instance use the optional
+// aws.Config parameter to add your extra config.
+//
+// Example:
+//
+//	mySession := session.Must(session.NewSession())
+//
+//	// Create a OutputService16ProtocolTest client from just a session.
+//	svc := outputservice16protocoltest.New(mySession)
+//
+//	// Create a OutputService16ProtocolTest client with additional configuration
+//	svc := outputservice16protocoltest.New(mySession, aws.NewConfig().WithRegion("us-west-2"))
+func NewOutputService16ProtocolTest(p client.ConfigProvider, cfgs ...*aws.Config) *OutputService16ProtocolTest {
+
+	//IBM Specific code START
+	// CheckForConflictingIamCredentials checks if both ApiKey and TrustedProfileID are set
+	// in environment variables. If both are set, it returns an error indicating the conflict.
+	// only one of them should be set.
+	userCfg := false
+	for _, cfg := range cfgs {
+		if cfg.Credentials != nil {
+			userCfg = true
+			break
+		}
+	}
+	if !userCfg {
+		if err := ibmiam.CheckForConflictingIamCredentials(); err != nil {
+			panic(err)
+		}
+	}
+	//IBM Specific code END
+
+	c := p.ClientConfig("outputservice16protocoltest", cfgs...)
+	if c.SigningNameDerived || len(c.SigningName) == 0 {
+	}
+	return newOutputService16ProtocolTestClient(*c.Config, c.Handlers, c.PartitionID, c.Endpoint, c.SigningRegion, c.SigningName, c.ResolvedRegion)
+}
+
+// newClient creates, initializes and returns a new service client instance.
+func newOutputService16ProtocolTestClient(cfg aws.Config, handlers request.Handlers, partitionID, endpoint, signingRegion, signingName, resolvedRegion string) *OutputService16ProtocolTest {
+	svc := &OutputService16ProtocolTest{
+		Client: client.New(
+			cfg,
+			metadata.ClientInfo{
+				ServiceName:    "OutputService16ProtocolTest",
+				ServiceID:      "OutputService16ProtocolTest",
+				SigningName:    signingName,
+				SigningRegion:  signingRegion,
+				PartitionID:    partitionID,
+				Endpoint:       endpoint,
+				APIVersion:     "",
+				ResolvedRegion: resolvedRegion,
+			},
+			handlers,
+		),
+	}
+
+	// Handlers
+	svc.Handlers.Sign.PushBackNamed(signer.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
+
+	return svc
+}
+
+// newRequest creates a new request for a OutputService16ProtocolTest operation and runs any
+// custom request initialization.
+func (c *OutputService16ProtocolTest) newRequest(op *request.Operation, params, data interface{}) *request.Request {
+	req := c.NewRequest(op, params, data)
+
+	return req
+}
+
+const opOutputService16TestCaseOperation1 = "OperationName"
+
+// OutputService16TestCaseOperation1Request generates a "aws/request.Request" representing the
+// client's request for the OutputService16TestCaseOperation1 operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See OutputService16TestCaseOperation1 for more information on using the OutputService16TestCaseOperation1
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the OutputService16TestCaseOperation1Request method.
+//	req, resp := client.OutputService16TestCaseOperation1Request(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+func (c *OutputService16ProtocolTest) OutputService16TestCaseOperation1Request(input *OutputService16TestShapeOutputService16TestCaseOperation1Input) (req *request.Request, output *OutputService16TestShapeOutputService16TestCaseOperation1Output) {
+	op := &request.Operation{
+		Name:     opOutputService16TestCaseOperation1,
+		HTTPPath: "/",
+	}
+
+	if input == nil {
+		input = &OutputService16TestShapeOutputService16TestCaseOperation1Input{}
+	}
+
+	output = &OutputService16TestShapeOutputService16TestCaseOperation1Output{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// OutputService16TestCaseOperation1 API operation for .
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for 's
+// API operation OutputService16TestCaseOperation1 for usage and error information.
+func (c *OutputService16ProtocolTest) OutputService16TestCaseOperation1(input *OutputService16TestShapeOutputService16TestCaseOperation1Input) (*OutputService16TestShapeOutputService16TestCaseOperation1Output, error) {
+	req, out := c.OutputService16TestCaseOperation1Request(input)
+	return out, req.Send()
+}
+
+// OutputService16TestCaseOperation1WithContext is the same as OutputService16TestCaseOperation1 with the addition of
+// the ability to pass a context and additional request options.
+//
+// See OutputService16TestCaseOperation1 for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *OutputService16ProtocolTest) OutputService16TestCaseOperation1WithContext(ctx aws.Context, input *OutputService16TestShapeOutputService16TestCaseOperation1Input, opts ...request.Option) (*OutputService16TestShapeOutputService16TestCaseOperation1Output, error) {
+	req, out := c.OutputService16TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opOutputService16TestCaseOperation2 = "OperationName"
+
+// OutputService16TestCaseOperation2Request generates a "aws/request.Request" representing the
+// client's request for the OutputService16TestCaseOperation2 operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See OutputService16TestCaseOperation2 for more information on using the OutputService16TestCaseOperation2
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the OutputService16TestCaseOperation2Request method.
+//	req, resp := client.OutputService16TestCaseOperation2Request(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+func (c *OutputService16ProtocolTest) OutputService16TestCaseOperation2Request(input *OutputService16TestShapeOutputService16TestCaseOperation2Input) (req *request.Request, output *OutputService16TestShapeOutputService16TestCaseOperation2Output) {
+	op := &request.Operation{
+		Name:     opOutputService16TestCaseOperation2,
+		HTTPPath: "/",
+	}
+
+	if input == nil {
+		input = &OutputService16TestShapeOutputService16TestCaseOperation2Input{}
+	}
+
+	output = &OutputService16TestShapeOutputService16TestCaseOperation2Output{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// OutputService16TestCaseOperation2 API operation for .
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for 's
+// API operation OutputService16TestCaseOperation2 for usage and error information.
+func (c *OutputService16ProtocolTest) OutputService16TestCaseOperation2(input *OutputService16TestShapeOutputService16TestCaseOperation2Input) (*OutputService16TestShapeOutputService16TestCaseOperation2Output, error) {
+	req, out := c.OutputService16TestCaseOperation2Request(input)
+	return out, req.Send()
+}
+
+// OutputService16TestCaseOperation2WithContext is the same as OutputService16TestCaseOperation2 with the addition of
+// the ability to pass a context and additional request options.
+//
+// See OutputService16TestCaseOperation2 for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *OutputService16ProtocolTest) OutputService16TestCaseOperation2WithContext(ctx aws.Context, input *OutputService16TestShapeOutputService16TestCaseOperation2Input, opts ...request.Option) (*OutputService16TestShapeOutputService16TestCaseOperation2Output, error) {
+	req, out := c.OutputService16TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opOutputService16TestCaseOperation3 = "OperationName"
+
+// OutputService16TestCaseOperation3Request generates a "aws/request.Request" representing the
+// client's request for the OutputService16TestCaseOperation3 operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See OutputService16TestCaseOperation3 for more information on using the OutputService16TestCaseOperation3
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the OutputService16TestCaseOperation3Request method.
+//	req, resp := client.OutputService16TestCaseOperation3Request(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+func (c *OutputService16ProtocolTest) OutputService16TestCaseOperation3Request(input *OutputService16TestShapeOutputService16TestCaseOperation3Input) (req *request.Request, output *OutputService16TestShapeOutputService16TestCaseOperation3Output) {
+	op := &request.Operation{
+		Name:     opOutputService16TestCaseOperation3,
+		HTTPPath: "/",
+	}
+
+	if input == nil {
+		input = &OutputService16TestShapeOutputService16TestCaseOperation3Input{}
+	}
+
+	output = &OutputService16TestShapeOutputService16TestCaseOperation3Output{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// OutputService16TestCaseOperation3 API operation for .
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for 's
+// API operation OutputService16TestCaseOperation3 for usage and error information.
+func (c *OutputService16ProtocolTest) OutputService16TestCaseOperation3(input *OutputService16TestShapeOutputService16TestCaseOperation3Input) (*OutputService16TestShapeOutputService16TestCaseOperation3Output, error) {
+	req, out := c.OutputService16TestCaseOperation3Request(input)
+	return out, req.Send()
+}
+
+// OutputService16TestCaseOperation3WithContext is the same as OutputService16TestCaseOperation3 with the addition of
+// the ability to pass a context and additional request options.
+//
+// See OutputService16TestCaseOperation3 for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *OutputService16ProtocolTest) OutputService16TestCaseOperation3WithContext(ctx aws.Context, input *OutputService16TestShapeOutputService16TestCaseOperation3Input, opts ...request.Option) (*OutputService16TestShapeOutputService16TestCaseOperation3Output, error) {
+	req, out := c.OutputService16TestCaseOperation3Request(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+type OutputService16TestShapeOutputService16TestCaseOperation1Input struct {
+	_ struct{} `type:"structure"`
+}
+
+type OutputService16TestShapeOutputService16TestCaseOperation1Output struct {
+	_ struct{} `type:"structure" payload:"Policy"`
+
+	Policy *string `type:"string"`
+}
+
+// SetPolicy sets the Policy field's value.
+func (s *OutputService16TestShapeOutputService16TestCaseOperation1Output) SetPolicy(v string) *OutputService16TestShapeOutputService16TestCaseOperation1Output {
+	s.Policy = &v
+	return s
+}
+
+type OutputService16TestShapeOutputService16TestCaseOperation2Input struct {
+	_ struct{} `type:"structure"`
+}
+
+type OutputService16TestShapeOutputService16TestCaseOperation2Output struct {
+	_ struct{} `type:"structure"`
+
+	// TagSet is a required field
+	TagSet []*OutputService16TestShapeTag `locationNameList:"Tag" type:"list" required:"true"`
+}
+
+// SetTagSet sets the TagSet field's value.
+func (s *OutputService16TestShapeOutputService16TestCaseOperation2Output) SetTagSet(v []*OutputService16TestShapeTag) *OutputService16TestShapeOutputService16TestCaseOperation2Output {
+	s.TagSet = v
+	return s
+}
+
+type OutputService16TestShapeOutputService16TestCaseOperation3Input struct {
+	_ struct{} `type:"structure"`
+}
+
+type OutputService16TestShapeOutputService16TestCaseOperation3Output struct {
+	_ struct{} `type:"structure"`
+
+	TopicConfigurations []*OutputService16TestShapeTopicConfiguration `locationName:"TopicConfiguration" type:"list" flattened:"true"`
+}
+
+// SetTopicConfigurations sets the TopicConfigurations field's value.
+func (s *OutputService16TestShapeOutputService16TestCaseOperation3Output) SetTopicConfigurations(v []*OutputService16TestShapeTopicConfiguration) *OutputService16TestShapeOutputService16TestCaseOperation3Output {
+	s.TopicConfigurations = v
+	return s
+}
+
+type OutputService16TestShapeTag struct {
+	_ struct{} `type:"structure"`
+
+	Key *string `type:"string"`
+
+	Value *string `type:"string"`
+}
+
+// SetKey sets the Key field's value.
+func (s *OutputService16TestShapeTag) SetKey(v string) *OutputService16TestShapeTag {
+	s.Key = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *OutputService16TestShapeTag) SetValue(v string) *OutputService16TestShapeTag {
+	s.Value = &v
+	return s
+}
+
+type OutputService16TestShapeTopicConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	Events []*string `locationName:"Event" type:"list" flattened:"true"`
+
+	Id *string `type:"string"`
+
+	TopicArn *string `locationName:"Topic" type:"string"`
+}
+
+// SetEvents sets the Events field's value.
+func (s *OutputService16TestShapeTopicConfiguration) SetEvents(v []*string) *OutputService16TestShapeTopicConfiguration {
+	s.Events = v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *OutputService16TestShapeTopicConfiguration) SetId(v string) *OutputService16TestShapeTopicConfiguration {
+	s.Id = &v
+	return s
+}
+
+// SetTopicArn sets the TopicArn field's value.
+func (s *OutputService16TestShapeTopicConfiguration) SetTopicArn(v string) *OutputService16TestShapeTopicConfiguration {
+	s.TopicArn = &v
+	return s
+}
+
 //
 // Tests begin here
 //
@@ -3839,3 +4223,108 @@ func TestOutputService15ProtocolTestXMLAttributesCase1(t *testing.T) {
 	}
 
 }
+
+func TestOutputService16ProtocolTestBucketGovernancePayloadsCase1(t *testing.T) {
+	svc := NewOutputService16ProtocolTest(unit.Session, &aws.Config{Endpoint: aws.String("https://test")})
+
+	buf := bytes.NewReader([]byte("{\"Statement\":[]}"))
+	req, out := svc.OutputService16TestCaseOperation1Request(nil)
+	req.HTTPResponse = &http.Response{StatusCode: 200, Body: ioutil.NopCloser(buf), Header: http.Header{}}
+
+	// set headers
+
+	// unmarshal response
+	req.Handlers.UnmarshalMeta.Run(req)
+	req.Handlers.Unmarshal.Run(req)
+	if req.Error != nil {
+		t.Errorf("expect not error, got %v", req.Error)
+	}
+
+	// assert response
+	if out == nil {
+		t.Errorf("expect not to be nil")
+	}
+	if e, a := "{\"Statement\":[]}", *out.Policy; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+}
+
+func TestOutputService16ProtocolTestBucketGovernancePayloadsCase2(t *testing.T) {
+	svc := NewOutputService16ProtocolTest(unit.Session, &aws.Config{Endpoint: aws.String("https://test")})
+
+	buf := bytes.NewReader([]byte("<Tagging xmlns=\"http://s3.amazonaws.com/doc/2006-03-01/\"><TagSet><Tag><Key>env</Key><Value>prod</Value></Tag><Tag><Key>team</Key><Value>storage</Value></Tag></TagSet></Tagging>"))
+	req, out := svc.OutputService16TestCaseOperation2Request(nil)
+	req.HTTPResponse = &http.Response{StatusCode: 200, Body: ioutil.NopCloser(buf), Header: http.Header{}}
+
+	// set headers
+
+	// unmarshal response
+	req.Handlers.UnmarshalMeta.Run(req)
+	req.Handlers.Unmarshal.Run(req)
+	if req.Error != nil {
+		t.Errorf("expect not error, got %v", req.Error)
+	}
+
+	// assert response
+	if out == nil {
+		t.Errorf("expect not to be nil")
+	}
+	if e, a := "env", *out.TagSet[0].Key; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "prod", *out.TagSet[0].Value; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "team", *out.TagSet[1].Key; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "storage", *out.TagSet[1].Value; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+}
+
+func TestOutputService16ProtocolTestBucketGovernancePayloadsCase3(t *testing.T) {
+	svc := NewOutputService16ProtocolTest(unit.Session, &aws.Config{Endpoint: aws.String("https://test")})
+
+	buf := bytes.NewReader([]byte("<NotificationConfiguration xmlns=\"http://s3.amazonaws.com/doc/2006-03-01/\"><TopicConfiguration><Id>a</Id><Topic>crn:v1:a</Topic><Event>s3:ObjectCreated:*</Event><Event>s3:ObjectRemoved:*</Event></TopicConfiguration><TopicConfiguration><Id>b</Id><Topic>crn:v1:b</Topic><Event>s3:ObjectCreated:Put</Event></TopicConfiguration></NotificationConfiguration>"))
+	req, out := svc.OutputService16TestCaseOperation3Request(nil)
+	req.HTTPResponse = &http.Response{StatusCode: 200, Body: ioutil.NopCloser(buf), Header: http.Header{}}
+
+	// set headers
+
+	// unmarshal response
+	req.Handlers.UnmarshalMeta.Run(req)
+	req.Handlers.Unmarshal.Run(req)
+	if req.Error != nil {
+		t.Errorf("expect not error, got %v", req.Error)
+	}
+
+	// assert response
+	if out == nil {
+		t.Errorf("expect not to be nil")
+	}
+	if e, a := "s3:ObjectCreated:*", *out.TopicConfigurations[0].Events[0]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "s3:ObjectRemoved:*", *out.TopicConfigurations[0].Events[1]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "a", *out.TopicConfigurations[0].Id; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "crn:v1:a", *out.TopicConfigurations[0].TopicArn; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "s3:ObjectCreated:Put", *out.TopicConfigurations[1].Events[0]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "b", *out.TopicConfigurations[1].Id; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "crn:v1:b", *out.TopicConfigurations[1].TopicArn; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+}
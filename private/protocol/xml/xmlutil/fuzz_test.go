@@ -0,0 +1,60 @@
+package xmlutil
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/internal/sdktesting"
+)
+
+type fuzzShape struct {
+	_ struct{} `type:"structure"`
+
+	Str     *string               `type:"string"`
+	Attr    *string               `locationName:"xsi:type" type:"string" xmlAttribute:"true"`
+	Int     *int64                `type:"integer"`
+	Float   *float64              `type:"double"`
+	Bool    *bool                 `type:"boolean"`
+	Time    *time.Time            `type:"timestamp"`
+	Blob    []byte                `type:"blob"`
+	List    []*string             `type:"list"`
+	Named   []*string             `locationNameList:"item" type:"list"`
+	Flat    []*fuzzShape          `type:"list" flattened:"true"`
+	Nested  [][]*int64            `type:"list"`
+	Map     map[string]*string    `type:"map"`
+	FlatMap map[string]*fuzzShape `type:"map" flattened:"true" locationNameKey:"k" locationNameValue:"v"`
+	Child   *fuzzShape            `type:"structure"`
+}
+
+func FuzzUnmarshalXML(f *testing.F) {
+	resps, err := sdktesting.ProtocolTestResponses("rest-xml")
+	if err != nil {
+		f.Fatalf("expect protocol tests, got %v", err)
+	}
+	for _, resp := range resps {
+		f.Add([]byte(resp.Body))
+	}
+	f.Add([]byte(`<R><Map><entry><key>a</key></entry></Map></R>`))
+	f.Add([]byte(`<R><List><member>a</member></List><List><member>b</member><member>c</member></List></R>`))
+	f.Add([]byte(`<R><Flat><Str>a</Str></Flat><Flat><Child><Int>1</Int></Child></Flat></R>`))
+	f.Add([]byte(`<R xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="t"><Nested><member><member>1</member></member></Nested></R>`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var v fuzzShape
+		UnmarshalXML(&v, xml.NewDecoder(bytes.NewReader(data)), "")
+
+		var streamed fuzzShape
+		UnmarshalXMLStream(&streamed, xml.NewDecoder(bytes.NewReader(data)))
+
+		var wrapped fuzzShape
+		UnmarshalXML(&wrapped, xml.NewDecoder(bytes.NewReader(data)), "Child")
+
+		var errResp struct {
+			Code    string `xml:"Error>Code"`
+			Message string `xml:"Error>Message"`
+		}
+		UnmarshalXMLError(&errResp, bytes.NewReader(data))
+	})
+}
@@ -0,0 +1,442 @@
+package xmlutil
+
+import (
+	"encoding/xml"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	byteSliceType = reflect.TypeOf([]byte{})
+)
+
+// UnmarshalXMLStream deserializes the elements read from the xml.Decoder
+// directly into the container v, without first building the XMLNode tree
+// UnmarshalXML does. V needs to match the shape of the XML expected to be
+// decoded.
+//
+// The elements are mapped the same way as UnmarshalXML with an empty wrapper,
+// as used by the REST-XML protocol, with the exception of an element with
+// mixed content, whose text is all of its character data, not only the last.
+func UnmarshalXMLStream(v interface{}, d *xml.Decoder) error {
+	s := streamDecoder{d: d}
+	r := reflect.ValueOf(v)
+
+	for {
+		tok, err := s.token()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if start, ok := tok.(xml.StartElement); ok {
+			n := s.pushNamespaces(start)
+			if err := s.decode(r, start, ""); err != nil {
+				return err
+			}
+			s.popNamespaces(n)
+		}
+	}
+}
+
+// streamDecoder maps the tokens of an xml.Decoder into a value.
+type streamDecoder struct {
+	d *xml.Decoder
+
+	// depth of the current element, used to resync after failing to decode
+	// a map value.
+	depth int
+	// err is the error of the decoder's last token read, if any.
+	err error
+
+	// namespaces declared by the current element and its ancestors.
+	namespaces []xml.Attr
+
+	text []byte
+}
+
+func (s *streamDecoder) token() (xml.Token, error) {
+	tok, err := s.d.Token()
+	if err != nil {
+		s.err = err
+		return nil, err
+	}
+
+	switch tok.(type) {
+	case xml.StartElement:
+		s.depth++
+	case xml.EndElement:
+		s.depth--
+	}
+	return tok, nil
+}
+
+// skip discards the remaining tokens of the current element.
+func (s *streamDecoder) skip() error {
+	if err := s.d.Skip(); err != nil {
+		s.err = err
+		return err
+	}
+	s.depth--
+	return nil
+}
+
+// skipTo discards tokens until the element at depth is closed.
+func (s *streamDecoder) skipTo(depth int) error {
+	for s.depth >= depth {
+		if _, err := s.token(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *streamDecoder) pushNamespaces(start xml.StartElement) int {
+	n := len(s.namespaces)
+	for _, a := range start.Attr {
+		if a.Name.Space == "xmlns" {
+			s.namespaces = append(s.namespaces, a)
+		}
+	}
+	return n
+}
+
+func (s *streamDecoder) popNamespaces(n int) {
+	s.namespaces = s.namespaces[:n]
+}
+
+// attrName returns the attribute's name qualified by its namespace's prefix,
+// the way XMLNode.findElem names attributes.
+func (s *streamDecoder) attrName(a xml.Attr) string {
+	namespace := a.Name.Space
+	for i := len(s.namespaces) - 1; i >= 0; i-- {
+		if s.namespaces[i].Value == namespace {
+			namespace = s.namespaces[i].Name.Local
+			break
+		}
+	}
+	return namespace + ":" + a.Name.Local
+}
+
+// readText returns the character data of the current element, discarding any
+// nested elements.
+func (s *streamDecoder) readText() (string, error) {
+	s.text = s.text[:0]
+	for {
+		tok, err := s.token()
+		if err != nil {
+			return "", err
+		}
+
+		switch tok := tok.(type) {
+		case xml.CharData:
+			s.text = append(s.text, tok...)
+		case xml.StartElement:
+			if err := s.skip(); err != nil {
+				return "", err
+			}
+		case xml.EndElement:
+			return string(s.text), nil
+		}
+	}
+}
+
+// decode deserializes the element started by start into r. The type tag is
+// used to infer the type, or reflect will be used to determine the type from
+// r.
+func (s *streamDecoder) decode(r reflect.Value, start xml.StartElement, tag reflect.StructTag) error {
+	if name := tag.Get("xml"); len(name) != 0 {
+		if strings.SplitAfterN(name, ",", 2)[0] == "-" {
+			return s.skip()
+		}
+	}
+
+	rtype := r.Type()
+	if rtype.Kind() == reflect.Ptr {
+		rtype = rtype.Elem()
+	}
+
+	t := tag.Get("type")
+	if t == "" {
+		switch rtype.Kind() {
+		case reflect.Struct:
+			if rtype != timeType {
+				t = "structure"
+			}
+		case reflect.Slice:
+			if r.Type() != byteSliceType {
+				t = "list"
+			}
+		case reflect.Map:
+			t = "map"
+		}
+	}
+
+	switch t {
+	case "structure":
+		if fields := cachedStreamFields(rtype); fields.hasTag {
+			tag = fields.tag
+		}
+		return s.decodeStruct(r, start, tag)
+	case "list":
+		return s.decodeList(r, start, tag)
+	case "map":
+		return s.decodeMap(r, start, tag)
+	default:
+		text, err := s.readText()
+		if err != nil {
+			return err
+		}
+		return parseScalarText(r, text, tag)
+	}
+}
+
+// parseScalarText deserializes the text of an element into r the same way
+// parseScalar deserializes an XMLNode.
+func parseScalarText(r reflect.Value, text string, tag reflect.StructTag) error {
+	return parseScalar(r, &XMLNode{Text: text}, tag)
+}
+
+func (s *streamDecoder) decodeStruct(r reflect.Value, start xml.StartElement, tag reflect.StructTag) error {
+	t := r.Type()
+	if r.Kind() == reflect.Ptr {
+		if r.IsNil() {
+			r.Set(reflect.New(t.Elem()))
+		}
+		r = r.Elem()
+		t = t.Elem()
+	}
+
+	// unwrap any payloads
+	if payload := tag.Get("payload"); payload != "" {
+		field, _ := t.FieldByName(payload)
+		return s.decodeStruct(r.FieldByIndex(field.Index), start, field.Tag)
+	}
+
+	fields := cachedStreamFields(t)
+
+	for _, a := range start.Attr {
+		if f, ok := fields.byName[s.attrName(a)]; ok {
+			if err := parse(r.Field(f.index), &XMLNode{Text: a.Value}, f.tag); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		tok, err := s.token()
+		if err != nil {
+			return err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			f, ok := fields.byName[tok.Name.Local]
+			if !ok {
+				if err := s.skip(); err != nil {
+					return err
+				}
+				continue
+			}
+
+			n := s.pushNamespaces(tok)
+			if err := s.decode(r.Field(f.index), tok, f.tag); err != nil {
+				return err
+			}
+			s.popNamespaces(n)
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+func (s *streamDecoder) decodeList(r reflect.Value, start xml.StartElement, tag reflect.StructTag) error {
+	t := r.Type()
+
+	if tag.Get("flattened") != "" { // flattened list means this is a single element
+		r.Set(reflect.Append(r, reflect.Zero(t.Elem())))
+		return s.decode(r.Index(r.Len()-1), start, "")
+	}
+
+	mname := "member"
+	if name := tag.Get("locationNameList"); name != "" {
+		mname = name
+	}
+
+	var i int
+	for {
+		tok, err := s.token()
+		if err != nil {
+			return err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if tok.Name.Local != mname {
+				if err := s.skip(); err != nil {
+					return err
+				}
+				continue
+			}
+
+			// A repeated list element overwrites the members of the previous.
+			if i == r.Len() {
+				r.Set(reflect.Append(r, reflect.Zero(t.Elem())))
+			}
+			n := s.pushNamespaces(tok)
+			if err := s.decode(r.Index(i), tok, ""); err != nil {
+				return err
+			}
+			s.popNamespaces(n)
+			i++
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+func (s *streamDecoder) decodeMap(r reflect.Value, start xml.StartElement, tag reflect.StructTag) error {
+	if r.IsNil() {
+		r.Set(reflect.MakeMap(r.Type()))
+	}
+
+	if tag.Get("flattened") != "" { // this element is itself an entry
+		return s.decodeMapEntry(r, tag)
+	}
+
+	for {
+		tok, err := s.token()
+		if err != nil {
+			return err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if tok.Name.Local != "entry" {
+				if err := s.skip(); err != nil {
+					return err
+				}
+				continue
+			}
+
+			n := s.pushNamespaces(tok)
+			if err := s.decodeMapEntry(r, tag); err != nil {
+				return err
+			}
+			s.popNamespaces(n)
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// decodeMapEntry deserializes the keys and values of the current element into
+// r, pairing them in order. As with parseMapEntry values failing to
+// deserialize are not an error.
+func (s *streamDecoder) decodeMapEntry(r reflect.Value, tag reflect.StructTag) error {
+	kname, vname := "key", "value"
+	if n := tag.Get("locationNameKey"); n != "" {
+		kname = n
+	}
+	if n := tag.Get("locationNameValue"); n != "" {
+		vname = n
+	}
+
+	var keys []string
+	var values []reflect.Value
+	for {
+		tok, err := s.token()
+		if err != nil {
+			return err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			switch tok.Name.Local {
+			case kname:
+				key, err := s.readText()
+				if err != nil {
+					return err
+				}
+				keys = append(keys, key)
+			case vname:
+				value := reflect.New(r.Type().Elem()).Elem()
+				depth := s.depth
+				n := s.pushNamespaces(tok)
+				if err := s.decode(value, tok, ""); err != nil {
+					if s.err != nil {
+						return err
+					}
+					if err := s.skipTo(depth); err != nil {
+						return err
+					}
+				}
+				s.popNamespaces(n)
+				values = append(values, value)
+			default:
+				if err := s.skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			for i := 0; i < len(keys) && i < len(values); i++ {
+				r.SetMapIndex(reflect.ValueOf(keys[i]), values[i])
+			}
+			return nil
+		}
+	}
+}
+
+// streamFields are the fields of a structure by the name of the element, or
+// the qualified name of the attribute, they are deserialized from.
+type streamFields struct {
+	byName map[string]streamField
+
+	// tag of the structure's "_" field, if any.
+	tag    reflect.StructTag
+	hasTag bool
+}
+
+type streamField struct {
+	index int
+	tag   reflect.StructTag
+}
+
+var streamFieldsCache sync.Map // map[reflect.Type]*streamFields
+
+func cachedStreamFields(t reflect.Type) *streamFields {
+	if v, ok := streamFieldsCache.Load(t); ok {
+		return v.(*streamFields)
+	}
+
+	fields := &streamFields{byName: map[string]streamField{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Name == "_" {
+			fields.tag, fields.hasTag = field.Tag, true
+		}
+		if c := field.Name[0:1]; strings.ToLower(c) == c {
+			continue // ignore unexported fields
+		}
+
+		// figure out what this field is called
+		name := field.Name
+		if field.Tag.Get("flattened") != "" && field.Tag.Get("locationNameList") != "" {
+			name = field.Tag.Get("locationNameList")
+		} else if locName := field.Tag.Get("locationName"); locName != "" {
+			name = locName
+		}
+
+		if _, ok := fields.byName[name]; !ok {
+			fields.byName[name] = streamField{index: i, tag: field.Tag}
+		}
+	}
+
+	v, _ := streamFieldsCache.LoadOrStore(t, fields)
+	return v.(*streamFields)
+}
@@ -0,0 +1,144 @@
+package xmlutil
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awsutil"
+	"github.com/IBM/ibm-cos-sdk-go/internal/sdktesting"
+)
+
+type mockPayloadOutput struct {
+	_ struct{} `type:"structure" payload:"Config"`
+
+	Config *mockNestedStruct `type:"structure"`
+}
+
+func TestUnmarshalXMLStream(t *testing.T) {
+	cases := map[string]struct {
+		Body   string
+		Output func() interface{}
+	}{
+		"structure": {
+			Body: `<?xml version="1.0" encoding="UTF-8"?>
+<MockResponse xmlns="http://xmlns.example.com">
+	<String>string value</String>
+	<Integer>123</Integer>
+	<Float>Infinity</Float>
+	<Unknown><String>ignored</String></Unknown>
+	<Closed xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:attrval="attr value"/>
+	<Nested>
+		<NestedString>nested string value</NestedString>
+		<NestedInt>321</NestedInt>
+	</Nested>
+	<List>
+		<Elem>
+			<NestedElem xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="type">
+				<String>nested elem string value</String>
+			</NestedElem>
+			<String>elem string value</String>
+		</Elem>
+		<Elem><String>second</String></Elem>
+	</List>
+</MockResponse>`,
+			Output: func() interface{} { return &mockOutput{} },
+		},
+		"payload": {
+			Body:   `<Config><NestedString>abc</NestedString><NestedInt>1</NestedInt></Config>`,
+			Output: func() interface{} { return &mockPayloadOutput{} },
+		},
+		"collections": {
+			Body: `<R xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="t">
+	<List><member>a</member></List>
+	<List><member>b</member><member>c</member></List>
+	<Named><item>x</item><other>y</other><item/></Named>
+	<Flat><Str>a</Str></Flat>
+	<Flat><Child><Int>1</Int></Child></Flat>
+	<Nested><member><member>1</member><member>2</member></member><member/></Nested>
+	<Map><entry><key>k1</key><value>v1</value></entry><entry><key>k2</key><key>k3</key><value>v2</value></entry></Map>
+	<FlatMap><k>a</k><v><Int>1</Int></v></FlatMap>
+	<FlatMap><v><Int>x</Int></v><k>b</k></FlatMap>
+	<Blob>aGVsbG8=</Blob>
+	<Time>2014-04-29T18:30:38Z</Time>
+	<Bool>true</Bool>
+</R>`,
+			Output: func() interface{} { return &fuzzShape{} },
+		},
+	}
+
+	resps, err := sdktesting.ProtocolTestResponses("rest-xml")
+	if err != nil {
+		t.Fatalf("expect protocol tests, got %v", err)
+	}
+	for i, resp := range resps {
+		if len(resp.Body) == 0 {
+			continue
+		}
+		cases[fmt.Sprintf("rest-xml %d", i)] = struct {
+			Body   string
+			Output func() interface{}
+		}{
+			Body:   resp.Body,
+			Output: func() interface{} { return &fuzzShape{} },
+		}
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			expect := c.Output()
+			err := UnmarshalXML(expect, xml.NewDecoder(strings.NewReader(c.Body)), "")
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			actual := c.Output()
+			err = UnmarshalXMLStream(actual, xml.NewDecoder(strings.NewReader(c.Body)))
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := expect, actual; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v, got %v", awsutil.Prettify(e), awsutil.Prettify(a))
+			}
+		})
+	}
+}
+
+func TestUnmarshalXMLStream_Error(t *testing.T) {
+	cases := map[string]string{
+		"invalid scalar":     `<R><Int>abc</Int></R>`,
+		"unexpected EOF":     `<R><Str>abc</Str><List><member>`,
+		"mismatched element": `<R><Str>abc</Int></R>`,
+	}
+
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			var out fuzzShape
+			err := UnmarshalXMLStream(&out, xml.NewDecoder(strings.NewReader(body)))
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+		})
+	}
+}
+
+func TestUnmarshalXMLStream_ReadError(t *testing.T) {
+	expectErr := fmt.Errorf("expected read error")
+	body := &mockBody{
+		DoneErr: expectErr,
+		Body:    strings.NewReader(`<R><Str>first value</Str><Int>1`),
+	}
+
+	var out fuzzShape
+	err := UnmarshalXMLStream(&out, xml.NewDecoder(body))
+	if e, a := expectErr, err; e != a {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+	if e, a := "first value", aws.StringValue(out.Str); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
@@ -181,6 +181,13 @@ func parseList(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 			if r.IsNil() {
 				r.Set(reflect.MakeSlice(t, len(Children), len(Children)))
 			}
+			// IBM COS SDK Code -- START
+			// A repeated list element may have more members than the slice
+			// parsed from the previous element.
+			if n := len(Children) - r.Len(); n > 0 {
+				r.Set(reflect.AppendSlice(r, reflect.MakeSlice(t, n, n)))
+			}
+			// IBM COS SDK Code -- END
 
 			for i, c := range Children {
 				err := parse(r.Index(i), c, "")
@@ -237,6 +244,11 @@ func parseMapEntry(r reflect.Value, node *XMLNode, tag reflect.StructTag) error
 	values := node.Children[vname]
 	if ok {
 		for i, key := range keys {
+			// IBM COS SDK Code -- START
+			if i >= len(values) {
+				break
+			}
+			// IBM COS SDK Code -- END
 			keyR := reflect.ValueOf(key.Text)
 			value := values[i]
 			valueR := reflect.New(r.Type().Elem()).Elem()
@@ -191,3 +191,32 @@ func TestUnmarshal_UnexpectedEOF(t *testing.T) {
 		t.Errorf("expect %v error in %v, but was not", e, a)
 	}
 }
+
+// IBM COS SDK Code -- START
+func TestUnmarshal_MalformedCollections(t *testing.T) {
+	const body = `<Response>
+	<List><member>a</member></List>
+	<List><member>b</member><member>c</member></List>
+	<Map><entry><key>k1</key><value>v1</value></entry><entry><key>k2</key><key>k3</key><value>v2</value></entry></Map>
+</Response>`
+
+	out := struct {
+		List []*string          `type:"list"`
+		Map  map[string]*string `type:"map"`
+	}{}
+
+	err := UnmarshalXML(&out, xml.NewDecoder(strings.NewReader(body)), "")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := []*string{aws.String("b"), aws.String("c")}, out.List; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", awsutil.Prettify(e), awsutil.Prettify(a))
+	}
+	expectMap := map[string]*string{"k1": aws.String("v1"), "k2": aws.String("v2")}
+	if e, a := expectMap, out.Map; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", awsutil.Prettify(e), awsutil.Prettify(a))
+	}
+}
+
+// IBM COS SDK Code -- END
@@ -1,5 +1,5 @@
 // Package service contains automatically generated AWS clients.
 package service
 
-//go:generate go run -tags codegen ../private/model/cli/gen-api/main.go -path=../service ../models/apis/*/*/api-2.json
+//go:generate go run -tags codegen ../private/model/cli/gen-api/main.go -path=../service -typed-clients=s3,kms ../models/apis/*/*/api-2.json ../models/apis/*/*/smithy.json
 //go:generate gofmt -s -w ../service
@@ -56,3 +56,38 @@ func BenchmarkPresign_PutObject(b *testing.B) {
 		}
 	}
 }
+
+// IBM COS SDK Code -- START
+func BenchmarkUnmarshal_ListObjectVersions(b *testing.B) {
+	body := listObjectVersionsBody(1000)
+
+	for _, stream := range []bool{false, true} {
+		name := "XMLNode"
+		if stream {
+			name = "Streaming"
+		}
+		b.Run(name, func(b *testing.B) {
+			svc := New(unit.Session, &aws.Config{UseStreamingXMLDecoder: aws.Bool(stream)})
+
+			b.ReportAllocs()
+			b.SetBytes(int64(len(body)))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				req, out := svc.ListObjectVersionsRequest(&ListObjectVersionsInput{
+					Bucket: aws.String("mock-bucket"),
+				})
+				req.Handlers.Send.Clear()
+				req.Handlers.Send.PushBack(mockResponseHandler(body))
+
+				if err := req.Send(); err != nil {
+					b.Fatalf("expect no error, got %v", err)
+				}
+				if e, a := 1000, len(out.Versions)+len(out.DeleteMarkers); e != a {
+					b.Fatalf("expect %v entries, got %v", e, a)
+				}
+			}
+		})
+	}
+}
+
+// IBM COS SDK Code -- END
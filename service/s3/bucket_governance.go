@@ -0,0 +1,14 @@
+package s3
+
+// Error codes returned by the bucket policy and bucket tagging operations
+// when the bucket has no policy or tags. The codes are not modeled as
+// exceptions of the operations.
+const (
+	// ErrCodeNoSuchBucketPolicy is returned by GetBucketPolicy when the bucket
+	// does not have a policy.
+	ErrCodeNoSuchBucketPolicy = "NoSuchBucketPolicy"
+
+	// ErrCodeNoSuchTagSet is returned by GetBucketTagging when the bucket
+	// does not have tags.
+	ErrCodeNoSuchTagSet = "NoSuchTagSet"
+)
@@ -0,0 +1,183 @@
+package s3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/awstesting/unit"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// respond replaces the Send handlers of the client, responding to each
+// request with the status and body.
+func respond(svc *s3.S3, status int, body string, inspect func(*http.Request, []byte)) {
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		var b []byte
+		if r.HTTPRequest.Body != nil {
+			b, _ = ioutil.ReadAll(r.HTTPRequest.Body)
+		}
+		inspect(r.HTTPRequest, b)
+		r.HTTPResponse = &http.Response{
+			StatusCode: status,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+		}
+	})
+}
+
+func TestPutBucketPolicy(t *testing.T) {
+	policy := `{"Version":"2012-10-17","Statement":[]}`
+
+	svc := s3.New(unit.Session)
+	respond(svc, http.StatusNoContent, "", func(r *http.Request, body []byte) {
+		if e, a := "PUT", r.Method; e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+		if _, ok := r.URL.Query()["policy"]; !ok {
+			t.Errorf("expect policy query, got %v", r.URL.RawQuery)
+		}
+		if e, a := "true", r.Header.Get("X-Amz-Confirm-Remove-Self-Bucket-Access"); e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+		if e, a := policy, string(body); e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	})
+
+	_, err := svc.PutBucketPolicy(&s3.PutBucketPolicyInput{
+		Bucket:                        aws.String("bucket"),
+		Policy:                        aws.String(policy),
+		ConfirmRemoveSelfBucketAccess: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+}
+
+func TestGetBucketPolicy(t *testing.T) {
+	policy := `{"Version":"2012-10-17","Statement":[]}`
+
+	svc := s3.New(unit.Session)
+	respond(svc, http.StatusOK, policy, func(r *http.Request, _ []byte) {
+		if _, ok := r.URL.Query()["policy"]; !ok {
+			t.Errorf("expect policy query, got %v", r.URL.RawQuery)
+		}
+	})
+
+	out, err := svc.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: aws.String("bucket")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := policy, aws.StringValue(out.Policy); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestGetBucketPolicy_NoSuchBucketPolicy(t *testing.T) {
+	svc := s3.New(unit.Session)
+	respond(svc, http.StatusNotFound,
+		`<Error><Code>NoSuchBucketPolicy</Code><Message>The bucket policy does not exist</Message></Error>`,
+		func(*http.Request, []byte) {})
+
+	_, err := svc.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: aws.String("bucket")})
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != s3.ErrCodeNoSuchBucketPolicy {
+		t.Errorf("expect %v error, got %v", s3.ErrCodeNoSuchBucketPolicy, err)
+	}
+}
+
+func TestBucketTagging(t *testing.T) {
+	svc := s3.New(unit.Session)
+	respond(svc, http.StatusOK, "", func(r *http.Request, body []byte) {
+		if _, ok := r.URL.Query()["tagging"]; !ok {
+			t.Errorf("expect tagging query, got %v", r.URL.RawQuery)
+		}
+		for _, e := range []string{
+			`<Tagging xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><TagSet><Tag>`,
+			`<Key>team</Key>`,
+			`<Value>storage</Value>`,
+		} {
+			if !bytes.Contains(body, []byte(e)) {
+				t.Errorf("expect %v in body, got %s", e, body)
+			}
+		}
+	})
+	_, err := svc.PutBucketTagging(&s3.PutBucketTaggingInput{
+		Bucket: aws.String("bucket"),
+		Tagging: &s3.Tagging{TagSet: []*s3.Tag{
+			{Key: aws.String("team"), Value: aws.String("storage")},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	respond(svc, http.StatusOK,
+		`<Tagging><TagSet><Tag><Key>team</Key><Value>storage</Value></Tag></TagSet></Tagging>`,
+		func(*http.Request, []byte) {})
+	out, err := svc.GetBucketTagging(&s3.GetBucketTaggingInput{Bucket: aws.String("bucket")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, len(out.TagSet); e != a {
+		t.Fatalf("expect %v tags, got %v", e, a)
+	}
+	if e, a := "storage", aws.StringValue(out.TagSet[0].Value); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestBucketNotificationConfiguration(t *testing.T) {
+	svc := s3.New(unit.Session)
+	respond(svc, http.StatusOK, "", func(r *http.Request, body []byte) {
+		if _, ok := r.URL.Query()["notification"]; !ok {
+			t.Errorf("expect notification query, got %v", r.URL.RawQuery)
+		}
+		for _, e := range []string{
+			`<NotificationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`,
+			`<Event>s3:ObjectCreated:*</Event>`,
+			`<Topic>arn:topic</Topic>`,
+		} {
+			if !bytes.Contains(body, []byte(e)) {
+				t.Errorf("expect %v in body, got %s", e, body)
+			}
+		}
+	})
+	_, err := svc.PutBucketNotificationConfiguration(&s3.PutBucketNotificationConfigurationInput{
+		Bucket: aws.String("bucket"),
+		NotificationConfiguration: &s3.NotificationConfiguration{
+			TopicConfigurations: []*s3.TopicConfiguration{{
+				Events:   []*string{aws.String(s3.EventS3ObjectCreated)},
+				TopicArn: aws.String("arn:topic"),
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	respond(svc, http.StatusOK,
+		`<NotificationConfiguration><QueueConfiguration><Id>id</Id><Queue>arn:queue</Queue><Event>s3:ObjectRemoved:*</Event></QueueConfiguration></NotificationConfiguration>`,
+		func(*http.Request, []byte) {})
+	out, err := svc.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String("bucket"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, len(out.QueueConfigurations); e != a {
+		t.Fatalf("expect %v queue configurations, got %v", e, a)
+	}
+	if e, a := "arn:queue", aws.StringValue(out.QueueConfigurations[0].QueueArn); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := s3.EventS3ObjectRemoved, aws.StringValue(out.QueueConfigurations[0].Events[0]); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
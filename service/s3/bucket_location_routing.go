@@ -0,0 +1,344 @@
+package s3
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/client"
+	"github.com/IBM/ibm-cos-sdk-go/aws/endpoints"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/internal/sync/singleflight"
+)
+
+// DefaultBucketLocationCacheTTL is how long discovered bucket locations are
+// cached when aws.Config.S3BucketLocationCacheTTL is not set.
+const DefaultBucketLocationCacheTTL = 15 * time.Minute
+
+// ErrCodeUnknownBucketLocation is the error code returned when the location
+// of a bucket cannot be discovered, or is not a known IBM COS location.
+const ErrCodeUnknownBucketLocation = "UnknownBucketLocation"
+
+// Error codes returned by IBM COS, and S3, when a bucket request is sent to
+// the endpoint of another location.
+var wrongEndpointErrorCodes = map[string]struct{}{
+	"IncorrectEndpoint": {},
+	"PermanentRedirect": {},
+}
+
+// Operations that are not routed to the bucket's location, either because
+// they discover it or because the bucket does not exist yet.
+var bucketLocationRoutingSkipOps = map[string]struct{}{
+	opGetBucketLocation:   {},
+	opListBucketsExtended: {},
+	opCreateBucket:        {},
+}
+
+// bucketLocationRouter routes bucket requests to the IBM COS endpoint of the
+// bucket's location, caching the discovered locations.
+type bucketLocationRouter struct {
+	svc *S3
+	ttl time.Duration
+
+	// Concurrent requests for a bucket share the discovery of its location.
+	discovery singleflight.Group
+
+	m     sync.Mutex
+	cache map[string]bucketLocationEntry
+}
+
+type bucketLocationEntry struct {
+	location string
+	expires  time.Time
+}
+
+func newBucketLocationRouter(c *client.Client) *bucketLocationRouter {
+	ttl := c.Config.S3BucketLocationCacheTTL
+	if ttl <= 0 {
+		ttl = DefaultBucketLocationCacheTTL
+	}
+
+	return &bucketLocationRouter{
+		svc:   &S3{Client: c},
+		ttl:   ttl,
+		cache: map[string]bucketLocationEntry{},
+	}
+}
+
+// endpointHandler routes the request to the endpoint of the bucket's location
+// before building the bucket endpoint with endpointHandler. Requests whose
+// bucket location cannot be discovered are sent to the configured endpoint.
+func (r *bucketLocationRouter) endpointHandler(req *request.Request) {
+	if bucket, ok := r.routableBucket(req); ok {
+		if location, err := r.bucketLocation(req.Context(), bucket); err == nil {
+			r.route(req, bucket, location)
+		} else {
+			logBucketLocationRouting(req, "unable to discover bucket location, using configured endpoint", err)
+		}
+
+		if req.Context().Value(resentRequestKey{}) == nil {
+			rerouted := false
+			req.Handlers.Retry.PushBack(func(req *request.Request) {
+				if rerouted || !isWrongEndpointError(req.Error) {
+					return
+				}
+				rerouted = true
+				r.reroute(req, bucket)
+			})
+		}
+	}
+
+	endpointHandler(req)
+}
+
+func (r *bucketLocationRouter) routableBucket(req *request.Request) (string, bool) {
+	if _, ok := bucketLocationRoutingSkipOps[req.Operation.Name]; ok {
+		return "", false
+	}
+	if endpoint, ok := req.Params.(endpointARNGetter); ok && endpoint.hasEndpointARN() {
+		return "", false
+	}
+	return bucketNameFromReqParams(req.Params)
+}
+
+// reroute discards the cached location of the bucket, rediscovers it and
+// resends the request to its endpoint if the location changed.
+func (r *bucketLocationRouter) reroute(req *request.Request, bucket string) {
+	r.forget(bucket)
+
+	location, err := r.bucketLocation(req.Context(), bucket)
+	if err != nil {
+		logBucketLocationRouting(req, "unable to rediscover bucket location", err)
+		return
+	}
+
+	if r.route(req, bucket, location) {
+		resend(req)
+	}
+}
+
+// resentRequestKey is the context key of requests resent to the endpoint of
+// their bucket's location. Resent requests are not rerouted again.
+type resentRequestKey struct{}
+
+// resend sends the request again as a new request with the same handlers,
+// parameters and output, and makes its result the result of the request.
+// The resend is not a retry, it is not delayed nor counted against the
+// request's retries. Requests with a body that cannot be rewound are not
+// resent.
+func resend(req *request.Request) {
+	if req.Body != nil {
+		if _, err := req.Body.Seek(req.BodyStart, io.SeekStart); err != nil {
+			logBucketLocationRouting(req, "unable to rewind request body to resend it", err)
+			return
+		}
+	}
+
+	resent := request.New(req.Config, req.ClientInfo, req.Handlers, req.Retryer,
+		req.Operation, req.Params, req.Data)
+	// The request's own Complete handlers run with the result of the resend
+	resent.Handlers.Complete.Clear()
+	resent.SetContext(context.WithValue(req.Context(), resentRequestKey{}, true))
+
+	req.Error = resent.Send()
+	req.HTTPRequest = resent.HTTPRequest
+	req.HTTPResponse = resent.HTTPResponse
+	req.RequestID = resent.RequestID
+	req.Retryable = aws.Bool(false)
+}
+
+// route updates the request endpoint to the endpoint of the location. Returns
+// true if the request endpoint changed.
+func (r *bucketLocationRouter) route(req *request.Request, bucket, location string) bool {
+	resolver := endpoints.IBMCOSResolver{NetworkType: ibmCOSNetworkType(req)}
+	resolved, err := resolver.EndpointFor(EndpointsID, location, func(o *endpoints.Options) {
+		o.DisableSSL = aws.BoolValue(req.Config.DisableSSL)
+		o.UseDualStackEndpoint = req.Config.UseDualStackEndpoint
+	})
+	if err != nil {
+		logBucketLocationRouting(req, "unable to resolve bucket location endpoint", err)
+		return false
+	}
+
+	u, err := url.Parse(resolved.URL)
+	if err != nil {
+		return false
+	}
+
+	// The bucket may already have been moved into the host by endpointHandler
+	host := u.Host
+	if strings.HasPrefix(req.HTTPRequest.URL.Host, bucket+".") {
+		host = bucket + "." + host
+	}
+	if req.HTTPRequest.URL.Host == host && req.HTTPRequest.URL.Scheme == u.Scheme {
+		return false
+	}
+
+	req.HTTPRequest.URL.Scheme = u.Scheme
+	req.HTTPRequest.URL.Host = host
+	req.ClientInfo.Endpoint = resolved.URL
+	req.ClientInfo.SigningRegion = resolved.SigningRegion
+	return true
+}
+
+// bucketLocation returns the cached location of the bucket, discovering it
+// with GetBucketLocation, or ListBucketsExtended, if not cached or expired.
+//
+// Concurrent callers share the discovery of the bucket's location. The
+// shared discovery is not canceled with the context of the caller that
+// started it, a canceled caller only stops waiting for it.
+func (r *bucketLocationRouter) bucketLocation(ctx aws.Context, bucket string) (string, error) {
+	if location, ok := r.cached(bucket); ok {
+		return location, nil
+	}
+
+	resCh := r.discovery.DoChan(bucket, func() (interface{}, error) {
+		return r.discoverLocation(&suppressedContext{ctx}, bucket)
+	})
+	select {
+	case res := <-resCh:
+		if res.Err != nil {
+			return "", res.Err
+		}
+		return res.Val.(string), nil
+	case <-ctx.Done():
+		return "", awserr.New(request.CanceledErrorCode,
+			"request context canceled", ctx.Err())
+	}
+}
+
+func (r *bucketLocationRouter) cached(bucket string) (string, bool) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	entry, ok := r.cache[bucket]
+	if !ok || !time.Now().Before(entry.expires) {
+		return "", false
+	}
+	return entry.location, true
+}
+
+// discoverLocation discovers the location of the bucket and caches it.
+func (r *bucketLocationRouter) discoverLocation(ctx aws.Context, bucket string) (string, error) {
+	// The location may have been cached by a discovery that completed
+	// after the caller checked the cache.
+	if location, ok := r.cached(bucket); ok {
+		return location, nil
+	}
+
+	constraint, err := r.discoverLocationConstraint(ctx, bucket)
+	if err != nil {
+		return "", err
+	}
+
+	// Requests are routed on the location alone, so buckets of storage
+	// classes unknown to the SDK are routed too.
+	location, _, err := splitBucketLocationConstraint(constraint)
+	if err != nil {
+		return "", awserr.New(ErrCodeUnknownBucketLocation,
+			"unknown location constraint "+constraint+" for bucket "+bucket, err)
+	}
+
+	r.m.Lock()
+	r.cache[bucket] = bucketLocationEntry{location: location, expires: time.Now().Add(r.ttl)}
+	r.m.Unlock()
+
+	return location, nil
+}
+
+func (r *bucketLocationRouter) forget(bucket string) {
+	r.m.Lock()
+	delete(r.cache, bucket)
+	r.m.Unlock()
+}
+
+func (r *bucketLocationRouter) discoverLocationConstraint(ctx aws.Context, bucket string) (string, error) {
+	out, err := r.svc.GetBucketLocationWithContext(ctx, &GetBucketLocationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err == nil && len(aws.StringValue(out.LocationConstraint)) != 0 {
+		return aws.StringValue(out.LocationConstraint), nil
+	}
+
+	var constraint string
+	listErr := r.svc.ListBucketsExtendedPagesWithContext(ctx, &ListBucketsExtendedInput{
+		Prefix: aws.String(bucket),
+	}, func(page *ListBucketsExtendedOutput, lastPage bool) bool {
+		for _, b := range page.Buckets {
+			if aws.StringValue(b.Name) == bucket {
+				constraint = aws.StringValue(b.LocationConstraint)
+				return false
+			}
+		}
+		return true
+	})
+	switch {
+	case len(constraint) != 0:
+		return constraint, nil
+	case err != nil:
+		return "", err
+	case listErr != nil:
+		return "", listErr
+	default:
+		return "", awserr.New(ErrCodeUnknownBucketLocation, "location of bucket "+bucket+" not found", nil)
+	}
+}
+
+// ibmCOSNetworkType returns the network type of the request's IBM COS
+// endpoint, so routed requests stay on the same network.
+func ibmCOSNetworkType(req *request.Request) endpoints.IBMCOSNetworkType {
+	if resolver, ok := req.Config.EndpointResolver.(endpoints.IBMCOSResolver); ok && len(resolver.NetworkType) != 0 {
+		return resolver.NetworkType
+	}
+
+	host := req.ClientInfo.Endpoint
+	if u, err := url.Parse(req.ClientInfo.Endpoint); err == nil && len(u.Host) != 0 {
+		host = u.Host
+	}
+	switch {
+	case strings.HasPrefix(host, "s3.private."):
+		return endpoints.IBMCOSPrivateNetwork
+	case strings.HasPrefix(host, "s3.direct."):
+		return endpoints.IBMCOSDirectNetwork
+	default:
+		return endpoints.IBMCOSPublicNetwork
+	}
+}
+
+func isWrongEndpointError(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	_, ok = wrongEndpointErrorCodes[aerr.Code()]
+	return ok
+}
+
+// suppressedContext keeps the values of the context, without its deadline
+// and cancellation, for work shared by several callers.
+type suppressedContext struct {
+	aws.Context
+}
+
+func (s *suppressedContext) Deadline() (deadline time.Time, ok bool) {
+	return time.Time{}, false
+}
+
+func (s *suppressedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (s *suppressedContext) Err() error {
+	return nil
+}
+
+func logBucketLocationRouting(req *request.Request, msg string, err error) {
+	if req.Config.LogLevel.Matches(aws.LogDebug) && req.Config.Logger != nil {
+		req.Config.Logger.Log("DEBUG: S3 bucket location routing,", msg, err)
+	}
+}
@@ -0,0 +1,254 @@
+package s3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/awstesting/unit"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+func newBucketLocationRoutingClient(locations []string, wrongEndpointHost string, cfgs ...*aws.Config) (*s3.S3, *[]string) {
+	cfg := &aws.Config{
+		Region:                  aws.String("us-south"),
+		Endpoint:                aws.String("https://s3.private.us-south.cloud-object-storage.appdomain.cloud"),
+		S3BucketLocationRouting: aws.Bool(true),
+		SleepDelay:              func(time.Duration) {},
+	}
+	cfg.MergeIn(cfgs...)
+	svc := s3.New(unit.Session, cfg)
+
+	var hosts []string
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		hosts = append(hosts, r.HTTPRequest.URL.Host)
+
+		status, body := 200, ""
+		switch {
+		case r.Operation.Name == "GetBucketLocation":
+			body = `<?xml version="1.0" encoding="UTF-8"?><LocationConstraint>` + locations[0] + `</LocationConstraint>`
+			if len(locations) > 1 {
+				locations = locations[1:]
+			}
+		case r.HTTPRequest.URL.Host == wrongEndpointHost:
+			status = 400
+			body = `<Error><Code>IncorrectEndpoint</Code><Message>The specified bucket exists in another location.</Message></Error>`
+		}
+		r.HTTPResponse = &http.Response{
+			StatusCode: status,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+
+	return svc, &hosts
+}
+
+func TestBucketLocationRouting(t *testing.T) {
+	svc, hosts := newBucketLocationRoutingClient([]string{"eu-de-standard"}, "")
+
+	for i := 0; i < 2; i++ {
+		_, err := svc.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+
+	expect := []string{
+		"s3.private.us-south.cloud-object-storage.appdomain.cloud",
+		"bucket.s3.private.eu-de.cloud-object-storage.appdomain.cloud",
+		"bucket.s3.private.eu-de.cloud-object-storage.appdomain.cloud",
+	}
+	if e, a := len(expect), len(*hosts); e != a {
+		t.Fatalf("expect %v requests, got %v, %v", e, a, *hosts)
+	}
+	for i, e := range expect {
+		if a := (*hosts)[i]; e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+}
+
+func TestBucketLocationRouting_RetryWrongEndpoint(t *testing.T) {
+	svc, hosts := newBucketLocationRoutingClient([]string{"us-south-standard", "ams03-vault"},
+		"bucket.s3.private.us-south.cloud-object-storage.appdomain.cloud")
+
+	_, err := svc.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := []string{
+		"s3.private.us-south.cloud-object-storage.appdomain.cloud",
+		"bucket.s3.private.us-south.cloud-object-storage.appdomain.cloud",
+		"s3.private.us-south.cloud-object-storage.appdomain.cloud",
+		"bucket.s3.private.ams03.cloud-object-storage.appdomain.cloud",
+	}
+	if e, a := len(expect), len(*hosts); e != a {
+		t.Fatalf("expect %v requests, got %v, %v", e, a, *hosts)
+	}
+	for i, e := range expect {
+		if a := (*hosts)[i]; e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+}
+
+func TestBucketLocationRouting_RerouteWithoutRetries(t *testing.T) {
+	var delays []time.Duration
+	svc, hosts := newBucketLocationRoutingClient([]string{"us-south-standard", "ams03-vault"},
+		"bucket.s3.private.us-south.cloud-object-storage.appdomain.cloud",
+		&aws.Config{
+			MaxRetries: aws.Int(0),
+			SleepDelay: func(d time.Duration) { delays = append(delays, d) },
+		})
+
+	req, _ := svc.GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if err := req.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "bucket.s3.private.ams03.cloud-object-storage.appdomain.cloud", (*hosts)[len(*hosts)-1]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 0, req.RetryCount; e != a {
+		t.Errorf("expect %v retries, got %v", e, a)
+	}
+	if len(delays) != 0 {
+		t.Errorf("expect no retry delay, got %v", delays)
+	}
+}
+
+func TestBucketLocationRouting_RerouteBody(t *testing.T) {
+	svc, hosts := newBucketLocationRoutingClient([]string{"us-south-standard", "ams03-vault"},
+		"bucket.s3.private.us-south.cloud-object-storage.appdomain.cloud")
+
+	var bodies []string
+	svc.Handlers.Send.PushFront(func(r *request.Request) {
+		if r.Operation.Name == "PutObject" {
+			b, _ := ioutil.ReadAll(r.HTTPRequest.Body)
+			bodies = append(bodies, string(b))
+		}
+	})
+
+	_, err := svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader([]byte("object data")),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "bucket.s3.private.ams03.cloud-object-storage.appdomain.cloud", (*hosts)[len(*hosts)-1]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := []string{"object data", "object data"}, bodies; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestBucketLocationRouting_SharedDiscovery(t *testing.T) {
+	svc := s3.New(unit.Session, &aws.Config{
+		Region:                  aws.String("us-south"),
+		Endpoint:                aws.String("https://s3.private.us-south.cloud-object-storage.appdomain.cloud"),
+		S3BucketLocationRouting: aws.Bool(true),
+	})
+
+	var m sync.Mutex
+	discoveries := 0
+	release := make(chan struct{})
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		body := ""
+		if r.Operation.Name == "GetBucketLocation" {
+			m.Lock()
+			discoveries++
+			m.Unlock()
+			<-release
+			body = `<?xml version="1.0" encoding="UTF-8"?><LocationConstraint>eu-de-standard</LocationConstraint>`
+		}
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+
+	const requests = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, requests)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := svc.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+			errs <- err
+		}()
+	}
+	// Let the requests wait on the pending discovery
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("expect no error, got %v", err)
+		}
+	}
+	if e, a := 1, discoveries; e != a {
+		t.Errorf("expect %v discovery, got %v", e, a)
+	}
+}
+
+func TestBucketLocationRouting_RerouteOnce(t *testing.T) {
+	svc, hosts := newBucketLocationRoutingClient([]string{"us-south-standard"},
+		"bucket.s3.private.us-south.cloud-object-storage.appdomain.cloud",
+		&aws.Config{MaxRetries: aws.Int(0)})
+
+	_, err := svc.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+
+	// The location did not change, so the request is not resent
+	if e, a := 3, len(*hosts); e != a {
+		t.Errorf("expect %v requests, got %v, %v", e, a, *hosts)
+	}
+}
+
+func TestBucketLocationRouting_UnknownStorageClass(t *testing.T) {
+	svc, hosts := newBucketLocationRoutingClient([]string{"eu-de-newclass"}, "")
+
+	_, err := svc.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	// Requests are routed on the location of the constraint
+	if e, a := "bucket.s3.private.eu-de.cloud-object-storage.appdomain.cloud", (*hosts)[len(*hosts)-1]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestBucketLocationRouting_UnknownLocation(t *testing.T) {
+	svc, hosts := newBucketLocationRoutingClient([]string{"mars-standard"}, "")
+
+	_, err := svc.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	// Requests fall back to the configured endpoint
+	if e, a := "bucket.s3.private.us-south.cloud-object-storage.appdomain.cloud", (*hosts)[len(*hosts)-1]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
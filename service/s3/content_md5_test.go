@@ -236,3 +236,28 @@ func TestMD5InPutPublicAccessBlock(t *testing.T) {
 
 	assertMD5(t, req)
 }
+
+// IBM COS SDK Code -- START
+func TestMD5InPutBucketPolicy(t *testing.T) {
+	svc := s3.New(unit.Session)
+	req, _ := svc.PutBucketPolicyRequest(&s3.PutBucketPolicyInput{
+		Bucket: aws.String("bucketname"),
+		Policy: aws.String("{}"),
+	})
+	assertMD5(t, req)
+}
+
+func TestMD5InPutBucketTagging(t *testing.T) {
+	svc := s3.New(unit.Session)
+	req, _ := svc.PutBucketTaggingRequest(&s3.PutBucketTaggingInput{
+		Bucket: aws.String("bucketname"),
+		Tagging: &s3.Tagging{
+			TagSet: []*s3.Tag{
+				{Key: aws.String("KEY"), Value: aws.String("VALUE")},
+			},
+		},
+	})
+	assertMD5(t, req)
+}
+
+// IBM COS SDK Code -- END
@@ -24,7 +24,14 @@ func defaultInitClientFn(c *client.Client) {
 	}
 
 	// Support building custom endpoints based on config
-	c.Handlers.Build.PushFront(endpointHandler)
+	// IBM COS SDK Code -- START
+	if aws.BoolValue(c.Config.S3BucketLocationRouting) {
+		// Route bucket requests to the endpoint of the bucket's location
+		c.Handlers.Build.PushFront(newBucketLocationRouter(c).endpointHandler)
+	} else {
+		c.Handlers.Build.PushFront(endpointHandler)
+	}
+	// IBM COS SDK Code -- END
 
 	// Require SSL when using SSE keys
 	c.Handlers.Validate.PushBack(validateSSERequiresSSL)
@@ -268,6 +268,58 @@ func ExampleS3_DeleteBucketCors_shared00() {
 	fmt.Println(result)
 }
 
+// To delete bucket policy
+// The following example deletes bucket policy on the specified bucket.
+func ExampleS3_DeleteBucketPolicy_shared00() {
+	svc := s3.New(session.Must(session.NewSession()))
+	input := &s3.DeleteBucketPolicyInput{
+		Bucket: aws.String("examplebucket"),
+	}
+
+	result, err := svc.DeleteBucketPolicy(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			default:
+				fmt.Println(aerr.Error())
+			}
+		} else {
+			// Print the error, cast err to awserr.Error to get the Code and
+			// Message from an error.
+			fmt.Println(err.Error())
+		}
+		return
+	}
+
+	fmt.Println(result)
+}
+
+// To delete bucket tags
+// The following example deletes bucket tags.
+func ExampleS3_DeleteBucketTagging_shared00() {
+	svc := s3.New(session.Must(session.NewSession()))
+	input := &s3.DeleteBucketTaggingInput{
+		Bucket: aws.String("examplebucket"),
+	}
+
+	result, err := svc.DeleteBucketTagging(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			default:
+				fmt.Println(aerr.Error())
+			}
+		} else {
+			// Print the error, cast err to awserr.Error to get the Code and
+			// Message from an error.
+			fmt.Println(err.Error())
+		}
+		return
+	}
+
+	fmt.Println(result)
+}
+
 // To delete bucket website configuration
 // The following example deletes bucket website configuration.
 func ExampleS3_DeleteBucketWebsite_shared00() {
@@ -566,6 +618,84 @@ func ExampleS3_GetBucketLocation_shared00() {
 	fmt.Println(result)
 }
 
+// To get notification configuration set on a bucket
+// The following example returns notification configuration set on a bucket.
+func ExampleS3_GetBucketNotificationConfiguration_shared00() {
+	svc := s3.New(session.Must(session.NewSession()))
+	input := &s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String("examplebucket"),
+	}
+
+	result, err := svc.GetBucketNotificationConfiguration(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			default:
+				fmt.Println(aerr.Error())
+			}
+		} else {
+			// Print the error, cast err to awserr.Error to get the Code and
+			// Message from an error.
+			fmt.Println(err.Error())
+		}
+		return
+	}
+
+	fmt.Println(result)
+}
+
+// To get bucket policy
+// The following example returns bucket policy associated with a bucket.
+func ExampleS3_GetBucketPolicy_shared00() {
+	svc := s3.New(session.Must(session.NewSession()))
+	input := &s3.GetBucketPolicyInput{
+		Bucket: aws.String("examplebucket"),
+	}
+
+	result, err := svc.GetBucketPolicy(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			default:
+				fmt.Println(aerr.Error())
+			}
+		} else {
+			// Print the error, cast err to awserr.Error to get the Code and
+			// Message from an error.
+			fmt.Println(err.Error())
+		}
+		return
+	}
+
+	fmt.Println(result)
+}
+
+// To get tag set associated with a bucket
+// The following example returns tag set associated with a bucket
+func ExampleS3_GetBucketTagging_shared00() {
+	svc := s3.New(session.Must(session.NewSession()))
+	input := &s3.GetBucketTaggingInput{
+		Bucket: aws.String("examplebucket"),
+	}
+
+	result, err := svc.GetBucketTagging(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			default:
+				fmt.Println(aerr.Error())
+			}
+		} else {
+			// Print the error, cast err to awserr.Error to get the Code and
+			// Message from an error.
+			fmt.Println(err.Error())
+		}
+		return
+	}
+
+	fmt.Println(result)
+}
+
 // To get bucket website configuration
 // The following example retrieves website configuration of a bucket.
 func ExampleS3_GetBucketWebsite_shared00() {
@@ -1084,6 +1214,108 @@ func ExampleS3_PutBucketCors_shared00() {
 	fmt.Println(result)
 }
 
+// Set notification configuration for a bucket
+// The following example sets notification configuration on a bucket to publish the
+// object created events to an SNS topic.
+func ExampleS3_PutBucketNotificationConfiguration_shared00() {
+	svc := s3.New(session.Must(session.NewSession()))
+	input := &s3.PutBucketNotificationConfigurationInput{
+		Bucket: aws.String("examplebucket"),
+		NotificationConfiguration: &s3.NotificationConfiguration{
+			TopicConfigurations: []*s3.TopicConfiguration{
+				{
+					Events: []*string{
+						aws.String("s3:ObjectCreated:*"),
+					},
+					TopicArn: aws.String("arn:aws:sns:us-west-2:123456789012:s3-notification-topic"),
+				},
+			},
+		},
+	}
+
+	result, err := svc.PutBucketNotificationConfiguration(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			default:
+				fmt.Println(aerr.Error())
+			}
+		} else {
+			// Print the error, cast err to awserr.Error to get the Code and
+			// Message from an error.
+			fmt.Println(err.Error())
+		}
+		return
+	}
+
+	fmt.Println(result)
+}
+
+// Set bucket policy
+// The following example sets a permission policy on a bucket.
+func ExampleS3_PutBucketPolicy_shared00() {
+	svc := s3.New(session.Must(session.NewSession()))
+	input := &s3.PutBucketPolicyInput{
+		Bucket: aws.String("examplebucket"),
+		Policy: aws.String("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Sid\":\"ReadOnly\",\"Effect\":\"Allow\",\"Principal\":\"*\",\"Action\":\"s3:GetObject\",\"Resource\":\"arn:aws:s3:::examplebucket/*\"}]}"),
+	}
+
+	result, err := svc.PutBucketPolicy(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			default:
+				fmt.Println(aerr.Error())
+			}
+		} else {
+			// Print the error, cast err to awserr.Error to get the Code and
+			// Message from an error.
+			fmt.Println(err.Error())
+		}
+		return
+	}
+
+	fmt.Println(result)
+}
+
+// Set tags on a bucket
+// The following example sets tags on a bucket. Any existing tags are replaced.
+func ExampleS3_PutBucketTagging_shared00() {
+	svc := s3.New(session.Must(session.NewSession()))
+	input := &s3.PutBucketTaggingInput{
+		Bucket: aws.String("examplebucket"),
+		Tagging: &s3.Tagging{
+			TagSet: []*s3.Tag{
+				{
+					Key:   aws.String("Key1"),
+					Value: aws.String("Value1"),
+				},
+				{
+					Key:   aws.String("Key2"),
+					Value: aws.String("Value2"),
+				},
+			},
+		},
+	}
+
+	result, err := svc.PutBucketTagging(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			default:
+				fmt.Println(aerr.Error())
+			}
+		} else {
+			// Print the error, cast err to awserr.Error to get the Code and
+			// Message from an error.
+			fmt.Println(err.Error())
+		}
+		return
+	}
+
+	fmt.Println(result)
+}
+
 // Set website configuration on a bucket
 // The following example adds website configuration to a bucket.
 func ExampleS3_PutBucketWebsite_shared00() {
@@ -0,0 +1,66 @@
+package s3
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+)
+
+func FuzzUnmarshalError(f *testing.F) {
+	f.Add(404, []byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The resource you requested does not exist</Message><Resource>/mybucket/myfoto.jpg</Resource><RequestId>4442587FB7D0A2F9</RequestId></Error>`))
+	f.Add(200, []byte(`<Error><Code>InternalError</Code><Message>We encountered an internal error.</Message></Error>`))
+	f.Add(301, []byte(`<Error><Code>PermanentRedirect</Code></Error>`))
+	f.Add(403, []byte(``))
+	f.Add(500, []byte(`<Error><Code>`))
+
+	f.Fuzz(func(t *testing.T, status int, body []byte) {
+		r := &request.Request{
+			HTTPRequest: &http.Request{Header: http.Header{}},
+			HTTPResponse: &http.Response{
+				StatusCode: status,
+				Header:     http.Header{"X-Amz-Bucket-Region": []string{"us-west-2"}},
+				Body:       ioutil.NopCloser(bytes.NewReader(body)),
+			},
+		}
+		unmarshalError(r)
+
+		if _, ok := r.Error.(awserr.RequestFailure); !ok {
+			t.Fatalf("expect request failure, got %T, %v", r.Error, r.Error)
+		}
+	})
+}
+
+func FuzzGetBucketLocation(f *testing.F) {
+	f.Add([]byte(`<?xml version="1.0" encoding="UTF-8"?><LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">EU</LocationConstraint>`))
+	f.Add([]byte(`<?xml version="1.0" encoding="UTF-8"?><LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/"/>`))
+	f.Add([]byte(`<LocationConstraint>us-standard</LocationConstraint>`))
+	f.Add([]byte(``))
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		out := &GetBucketLocationOutput{}
+		r := &request.Request{
+			Data: out,
+			HTTPResponse: &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewReader(body)),
+			},
+		}
+		buildGetBucketLocation(r)
+		if r.Error != nil {
+			t.Fatalf("expect no error, got %v", r.Error)
+		}
+
+		loc := NormalizeBucketLocation(aws.StringValue(out.LocationConstraint))
+		if len(loc) == 0 {
+			t.Errorf("expect normalized location, got none")
+		}
+		if e, a := loc, NormalizeBucketLocation(loc); e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	})
+}
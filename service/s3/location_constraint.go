@@ -0,0 +1,139 @@
+package s3
+
+import (
+	"strings"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/endpoints"
+)
+
+// ErrCodeInvalidLocationConstraint is the error code returned when a bucket
+// LocationConstraint is not an IBM COS provisioning code.
+const ErrCodeInvalidLocationConstraint = "InvalidLocationConstraint"
+
+// BucketStorageClass is the storage class encoded in the IBM COS bucket
+// LocationConstraint provisioning code, e.g. standard in us-south-standard.
+type BucketStorageClass string
+
+// IBM COS bucket storage classes.
+const (
+	// BucketStorageClassStandard is for active workloads.
+	BucketStorageClassStandard BucketStorageClass = "standard"
+
+	// BucketStorageClassVault is for less active workloads.
+	BucketStorageClassVault BucketStorageClass = "vault"
+
+	// BucketStorageClassCold is for cold workloads.
+	BucketStorageClassCold BucketStorageClass = "cold"
+
+	// BucketStorageClassSmart is for workloads with changing access patterns.
+	BucketStorageClassSmart BucketStorageClass = "smart"
+
+	// BucketStorageClassFlex is the legacy Flex storage class.
+	BucketStorageClassFlex BucketStorageClass = "flex"
+
+	// BucketStorageClassOneRateActive is for One Rate plan instances.
+	BucketStorageClassOneRateActive BucketStorageClass = "onerate_active"
+)
+
+// BucketStorageClass_Values returns all elements of the BucketStorageClass enum
+func BucketStorageClass_Values() []BucketStorageClass {
+	return []BucketStorageClass{
+		BucketStorageClassStandard,
+		BucketStorageClassVault,
+		BucketStorageClassCold,
+		BucketStorageClassSmart,
+		BucketStorageClassFlex,
+		BucketStorageClassOneRateActive,
+	}
+}
+
+// ParseBucketStorageClass returns the BucketStorageClass of the
+// case-insensitive string.
+func ParseBucketStorageClass(s string) (BucketStorageClass, error) {
+	for _, v := range BucketStorageClass_Values() {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", awserr.New(ErrCodeInvalidLocationConstraint, "unknown bucket storage class "+s, nil)
+}
+
+// BucketLocation is an IBM COS bucket LocationConstraint provisioning code,
+// made of the bucket's location and storage class.
+type BucketLocation struct {
+	// IBM COS location, e.g. us-south, us or ams03
+	Location string
+
+	// Storage class, empty when the provisioning code has none, in which case
+	// IBM COS uses standard.
+	StorageClass BucketStorageClass
+}
+
+// LocationType returns the resiliency of the bucket's location.
+func (l BucketLocation) LocationType() endpoints.IBMCOSLocationType {
+	return endpoints.IBMCOSLocations()[l.Location].Type
+}
+
+// String returns the LocationConstraint provisioning code, e.g.
+// us-south-standard.
+func (l BucketLocation) String() string {
+	return FormatBucketLocationConstraint(l.Location, l.StorageClass)
+}
+
+// FormatBucketLocationConstraint returns the LocationConstraint provisioning
+// code of the location and storage class, e.g. us-south-standard. The location
+// is returned as is if the storage class is empty.
+func FormatBucketLocationConstraint(location string, storageClass BucketStorageClass) string {
+	if len(storageClass) == 0 {
+		return location
+	}
+	return location + "-" + string(storageClass)
+}
+
+// ParseBucketLocationConstraint parses an IBM COS LocationConstraint
+// provisioning code, e.g. us-south-standard, eu-smart or ams03-cold, as
+// returned by ListBucketsExtended and GetBucketLocation.
+//
+// Returns an error if the location is not a known IBM COS location, or the
+// storage class is not known.
+func ParseBucketLocationConstraint(constraint string) (BucketLocation, error) {
+	location, suffix, err := splitBucketLocationConstraint(constraint)
+	if err != nil {
+		return BucketLocation{}, err
+	}
+	if len(suffix) == 0 {
+		return BucketLocation{Location: location}, nil
+	}
+
+	storageClass, err := ParseBucketStorageClass(suffix)
+	if err != nil {
+		return BucketLocation{}, err
+	}
+
+	return BucketLocation{Location: location, StorageClass: storageClass}, nil
+}
+
+// splitBucketLocationConstraint returns the known IBM COS location of the
+// LocationConstraint provisioning code, and its storage class suffix without
+// checking it is a known storage class.
+func splitBucketLocationConstraint(constraint string) (location, suffix string, err error) {
+	locations := endpoints.IBMCOSLocations()
+	if _, ok := locations[constraint]; ok {
+		return constraint, "", nil
+	}
+
+	i := strings.LastIndex(constraint, "-")
+	if i <= 0 {
+		return "", "", awserr.New(ErrCodeInvalidLocationConstraint,
+			"unknown location constraint "+constraint, nil)
+	}
+
+	location = constraint[:i]
+	if _, ok := locations[location]; !ok {
+		return "", "", awserr.New(ErrCodeInvalidLocationConstraint,
+			"unknown location "+location+" in location constraint "+constraint, nil)
+	}
+
+	return location, constraint[i+1:], nil
+}
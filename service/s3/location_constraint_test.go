@@ -0,0 +1,64 @@
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws/endpoints"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+func TestParseBucketLocationConstraint(t *testing.T) {
+	cases := []struct {
+		Constraint   string
+		Location     string
+		StorageClass s3.BucketStorageClass
+		LocationType endpoints.IBMCOSLocationType
+		ExpectErr    bool
+	}{
+		{Constraint: "us-south-standard", Location: "us-south", StorageClass: s3.BucketStorageClassStandard, LocationType: endpoints.IBMCOSRegionalLocation},
+		{Constraint: "eu-smart", Location: "eu", StorageClass: s3.BucketStorageClassSmart, LocationType: endpoints.IBMCOSCrossRegionLocation},
+		{Constraint: "us-cold", Location: "us", StorageClass: s3.BucketStorageClassCold, LocationType: endpoints.IBMCOSCrossRegionLocation},
+		{Constraint: "ams03-vault", Location: "ams03", StorageClass: s3.BucketStorageClassVault, LocationType: endpoints.IBMCOSSingleSiteLocation},
+		{Constraint: "jp-tok-onerate_active", Location: "jp-tok", StorageClass: s3.BucketStorageClassOneRateActive, LocationType: endpoints.IBMCOSRegionalLocation},
+		{Constraint: "us-south", Location: "us-south", LocationType: endpoints.IBMCOSRegionalLocation},
+		{Constraint: "us-south-glacier", ExpectErr: true},
+		{Constraint: "mars-standard", ExpectErr: true},
+		{Constraint: "", ExpectErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Constraint, func(t *testing.T) {
+			l, err := s3.ParseBucketLocationConstraint(c.Constraint)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got %v", l)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Location, l.Location; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			if e, a := c.StorageClass, l.StorageClass; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			if e, a := c.LocationType, l.LocationType(); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			if e, a := c.Constraint, l.String(); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestParseBucketStorageClass(t *testing.T) {
+	if v, err := s3.ParseBucketStorageClass("Smart"); err != nil || v != s3.BucketStorageClassSmart {
+		t.Errorf("expect %v, got %v, %v", s3.BucketStorageClassSmart, v, err)
+	}
+	if _, err := s3.ParseBucketStorageClass("glacier"); err == nil {
+		t.Errorf("expect error, got none")
+	}
+}
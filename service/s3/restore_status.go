@@ -0,0 +1,94 @@
+package s3
+
+import (
+	"strings"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+)
+
+const (
+	// ErrCodeInvalidRestoreStatus is the error code returned when the Restore
+	// header of an object cannot be parsed.
+	ErrCodeInvalidRestoreStatus = "InvalidRestoreStatus"
+
+	// ErrCodeObjectRestoreNotRequested is the error code returned when
+	// checking the restore status of an object without a Restore header,
+	// because it is not archived or its restore was not requested.
+	ErrCodeObjectRestoreNotRequested = "ObjectRestoreNotRequested"
+)
+
+// RestoreStatus is the status of the restore of an archived object, as
+// returned in the Restore header of HeadObject and GetObject, e.g.
+//
+//	ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"
+type RestoreStatus struct {
+	// The restore is in progress, the object cannot be read yet.
+	Ongoing bool
+
+	// Time the restored copy of the object expires, zero while the restore
+	// is in progress.
+	ExpiryDate time.Time
+}
+
+// ParseRestoreStatus parses the Restore header of an object.
+func ParseRestoreStatus(header string) (RestoreStatus, error) {
+	var status RestoreStatus
+	var ongoing bool
+
+	rest := strings.TrimSpace(header)
+	for len(rest) != 0 {
+		// Values are quoted and may contain commas, e.g. the expiry date
+		i := strings.Index(rest, `="`)
+		if i < 0 {
+			return RestoreStatus{}, awserr.New(ErrCodeInvalidRestoreStatus, "invalid restore status "+header, nil)
+		}
+		name := strings.TrimSpace(rest[:i])
+		rest = rest[i+2:]
+
+		j := strings.Index(rest, `"`)
+		if j < 0 {
+			return RestoreStatus{}, awserr.New(ErrCodeInvalidRestoreStatus, "invalid restore status "+header, nil)
+		}
+		value := rest[:j]
+		rest = strings.TrimPrefix(strings.TrimSpace(rest[j+1:]), ",")
+		rest = strings.TrimSpace(rest)
+
+		switch strings.ToLower(name) {
+		case "ongoing-request":
+			ongoing = true
+			status.Ongoing = value == "true"
+		case "expiry-date":
+			t, err := time.Parse(time.RFC1123, value)
+			if err != nil {
+				return RestoreStatus{}, awserr.New(ErrCodeInvalidRestoreStatus, "invalid restore expiry date "+value, err)
+			}
+			status.ExpiryDate = t
+		}
+	}
+
+	if !ongoing {
+		return RestoreStatus{}, awserr.New(ErrCodeInvalidRestoreStatus, "restore status without ongoing-request "+header, nil)
+	}
+	return status, nil
+}
+
+// objectRestored returns if the restore of the object completed.
+func objectRestored(out *HeadObjectOutput, err error) bool {
+	if err != nil {
+		return false
+	}
+	status, err := ParseRestoreStatus(aws.StringValue(out.Restore))
+	return err == nil && !status.Ongoing
+}
+
+// objectRestoreStatusInvalid returns if the object has no, or an invalid,
+// Restore header.
+func objectRestoreStatusInvalid(out *HeadObjectOutput, err error) bool {
+	if err != nil {
+		return false
+	}
+	_, err = ParseRestoreStatus(aws.StringValue(out.Restore))
+	return err != nil
+}
@@ -0,0 +1,141 @@
+package s3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/awstesting/unit"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+func TestParseRestoreStatus(t *testing.T) {
+	cases := map[string]struct {
+		Header    string
+		Expect    s3.RestoreStatus
+		ExpectErr bool
+	}{
+		"ongoing": {
+			Header: `ongoing-request="true"`,
+			Expect: s3.RestoreStatus{Ongoing: true},
+		},
+		"restored": {
+			Header: `ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`,
+			Expect: s3.RestoreStatus{ExpiryDate: time.Date(2012, 12, 21, 0, 0, 0, 0, time.UTC)},
+		},
+		"missing ongoing-request": {
+			Header:    `expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`,
+			ExpectErr: true,
+		},
+		"invalid date": {
+			Header:    `ongoing-request="false", expiry-date="tomorrow"`,
+			ExpectErr: true,
+		},
+		"unquoted": {
+			Header:    `ongoing-request=false`,
+			ExpectErr: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := s3.ParseRestoreStatus(c.Header)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect.Ongoing, status.Ongoing; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			if e, a := c.Expect.ExpiryDate, status.ExpiryDate; !e.Equal(a) {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func newRestoreWaiterClient(headers []string) (*s3.S3, *int) {
+	svc := s3.New(unit.Session, &aws.Config{Region: aws.String("us-south")})
+
+	attempts := 0
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		header := http.Header{}
+		if restore := headers[attempts]; len(restore) != 0 {
+			header.Set("x-amz-restore", restore)
+		}
+		attempts++
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Header:     header,
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		}
+	})
+	return svc, &attempts
+}
+
+func TestWaitUntilObjectRestored(t *testing.T) {
+	svc, attempts := newRestoreWaiterClient([]string{
+		`ongoing-request="true"`,
+		`ongoing-request="true"`,
+		`ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`,
+	})
+
+	err := svc.WaitUntilObjectRestoredWithContext(aws.BackgroundContext(),
+		&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")},
+		request.WithWaiterDelay(request.ConstantWaiterDelay(0)))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 3, *attempts; e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
+
+func TestWaitUntilObjectRestored_MaxAttempts(t *testing.T) {
+	svc, attempts := newRestoreWaiterClient([]string{
+		`ongoing-request="true"`,
+		`ongoing-request="true"`,
+	})
+
+	err := svc.WaitUntilObjectRestoredWithContext(aws.BackgroundContext(),
+		&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")},
+		request.WithWaiterDelay(request.ConstantWaiterDelay(0)),
+		request.WithWaiterMaxAttempts(2))
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := request.WaiterResourceNotReadyErrorCode, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 2, *attempts; e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
+
+func TestWaitUntilObjectRestored_NotRequested(t *testing.T) {
+	svc, attempts := newRestoreWaiterClient([]string{""})
+
+	err := svc.WaitUntilObjectRestoredWithContext(aws.BackgroundContext(),
+		&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")},
+		request.WithWaiterDelay(request.ConstantWaiterDelay(0)))
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := request.WaiterResourceNotReadyErrorCode, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 1, *attempts; e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
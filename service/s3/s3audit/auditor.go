@@ -0,0 +1,285 @@
+package s3audit
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3retention"
+)
+
+// Audit checks.
+const (
+	CheckVersioning        = "Versioning"
+	CheckPublicAccessBlock = "PublicAccessBlock"
+	CheckPublicACLGrants   = "PublicACLGrants"
+	CheckLogging           = "Logging"
+	CheckObjectLock        = "ObjectLock"
+	CheckRetention         = "Retention"
+)
+
+// Status is the outcome of an audit check.
+type Status string
+
+const (
+	// StatusPass is the status of checks the bucket passed.
+	StatusPass Status = "PASS"
+
+	// StatusFail is the status of checks the bucket failed.
+	StatusFail Status = "FAIL"
+
+	// StatusError is the status of checks that could not be run, because the
+	// bucket setting could not be read.
+	StatusError Status = "ERROR"
+)
+
+// Error codes of bucket settings that are not configured. The checks of
+// unconfigured settings fail rather than error.
+var notConfiguredErrorCodes = map[string]struct{}{
+	"NoSuchPublicAccessBlockConfiguration": {},
+	"ObjectLockConfigurationNotFoundError": {},
+}
+
+// Grantee URIs of public ACL grants.
+var publicGranteeURIs = map[string]struct{}{
+	"http://acs.amazonaws.com/groups/global/AllUsers":           {},
+	"http://acs.amazonaws.com/groups/global/AuthenticatedUsers": {},
+}
+
+// CheckResult is the outcome of an audit check.
+type CheckResult struct {
+	Check   string `json:"check"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+}
+
+// Report is the outcome of the audit of a bucket.
+type Report struct {
+	Bucket string        `json:"bucket"`
+	Time   time.Time     `json:"time"`
+	Checks []CheckResult `json:"checks"`
+}
+
+// Passed returns true if the bucket passed every check.
+func (r *Report) Passed() bool {
+	return len(r.Failures()) == 0
+}
+
+// Failures returns the checks the bucket failed or that could not be run.
+func (r *Report) Failures() []CheckResult {
+	var failures []CheckResult
+	for _, c := range r.Checks {
+		if c.Status != StatusPass {
+			failures = append(failures, c)
+		}
+	}
+	return failures
+}
+
+// Auditor audits buckets against policies.
+type Auditor struct {
+	// The client to use when reading bucket settings.
+	S3 s3iface.S3API
+
+	// List of request options that will be passed down to individual API
+	// operation requests made by the Auditor.
+	RequestOptions []request.Option
+}
+
+// NewAuditor creates a new Auditor instance to audit buckets with the
+// client. Pass in additional functional options to customize the Auditor's
+// behavior.
+func NewAuditor(svc s3iface.S3API, options ...func(*Auditor)) *Auditor {
+	a := &Auditor{S3: svc}
+
+	for _, option := range options {
+		option(a)
+	}
+
+	return a
+}
+
+// Audit runs the checks the policy requires against the bucket. Bucket
+// settings that cannot be read are reported as checks with StatusError, the
+// returned error is only set if the context is canceled.
+func (a *Auditor) Audit(ctx aws.Context, bucket string, policy Policy) (*Report, error) {
+	report := &Report{Bucket: bucket, Time: time.Now().UTC()}
+
+	checks := []struct {
+		enabled bool
+		check   string
+		fn      func(aws.Context, string, Policy) (bool, string, error)
+	}{
+		{policy.RequireVersioning, CheckVersioning, a.checkVersioning},
+		{policy.RequirePublicAccessBlock, CheckPublicAccessBlock, a.checkPublicAccessBlock},
+		{policy.ForbidPublicACLGrants, CheckPublicACLGrants, a.checkPublicACLGrants},
+		{policy.checkLogging(), CheckLogging, a.checkLogging},
+		{policy.checkObjectLock(), CheckObjectLock, a.checkObjectLock},
+		{policy.checkRetention(), CheckRetention, a.checkRetention},
+	}
+
+	for _, c := range checks {
+		if !c.enabled {
+			continue
+		}
+
+		result := CheckResult{Check: c.check}
+		pass, msg, err := c.fn(ctx, bucket, policy)
+		switch {
+		case err != nil && isNotConfigured(err):
+			result.Status, result.Message = StatusFail, "not configured"
+		case err != nil:
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			result.Status, result.Message = StatusError, err.Error()
+		case pass:
+			result.Status, result.Message = StatusPass, msg
+		default:
+			result.Status, result.Message = StatusFail, msg
+		}
+		report.Checks = append(report.Checks, result)
+	}
+
+	return report, nil
+}
+
+func (a *Auditor) checkVersioning(ctx aws.Context, bucket string, policy Policy) (bool, string, error) {
+	out, err := a.S3.GetBucketVersioningWithContext(ctx,
+		&s3.GetBucketVersioningInput{Bucket: aws.String(bucket)}, a.RequestOptions...)
+	if err != nil {
+		return false, "", err
+	}
+
+	status := aws.StringValue(out.Status)
+	if status != s3.BucketVersioningStatusEnabled {
+		if len(status) == 0 {
+			status = "never enabled"
+		}
+		return false, "versioning is " + status, nil
+	}
+	return true, "versioning is enabled", nil
+}
+
+func (a *Auditor) checkPublicAccessBlock(ctx aws.Context, bucket string, policy Policy) (bool, string, error) {
+	out, err := a.S3.GetPublicAccessBlockWithContext(ctx,
+		&s3.GetPublicAccessBlockInput{Bucket: aws.String(bucket)}, a.RequestOptions...)
+	if err != nil {
+		return false, "", err
+	}
+
+	cfg := out.PublicAccessBlockConfiguration
+	if cfg == nil {
+		return false, "not configured", nil
+	}
+	var missing []string
+	if !aws.BoolValue(cfg.BlockPublicAcls) {
+		missing = append(missing, "BlockPublicAcls")
+	}
+	if !aws.BoolValue(cfg.IgnorePublicAcls) {
+		missing = append(missing, "IgnorePublicAcls")
+	}
+	if len(missing) > 0 {
+		return false, strings.Join(missing, " and ") + " not enabled", nil
+	}
+	return true, "public ACLs are blocked and ignored", nil
+}
+
+func (a *Auditor) checkPublicACLGrants(ctx aws.Context, bucket string, policy Policy) (bool, string, error) {
+	out, err := a.S3.GetBucketAclWithContext(ctx,
+		&s3.GetBucketAclInput{Bucket: aws.String(bucket)}, a.RequestOptions...)
+	if err != nil {
+		return false, "", err
+	}
+
+	var public []string
+	for _, grant := range out.Grants {
+		if grant == nil || grant.Grantee == nil {
+			continue
+		}
+		uri := aws.StringValue(grant.Grantee.URI)
+		if _, ok := publicGranteeURIs[uri]; ok {
+			public = append(public, aws.StringValue(grant.Permission)+" to "+uri)
+		}
+	}
+	if len(public) > 0 {
+		return false, "public grants: " + strings.Join(public, ", "), nil
+	}
+	return true, "no public grants", nil
+}
+
+func (a *Auditor) checkLogging(ctx aws.Context, bucket string, policy Policy) (bool, string, error) {
+	out, err := a.S3.GetBucketLoggingWithContext(ctx,
+		&s3.GetBucketLoggingInput{Bucket: aws.String(bucket)}, a.RequestOptions...)
+	if err != nil {
+		return false, "", err
+	}
+
+	if out.LoggingEnabled == nil || len(aws.StringValue(out.LoggingEnabled.TargetBucket)) == 0 {
+		return false, "logging is not enabled", nil
+	}
+	target := aws.StringValue(out.LoggingEnabled.TargetBucket)
+	if len(policy.LoggingTargetBucket) != 0 && target != policy.LoggingTargetBucket {
+		return false, fmt.Sprintf("logging target bucket is %s, expected %s", target, policy.LoggingTargetBucket), nil
+	}
+	return true, "logging to " + target, nil
+}
+
+func (a *Auditor) checkObjectLock(ctx aws.Context, bucket string, policy Policy) (bool, string, error) {
+	out, err := a.S3.GetObjectLockConfigurationWithContext(ctx,
+		&s3.GetObjectLockConfigurationInput{Bucket: aws.String(bucket)}, a.RequestOptions...)
+	if err != nil {
+		return false, "", err
+	}
+
+	cfg := out.ObjectLockConfiguration
+	if cfg == nil || aws.StringValue(cfg.ObjectLockEnabled) != s3.ObjectLockEnabledEnabled {
+		return false, "Object Lock is not enabled", nil
+	}
+
+	var mode string
+	var days int64
+	if cfg.Rule != nil && cfg.Rule.DefaultRetention != nil {
+		mode = aws.StringValue(cfg.Rule.DefaultRetention.Mode)
+		days = aws.Int64Value(cfg.Rule.DefaultRetention.Days) + 365*aws.Int64Value(cfg.Rule.DefaultRetention.Years)
+	}
+	if len(policy.ObjectLockMode) != 0 && mode != policy.ObjectLockMode {
+		return false, fmt.Sprintf("default retention mode is %q, expected %s", mode, policy.ObjectLockMode), nil
+	}
+	if days < policy.MinimumObjectLockDays {
+		return false, fmt.Sprintf("default retention is %d days, expected at least %d", days, policy.MinimumObjectLockDays), nil
+	}
+	return true, fmt.Sprintf("Object Lock is enabled, default retention %s %d days", mode, days), nil
+}
+
+func (a *Auditor) checkRetention(ctx aws.Context, bucket string, policy Policy) (bool, string, error) {
+	out, err := a.S3.GetBucketProtectionConfigurationWithContext(ctx,
+		&s3.GetBucketProtectionConfigurationInput{Bucket: aws.String(bucket)}, a.RequestOptions...)
+	if err != nil {
+		return false, "", err
+	}
+
+	retention := s3retention.PolicyFromProtectionConfiguration(out.ProtectionConfiguration)
+	if !retention.Enabled {
+		return false, "retention is not enabled", nil
+	}
+	if retention.MinimumDays < policy.MinimumRetentionDays {
+		return false, fmt.Sprintf("minimum retention is %d days, expected at least %d",
+			retention.MinimumDays, policy.MinimumRetentionDays), nil
+	}
+	return true, fmt.Sprintf("retention is enabled, minimum %d days", retention.MinimumDays), nil
+}
+
+func isNotConfigured(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	_, ok = notConfiguredErrorCodes[aerr.Code()]
+	return ok
+}
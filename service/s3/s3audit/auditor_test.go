@@ -0,0 +1,193 @@
+package s3audit
+
+import (
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+)
+
+type mockClient struct {
+	s3iface.S3API
+
+	versioning        *s3.GetBucketVersioningOutput
+	publicAccessBlock *s3.GetPublicAccessBlockOutput
+	publicAccessErr   error
+	acl               *s3.GetBucketAclOutput
+	aclErr            error
+	logging           *s3.GetBucketLoggingOutput
+	objectLock        *s3.GetObjectLockConfigurationOutput
+	protection        *s3.GetBucketProtectionConfigurationOutput
+}
+
+func (c *mockClient) GetBucketVersioningWithContext(aws.Context, *s3.GetBucketVersioningInput, ...request.Option) (*s3.GetBucketVersioningOutput, error) {
+	return c.versioning, nil
+}
+
+func (c *mockClient) GetPublicAccessBlockWithContext(aws.Context, *s3.GetPublicAccessBlockInput, ...request.Option) (*s3.GetPublicAccessBlockOutput, error) {
+	return c.publicAccessBlock, c.publicAccessErr
+}
+
+func (c *mockClient) GetBucketAclWithContext(aws.Context, *s3.GetBucketAclInput, ...request.Option) (*s3.GetBucketAclOutput, error) {
+	return c.acl, c.aclErr
+}
+
+func (c *mockClient) GetBucketLoggingWithContext(aws.Context, *s3.GetBucketLoggingInput, ...request.Option) (*s3.GetBucketLoggingOutput, error) {
+	return c.logging, nil
+}
+
+func (c *mockClient) GetObjectLockConfigurationWithContext(aws.Context, *s3.GetObjectLockConfigurationInput, ...request.Option) (*s3.GetObjectLockConfigurationOutput, error) {
+	return c.objectLock, nil
+}
+
+func (c *mockClient) GetBucketProtectionConfigurationWithContext(aws.Context, *s3.GetBucketProtectionConfigurationInput, ...request.Option) (*s3.GetBucketProtectionConfigurationOutput, error) {
+	return c.protection, nil
+}
+
+func compliantClient() *mockClient {
+	return &mockClient{
+		versioning: &s3.GetBucketVersioningOutput{Status: aws.String(s3.BucketVersioningStatusEnabled)},
+		publicAccessBlock: &s3.GetPublicAccessBlockOutput{
+			PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+				BlockPublicAcls:  aws.Bool(true),
+				IgnorePublicAcls: aws.Bool(true),
+			},
+		},
+		acl: &s3.GetBucketAclOutput{
+			Grants: []*s3.Grant{{
+				Grantee:    &s3.Grantee{Type: aws.String(s3.TypeCanonicalUser), ID: aws.String("owner")},
+				Permission: aws.String(s3.PermissionFullControl),
+			}},
+		},
+		logging: &s3.GetBucketLoggingOutput{
+			LoggingEnabled: &s3.LoggingEnabled{TargetBucket: aws.String("logs"), TargetPrefix: aws.String("bucket/")},
+		},
+		objectLock: &s3.GetObjectLockConfigurationOutput{
+			ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+				ObjectLockEnabled: aws.String(s3.ObjectLockEnabledEnabled),
+				Rule: &s3.ObjectLockRule{DefaultRetention: &s3.DefaultRetention{
+					Mode:  aws.String(s3.ObjectLockRetentionModeCompliance),
+					Years: aws.Int64(1),
+				}},
+			},
+		},
+		protection: &s3.GetBucketProtectionConfigurationOutput{
+			ProtectionConfiguration: &s3.ProtectionConfiguration{
+				DefaultRetention: &s3.BucketProtectionDefaultRetention{Days: aws.Int64(365)},
+				MinimumRetention: &s3.BucketProtectionMinimumRetention{Days: aws.Int64(365)},
+				MaximumRetention: &s3.BucketProtectionMaximumRetention{Days: aws.Int64(3650)},
+				Status:           aws.String(s3.BucketProtectionStatusRetention),
+			},
+		},
+	}
+}
+
+var strictPolicy = Policy{
+	RequireVersioning:        true,
+	RequirePublicAccessBlock: true,
+	ForbidPublicACLGrants:    true,
+	LoggingTargetBucket:      "logs",
+	ObjectLockMode:           s3.ObjectLockRetentionModeCompliance,
+	MinimumObjectLockDays:    365,
+	MinimumRetentionDays:     365,
+}
+
+func TestAudit_Pass(t *testing.T) {
+	report, err := NewAuditor(compliantClient()).Audit(aws.BackgroundContext(), "bucket", strictPolicy)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 6, len(report.Checks); e != a {
+		t.Fatalf("expect %v checks, got %v", e, a)
+	}
+	if !report.Passed() {
+		t.Errorf("expect report to pass, got %v", report.Failures())
+	}
+}
+
+func TestAudit_Fail(t *testing.T) {
+	client := compliantClient()
+	client.versioning = &s3.GetBucketVersioningOutput{Status: aws.String(s3.BucketVersioningStatusSuspended)}
+	client.publicAccessErr = awserr.New("NoSuchPublicAccessBlockConfiguration", "not found", nil)
+	client.acl.Grants = append(client.acl.Grants, &s3.Grant{
+		Grantee:    &s3.Grantee{Type: aws.String(s3.TypeGroup), URI: aws.String("http://acs.amazonaws.com/groups/global/AllUsers")},
+		Permission: aws.String(s3.PermissionRead),
+	})
+	client.logging = &s3.GetBucketLoggingOutput{
+		LoggingEnabled: &s3.LoggingEnabled{TargetBucket: aws.String("other"), TargetPrefix: aws.String("")},
+	}
+	client.objectLock.ObjectLockConfiguration.Rule.DefaultRetention = &s3.DefaultRetention{
+		Mode: aws.String(s3.ObjectLockRetentionModeGovernance),
+		Days: aws.Int64(30),
+	}
+	client.protection.ProtectionConfiguration.MinimumRetention.Days = aws.Int64(30)
+
+	report, err := NewAuditor(client).Audit(aws.BackgroundContext(), "bucket", strictPolicy)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := map[string]string{
+		CheckVersioning:        "versioning is Suspended",
+		CheckPublicAccessBlock: "not configured",
+		CheckPublicACLGrants:   "public grants: READ to http://acs.amazonaws.com/groups/global/AllUsers",
+		CheckLogging:           "logging target bucket is other, expected logs",
+		CheckObjectLock:        `default retention mode is "GOVERNANCE", expected COMPLIANCE`,
+		CheckRetention:         "minimum retention is 30 days, expected at least 365",
+	}
+	if e, a := len(expect), len(report.Failures()); e != a {
+		t.Fatalf("expect %v failures, got %v", e, a)
+	}
+	for _, c := range report.Checks {
+		if e, a := StatusFail, c.Status; e != a {
+			t.Errorf("%s, expect %v, got %v", c.Check, e, a)
+		}
+		if e, a := expect[c.Check], c.Message; e != a {
+			t.Errorf("%s, expect %v, got %v", c.Check, e, a)
+		}
+	}
+}
+
+func TestAudit_OnlyPolicyChecks(t *testing.T) {
+	client := compliantClient()
+	client.aclErr = awserr.New("AccessDenied", "access denied", nil)
+
+	report, err := NewAuditor(client).Audit(aws.BackgroundContext(), "bucket", Policy{
+		RequireVersioning:     true,
+		ForbidPublicACLGrants: true,
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 2, len(report.Checks); e != a {
+		t.Fatalf("expect %v checks, got %v", e, a)
+	}
+	if e, a := StatusPass, report.Checks[0].Status; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := StatusError, report.Checks[1].Status; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if report.Passed() {
+		t.Errorf("expect report not to pass")
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	p, err := ParsePolicy([]byte(`{"require_versioning": true, "logging_target_bucket": "logs", "minimum_retention_days": 30}`))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := (Policy{RequireVersioning: true, LoggingTargetBucket: "logs", MinimumRetentionDays: 30}), p; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	if _, err := ParsePolicy([]byte(`{`)); err == nil {
+		t.Errorf("expect error, got none")
+	}
+}
@@ -0,0 +1,23 @@
+// Package s3audit audits the versioning, public access, logging, Object Lock
+// and Immutable Object Storage retention settings of buckets against a
+// declarative policy.
+//
+//	policy := s3audit.Policy{
+//	    RequireVersioning:        true,
+//	    RequirePublicAccessBlock: true,
+//	    ForbidPublicACLGrants:    true,
+//	    RequireLogging:           true,
+//	    MinimumRetentionDays:     365,
+//	}
+//
+//	report, err := s3audit.NewAuditor(s3.New(sess)).Audit(ctx, "my-bucket", policy)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, check := range report.Failures() {
+//	    fmt.Println(check.Check, check.Status, check.Message)
+//	}
+//
+// Policies can be loaded from JSON with LoadPolicy. The auditBucket example
+// command audits buckets from the command line.
+package s3audit
@@ -0,0 +1,81 @@
+package s3audit
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+)
+
+// ErrCodeInvalidPolicy is the error code returned when an audit policy
+// cannot be loaded.
+const ErrCodeInvalidPolicy = "InvalidAuditPolicy"
+
+// Policy is the declarative policy buckets are audited against. Only the
+// checks the policy requires are run.
+type Policy struct {
+	// Versioning must be enabled.
+	RequireVersioning bool `json:"require_versioning,omitempty"`
+
+	// The public access block must block and ignore public ACLs.
+	RequirePublicAccessBlock bool `json:"require_public_access_block,omitempty"`
+
+	// The bucket ACL must not grant access to all users, or all
+	// authenticated users.
+	ForbidPublicACLGrants bool `json:"forbid_public_acl_grants,omitempty"`
+
+	// Access logging must be enabled.
+	RequireLogging bool `json:"require_logging,omitempty"`
+
+	// Access logs must be written to the bucket, if set. Implies
+	// RequireLogging.
+	LoggingTargetBucket string `json:"logging_target_bucket,omitempty"`
+
+	// Object Lock must be enabled.
+	RequireObjectLock bool `json:"require_object_lock,omitempty"`
+
+	// Object Lock default retention mode, GOVERNANCE or COMPLIANCE, if set.
+	// Implies RequireObjectLock.
+	ObjectLockMode string `json:"object_lock_mode,omitempty"`
+
+	// Shortest Object Lock default retention, in days, if set. Implies
+	// RequireObjectLock.
+	MinimumObjectLockDays int64 `json:"minimum_object_lock_days,omitempty"`
+
+	// Immutable Object Storage retention must be enabled.
+	RequireRetention bool `json:"require_retention,omitempty"`
+
+	// Shortest Immutable Object Storage minimum retention, in days, if set.
+	// Implies RequireRetention.
+	MinimumRetentionDays int64 `json:"minimum_retention_days,omitempty"`
+}
+
+// ParsePolicy parses a JSON audit policy.
+func ParsePolicy(data []byte) (Policy, error) {
+	var p Policy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return Policy{}, awserr.New(ErrCodeInvalidPolicy, "failed to parse audit policy", err)
+	}
+	return p, nil
+}
+
+// LoadPolicy loads a JSON audit policy from the file.
+func LoadPolicy(filename string) (Policy, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return Policy{}, awserr.New(ErrCodeInvalidPolicy, "failed to read audit policy "+filename, err)
+	}
+	return ParsePolicy(data)
+}
+
+func (p Policy) checkLogging() bool {
+	return p.RequireLogging || len(p.LoggingTargetBucket) != 0
+}
+
+func (p Policy) checkObjectLock() bool {
+	return p.RequireObjectLock || len(p.ObjectLockMode) != 0 || p.MinimumObjectLockDays > 0
+}
+
+func (p Policy) checkRetention() bool {
+	return p.RequireRetention || p.MinimumRetentionDays > 0
+}
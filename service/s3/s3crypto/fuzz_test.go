@@ -0,0 +1,34 @@
+package s3crypto
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func FuzzEnvelope_UnmarshalJSON(f *testing.F) {
+	f.Add([]byte(`{"x-amz-iv":"iv","x-amz-key-v2":"key","x-amz-matdesc":"{\"aws:x-amz-cek-alg\":\"AES/GCM/NoPadding\"}","x-amz-wrap-alg":"kms+context","x-amz-cek-alg":"AES/GCM/NoPadding","x-amz-tag-len":"128","x-amz-unencrypted-content-length":"1024"}`))
+	f.Add([]byte(`{"x-amz-iv":"iv","x-amz-key-v2":"key","x-amz-tag-len":128,"x-amz-unencrypted-content-length":1024}`))
+	f.Add([]byte(`{"x-amz-tag-len":null,"x-amz-unencrypted-content-length":null}`))
+	f.Add([]byte(`{"x-amz-tag-len":1.5}`))
+	f.Add([]byte(`{}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var e Envelope
+		if err := json.Unmarshal(data, &e); err != nil {
+			return
+		}
+
+		b, err := json.Marshal(e)
+		if err != nil {
+			t.Fatalf("expect envelope to marshal, got %v", err)
+		}
+		var actual Envelope
+		if err := json.Unmarshal(b, &actual); err != nil {
+			t.Fatalf("expect marshaled envelope to unmarshal, got %v", err)
+		}
+		if !reflect.DeepEqual(e, actual) {
+			t.Errorf("expect %v, got %v", e, actual)
+		}
+	})
+}
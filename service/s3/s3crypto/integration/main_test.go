@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"crypto/rand"
 	"flag"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
@@ -57,12 +58,20 @@ func TestMain(m *testing.M) {
 		log.Fatal("kms cmk key id must be provided")
 	}
 
-	config.Session = session.Must(session.NewSession(&aws.Config{Region: &config.Region}))
+	// IBM COS SDK Code -- START
+	config.Session = integration.Session.Copy(&aws.Config{Region: &config.Region})
+	// IBM COS SDK Code -- END
 
 	config.Clients.KMS = kms.New(config.Session)
 	config.Clients.S3 = s3.New(config.Session)
 
-	m.Run()
+	// IBM COS SDK Code -- START
+	result := m.Run()
+	if err := integration.StopRecording(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	os.Exit(result)
+	// IBM COS SDK Code -- END
 }
 
 func TestEncryptionV1_WithV2Interop(t *testing.T) {
@@ -0,0 +1,103 @@
+package s3iface_test
+
+import (
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+)
+
+func TestFakeS3(t *testing.T) {
+	fake := &s3iface.FakeS3{
+		HeadObjectWithContextFunc: func(_ aws.Context, input *s3.HeadObjectInput, _ ...request.Option) (*s3.HeadObjectOutput, error) {
+			return &s3.HeadObjectOutput{ContentLength: aws.Int64(10)}, nil
+		},
+	}
+
+	var svc s3iface.S3API = fake
+	out, err := svc.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int64(10), aws.Int64Value(out.ContentLength); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	_, err = svc.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != s3iface.ErrCodeFakeNotImplemented {
+		t.Errorf("expect %v error, got %v", s3iface.ErrCodeFakeNotImplemented, err)
+	}
+
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if aerr, ok := req.Send().(awserr.Error); !ok || aerr.Code() != s3iface.ErrCodeFakeNotImplemented {
+		t.Errorf("expect %v error, got %v", s3iface.ErrCodeFakeNotImplemented, aerr)
+	}
+
+	if e, a := 3, len(fake.Calls()); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+	calls := fake.CallsTo("HeadObject")
+	if e, a := 1, len(calls); e != a {
+		t.Fatalf("expect %v calls, got %v", e, a)
+	}
+	if e, a := "key", aws.StringValue(calls[0].Args[0].(*s3.HeadObjectInput).Key); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestFakeS3_Pages(t *testing.T) {
+	fake := &s3iface.FakeS3{
+		ListObjectsV2Func: func(input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+			return &s3.ListObjectsV2Output{KeyCount: aws.Int64(2)}, nil
+		},
+	}
+
+	var pages int
+	err := fake.ListObjectsV2PagesWithContext(aws.BackgroundContext(), &s3.ListObjectsV2Input{Bucket: aws.String("bucket")},
+		func(out *s3.ListObjectsV2Output, last bool) bool {
+			pages++
+			if !last {
+				t.Errorf("expect last page")
+			}
+			return true
+		})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, pages; e != a {
+		t.Errorf("expect %v pages, got %v", e, a)
+	}
+
+	fake.Reset()
+	if err := fake.WaitUntilObjectExists(&s3.HeadObjectInput{}); err == nil {
+		t.Errorf("expect error")
+	}
+	if e, a := "WaitUntilObjectExists", fake.Calls()[0].Method; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestFakeS3_WaitUntilObjectRestored(t *testing.T) {
+	var waited *s3.HeadObjectInput
+	fake := &s3iface.FakeS3{
+		WaitUntilObjectRestoredWithContextFunc: func(_ aws.Context, input *s3.HeadObjectInput, _ ...request.WaiterOption) error {
+			waited = input
+			return nil
+		},
+	}
+
+	var svc s3iface.S3API = fake
+	input := &s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")}
+	if err := svc.WaitUntilObjectRestored(input); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := input, waited; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 1, len(fake.CallsTo("WaitUntilObjectRestored")); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
@@ -0,0 +1,144 @@
+package s3lifecycle
+
+import (
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// IBM COS archive storage classes lifecycle rules can transition objects to.
+const (
+	// StorageClassArchive restores archived objects within 12 hours.
+	StorageClassArchive = s3.TransitionStorageClassGlacier
+
+	// StorageClassAcceleratedArchive restores archived objects within 2 hours.
+	StorageClassAcceleratedArchive = s3.TransitionStorageClassAccelerated
+)
+
+// Builder builds a lifecycle configuration.
+type Builder struct {
+	rules []*RuleBuilder
+}
+
+// NewBuilder returns a Builder of a lifecycle configuration without rules.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// Rule adds an enabled rule with the ID to the configuration, and returns it.
+func (b *Builder) Rule(id string) *RuleBuilder {
+	r := &RuleBuilder{rule: &s3.LifecycleRule{
+		ID:     aws.String(id),
+		Filter: &s3.LifecycleRuleFilter{},
+		Status: aws.String(s3.ExpirationStatusEnabled),
+	}}
+	b.rules = append(b.rules, r)
+	return r
+}
+
+// Build returns the lifecycle configuration, or an error listing the
+// problems found by Validate.
+func (b *Builder) Build() (*s3.LifecycleConfiguration, error) {
+	cfg := &s3.LifecycleConfiguration{}
+	for _, r := range b.rules {
+		cfg.Rules = append(cfg.Rules, r.rule)
+	}
+
+	if err := Validate(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// RuleBuilder builds a lifecycle rule.
+type RuleBuilder struct {
+	rule *s3.LifecycleRule
+}
+
+// Prefix limits the rule to objects whose key starts with the prefix.
+func (r *RuleBuilder) Prefix(prefix string) *RuleBuilder {
+	r.rule.Filter.Prefix = aws.String(prefix)
+	return r
+}
+
+// Disabled disables the rule.
+func (r *RuleBuilder) Disabled() *RuleBuilder {
+	r.rule.Status = aws.String(s3.ExpirationStatusDisabled)
+	return r
+}
+
+// TransitionAfterDays transitions objects to the archive storage class the
+// number of days after their creation.
+func (r *RuleBuilder) TransitionAfterDays(days int64, storageClass string) *RuleBuilder {
+	r.rule.Transitions = append(r.rule.Transitions, &s3.Transition{
+		Days:         aws.Int64(days),
+		StorageClass: aws.String(storageClass),
+	})
+	return r
+}
+
+// TransitionOnDate transitions objects to the archive storage class on the
+// date. The date must be midnight UTC.
+func (r *RuleBuilder) TransitionOnDate(date time.Time, storageClass string) *RuleBuilder {
+	r.rule.Transitions = append(r.rule.Transitions, &s3.Transition{
+		Date:         aws.Time(date),
+		StorageClass: aws.String(storageClass),
+	})
+	return r
+}
+
+// ExpireAfterDays expires objects the number of days after their creation.
+func (r *RuleBuilder) ExpireAfterDays(days int64) *RuleBuilder {
+	r.expiration().Days = aws.Int64(days)
+	return r
+}
+
+// ExpireOnDate expires objects on the date. The date must be midnight UTC.
+func (r *RuleBuilder) ExpireOnDate(date time.Time) *RuleBuilder {
+	r.expiration().Date = aws.Time(date)
+	return r
+}
+
+// ExpireDeleteMarkers removes delete markers without noncurrent versions.
+func (r *RuleBuilder) ExpireDeleteMarkers() *RuleBuilder {
+	r.expiration().ExpiredObjectDeleteMarker = aws.Bool(true)
+	return r
+}
+
+// ExpireNoncurrentVersionsAfterDays permanently deletes object versions the
+// number of days after they became noncurrent.
+func (r *RuleBuilder) ExpireNoncurrentVersionsAfterDays(days int64) *RuleBuilder {
+	r.noncurrentVersionExpiration().NoncurrentDays = aws.Int64(days)
+	return r
+}
+
+// KeepNewerNoncurrentVersions retains the number of newest noncurrent
+// versions of objects from ExpireNoncurrentVersionsAfterDays.
+func (r *RuleBuilder) KeepNewerNoncurrentVersions(n int64) *RuleBuilder {
+	r.noncurrentVersionExpiration().NewerNoncurrentVersions = aws.Int64(n)
+	return r
+}
+
+// AbortIncompleteMultipartUploadAfterDays aborts multipart uploads the
+// number of days after they were initiated.
+func (r *RuleBuilder) AbortIncompleteMultipartUploadAfterDays(days int64) *RuleBuilder {
+	r.rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+		DaysAfterInitiation: aws.Int64(days),
+	}
+	return r
+}
+
+func (r *RuleBuilder) expiration() *s3.LifecycleExpiration {
+	if r.rule.Expiration == nil {
+		r.rule.Expiration = &s3.LifecycleExpiration{}
+	}
+	return r.rule.Expiration
+}
+
+func (r *RuleBuilder) noncurrentVersionExpiration() *s3.NoncurrentVersionExpiration {
+	if r.rule.NoncurrentVersionExpiration == nil {
+		r.rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{}
+	}
+	return r.rule.NoncurrentVersionExpiration
+}
@@ -0,0 +1,33 @@
+// Package s3lifecycle provides a builder and validator for IBM COS bucket
+// lifecycle configurations, and a local evaluator predicting the actions the
+// lifecycle rules will take on objects.
+//
+// Building a lifecycle configuration:
+//
+//	b := s3lifecycle.NewBuilder()
+//	b.Rule("archive-logs").Prefix("logs/").
+//	    TransitionAfterDays(30, s3lifecycle.StorageClassArchive).
+//	    ExpireAfterDays(365)
+//	b.Rule("tmp").Prefix("tmp/").
+//	    ExpireAfterDays(1).
+//	    AbortIncompleteMultipartUploadAfterDays(1)
+//
+//	cfg, err := b.Build()
+//	if err != nil {
+//	    return err
+//	}
+//	_, err = svc.PutBucketLifecycleConfiguration(&s3.PutBucketLifecycleConfigurationInput{
+//	    Bucket:                 aws.String("my-bucket"),
+//	    LifecycleConfiguration: cfg,
+//	})
+//
+// Predicting the actions on the objects of a bucket:
+//
+//	out, err := svc.ListObjectVersions(&s3.ListObjectVersionsInput{Bucket: aws.String("my-bucket")})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, p := range s3lifecycle.Evaluate(cfg, out) {
+//	    fmt.Println(p.Date, p.Action, p.Key, p.VersionID, p.RuleID)
+//	}
+package s3lifecycle
@@ -0,0 +1,374 @@
+package s3lifecycle
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// Action is a lifecycle action taken on an object version.
+type Action int
+
+const (
+	// ActionTransition transitions the current version of an object to an
+	// archive storage class.
+	ActionTransition Action = iota
+
+	// ActionExpire expires the current version of an object. In versioned
+	// buckets the version becomes noncurrent behind a delete marker.
+	ActionExpire
+
+	// ActionExpireNoncurrentVersion permanently deletes a noncurrent version
+	// of an object.
+	ActionExpireNoncurrentVersion
+
+	// ActionRemoveDeleteMarker removes a delete marker without noncurrent
+	// versions.
+	ActionRemoveDeleteMarker
+)
+
+// String returns the name of the action.
+func (a Action) String() string {
+	switch a {
+	case ActionTransition:
+		return "Transition"
+	case ActionExpire:
+		return "Expire"
+	case ActionExpireNoncurrentVersion:
+		return "ExpireNoncurrentVersion"
+	case ActionRemoveDeleteMarker:
+		return "RemoveDeleteMarker"
+	default:
+		return "Unknown"
+	}
+}
+
+// Prediction is a lifecycle action predicted to be taken on an object
+// version.
+type Prediction struct {
+	Key       string
+	VersionID string
+	Action    Action
+
+	// Storage class the object transitions to, for ActionTransition.
+	StorageClass string
+
+	// Date, midnight UTC, the action becomes due. IBM COS takes the action
+	// within a day of the date.
+	Date time.Time
+
+	// ID of the rule taking the action.
+	RuleID string
+}
+
+// objectVersion is an object version or delete marker of a listing.
+type objectVersion struct {
+	key          string
+	versionID    string
+	lastModified time.Time
+	size         int64
+	storageClass string
+	deleteMarker bool
+
+	// isLatest is the IsLatest flag of the listing, latestKnown if the
+	// listing set it.
+	isLatest    bool
+	latestKnown bool
+}
+
+// Evaluate predicts the actions the enabled rules of the lifecycle
+// configuration will take on the object versions and delete markers of the
+// ListObjectVersions listings. Pass every page of a listing, the versions of
+// an object must all be listed for noncurrent version actions to be
+// predicted correctly.
+//
+// Versions flagged IsLatest are current, expiration and transition rules only
+// apply to them, and noncurrent version rules only to the other versions. The
+// newest version of an object is current if the listing does not set
+// IsLatest, e.g. for unversioned buckets.
+//
+// Days are counted from the creation of a version, or from the time it
+// became noncurrent, and rounded up to the next midnight UTC. When several
+// rules apply the earliest action is predicted, and transitions are only
+// predicted if they happen before the expiration of the object.
+//
+// Rules with tag filters are ignored, since listings do not include object
+// tags. The predictions are sorted by date, key and version ID.
+func Evaluate(cfg *s3.LifecycleConfiguration, listings ...*s3.ListObjectVersionsOutput) []Prediction {
+	if cfg == nil {
+		return nil
+	}
+
+	var rules []*s3.LifecycleRule
+	for _, rule := range cfg.Rules {
+		if ruleEnabled(rule) && !hasTagFilter(rule) {
+			rules = append(rules, rule)
+		}
+	}
+
+	byKey := map[string][]objectVersion{}
+	for _, out := range listings {
+		if out == nil {
+			continue
+		}
+		for _, v := range out.Versions {
+			key := aws.StringValue(v.Key)
+			byKey[key] = append(byKey[key], objectVersion{
+				key:          key,
+				versionID:    aws.StringValue(v.VersionId),
+				lastModified: aws.TimeValue(v.LastModified),
+				size:         aws.Int64Value(v.Size),
+				storageClass: aws.StringValue(v.StorageClass),
+				isLatest:     aws.BoolValue(v.IsLatest),
+				latestKnown:  v.IsLatest != nil,
+			})
+		}
+		for _, m := range out.DeleteMarkers {
+			key := aws.StringValue(m.Key)
+			byKey[key] = append(byKey[key], objectVersion{
+				key:          key,
+				versionID:    aws.StringValue(m.VersionId),
+				lastModified: aws.TimeValue(m.LastModified),
+				deleteMarker: true,
+				isLatest:     aws.BoolValue(m.IsLatest),
+				latestKnown:  m.IsLatest != nil,
+			})
+		}
+	}
+
+	var predictions []Prediction
+	for _, versions := range byKey {
+		// Current version first, then newest version first
+		sort.SliceStable(versions, func(i, j int) bool {
+			if versions[i].isLatest != versions[j].isLatest {
+				return versions[i].isLatest
+			}
+			return versions[i].lastModified.After(versions[j].lastModified)
+		})
+		predictions = append(predictions, evaluateObject(rules, versions)...)
+	}
+
+	sort.Slice(predictions, func(i, j int) bool {
+		a, b := predictions[i], predictions[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		if a.Key != b.Key {
+			return a.Key < b.Key
+		}
+		return a.VersionID < b.VersionID
+	})
+	return predictions
+}
+
+// evaluateObject returns the predictions for the versions of an object,
+// sorted current first, then newest first. The object has no current version
+// if the listing flags all its versions noncurrent, e.g. the current version
+// was not listed. The time the newest of these versions became noncurrent is
+// then unknown, and its expiration is not predicted.
+func evaluateObject(rules []*s3.LifecycleRule, versions []objectVersion) []Prediction {
+	var predictions []Prediction
+
+	current := versions[0]
+	hasCurrent := current.isLatest || !current.latestKnown
+	if hasCurrent && !current.deleteMarker {
+		predictions = append(predictions, evaluateCurrent(rules, current)...)
+	}
+
+	var noncurrentExpirations []Prediction
+	for i := 1; i < len(versions); i++ {
+		if versions[i].isLatest {
+			continue
+		}
+		index := i
+		if hasCurrent {
+			index--
+		}
+		if p, ok := evaluateNoncurrent(rules, versions[i], versions[i-1].lastModified, index); ok {
+			noncurrentExpirations = append(noncurrentExpirations, p)
+		}
+	}
+	predictions = append(predictions, noncurrentExpirations...)
+
+	// A current delete marker is removed once its noncurrent versions are
+	// all expired.
+	if hasCurrent && current.deleteMarker && len(noncurrentExpirations) == len(versions)-1 {
+		if p, ok := evaluateDeleteMarker(rules, current, noncurrentExpirations); ok {
+			predictions = append(predictions, p)
+		}
+	}
+
+	return predictions
+}
+
+func evaluateCurrent(rules []*s3.LifecycleRule, v objectVersion) []Prediction {
+	var transition, expiration *Prediction
+
+	for _, rule := range rules {
+		if !ruleMatches(rule, v) {
+			continue
+		}
+
+		if !isArchived(v.storageClass) {
+			for _, t := range rule.Transitions {
+				if t == nil {
+					continue
+				}
+				date, ok := actionDate(v.lastModified, t.Days, t.Date)
+				if ok && (transition == nil || date.Before(transition.Date)) {
+					transition = &Prediction{
+						Key:          v.key,
+						VersionID:    v.versionID,
+						Action:       ActionTransition,
+						StorageClass: aws.StringValue(t.StorageClass),
+						Date:         date,
+						RuleID:       aws.StringValue(rule.ID),
+					}
+				}
+			}
+		}
+
+		if exp := rule.Expiration; exp != nil {
+			date, ok := actionDate(v.lastModified, exp.Days, exp.Date)
+			if ok && (expiration == nil || date.Before(expiration.Date)) {
+				expiration = &Prediction{
+					Key:       v.key,
+					VersionID: v.versionID,
+					Action:    ActionExpire,
+					Date:      date,
+					RuleID:    aws.StringValue(rule.ID),
+				}
+			}
+		}
+	}
+
+	var predictions []Prediction
+	if transition != nil && (expiration == nil || transition.Date.Before(expiration.Date)) {
+		predictions = append(predictions, *transition)
+	}
+	if expiration != nil {
+		predictions = append(predictions, *expiration)
+	}
+	return predictions
+}
+
+// evaluateNoncurrent returns the expiration of a version that became
+// noncurrent at the time, and is the index'th newest noncurrent version.
+func evaluateNoncurrent(rules []*s3.LifecycleRule, v objectVersion, noncurrentSince time.Time, index int) (Prediction, bool) {
+	var expiration *Prediction
+
+	for _, rule := range rules {
+		nve := rule.NoncurrentVersionExpiration
+		if nve == nil || nve.NoncurrentDays == nil || !ruleMatches(rule, v) {
+			continue
+		}
+		if n := nve.NewerNoncurrentVersions; n != nil && int64(index) < *n {
+			continue
+		}
+
+		date, _ := actionDate(noncurrentSince, nve.NoncurrentDays, nil)
+		if expiration == nil || date.Before(expiration.Date) {
+			expiration = &Prediction{
+				Key:       v.key,
+				VersionID: v.versionID,
+				Action:    ActionExpireNoncurrentVersion,
+				Date:      date,
+				RuleID:    aws.StringValue(rule.ID),
+			}
+		}
+	}
+
+	if expiration == nil {
+		return Prediction{}, false
+	}
+	return *expiration, true
+}
+
+// evaluateDeleteMarker returns the removal of a current delete marker after
+// the expiration of its noncurrent versions.
+func evaluateDeleteMarker(rules []*s3.LifecycleRule, v objectVersion, expirations []Prediction) (Prediction, bool) {
+	for _, rule := range rules {
+		if rule.Expiration == nil || !aws.BoolValue(rule.Expiration.ExpiredObjectDeleteMarker) ||
+			!strings.HasPrefix(v.key, rulePrefix(rule)) {
+			continue
+		}
+
+		date := nextMidnightUTC(v.lastModified)
+		for _, p := range expirations {
+			if p.Date.After(date) {
+				date = p.Date
+			}
+		}
+		return Prediction{
+			Key:       v.key,
+			VersionID: v.versionID,
+			Action:    ActionRemoveDeleteMarker,
+			Date:      date,
+			RuleID:    aws.StringValue(rule.ID),
+		}, true
+	}
+	return Prediction{}, false
+}
+
+// ruleMatches returns true if the prefix and object size filters of the rule
+// match the object version.
+func ruleMatches(rule *s3.LifecycleRule, v objectVersion) bool {
+	if !strings.HasPrefix(v.key, rulePrefix(rule)) {
+		return false
+	}
+
+	var greaterThan, lessThan *int64
+	if f := rule.Filter; f != nil {
+		greaterThan, lessThan = f.ObjectSizeGreaterThan, f.ObjectSizeLessThan
+		if f.And != nil {
+			greaterThan, lessThan = f.And.ObjectSizeGreaterThan, f.And.ObjectSizeLessThan
+		}
+	}
+	if v.deleteMarker {
+		return greaterThan == nil && lessThan == nil
+	}
+	if greaterThan != nil && v.size <= *greaterThan {
+		return false
+	}
+	if lessThan != nil && v.size >= *lessThan {
+		return false
+	}
+	return true
+}
+
+// actionDate returns the date an action with the days or date is due for an
+// object created, or made noncurrent, at the time. Days are saturated to
+// MaxDays so configurations that were not validated cannot overflow.
+func actionDate(t time.Time, days *int64, date *time.Time) (time.Time, bool) {
+	switch {
+	case days != nil:
+		n := *days
+		if n > MaxDays {
+			n = MaxDays
+		}
+		return nextMidnightUTC(t.UTC().AddDate(0, 0, int(n))), true
+	case date != nil:
+		if due := nextMidnightUTC(t); due.After(*date) {
+			return due, true
+		}
+		return date.UTC(), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func nextMidnightUTC(t time.Time) time.Time {
+	t = t.UTC()
+	midnight := t.Truncate(24 * time.Hour)
+	if midnight.Equal(t) {
+		return midnight
+	}
+	return midnight.Add(24 * time.Hour)
+}
+
+func isArchived(storageClass string) bool {
+	return storageClass == StorageClassArchive || storageClass == StorageClassAcceleratedArchive
+}
@@ -0,0 +1,233 @@
+package s3lifecycle
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+func day(d int) time.Time {
+	return time.Date(2023, 1, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestEvaluate(t *testing.T) {
+	b := NewBuilder()
+	b.Rule("archive-logs").Prefix("logs/").
+		TransitionAfterDays(30, StorageClassArchive).
+		ExpireAfterDays(365)
+	b.Rule("tmp").Prefix("tmp/").
+		TransitionAfterDays(10, StorageClassAcceleratedArchive).
+		ExpireAfterDays(20)
+	b.Rule("versions").Prefix("v").
+		ExpireNoncurrentVersionsAfterDays(7).
+		KeepNewerNoncurrentVersions(1)
+	b.Rule("deleted").Prefix("deleted").
+		ExpireNoncurrentVersionsAfterDays(7).
+		ExpireDeleteMarkers()
+	cfg, err := b.Build()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	// Transitions after the expiration of the object are not predicted
+	cfg.Rules[1].Expiration.Days = aws.Int64(5)
+
+	created := day(1).Add(10 * time.Hour)
+	listing := &s3.ListObjectVersionsOutput{
+		Versions: []*s3.ObjectVersion{
+			{Key: aws.String("logs/a"), VersionId: aws.String("a1"), IsLatest: aws.Bool(true), LastModified: aws.Time(created), StorageClass: aws.String("STANDARD")},
+			{Key: aws.String("logs/archived"), VersionId: aws.String("b1"), IsLatest: aws.Bool(true), LastModified: aws.Time(created), StorageClass: aws.String(StorageClassArchive)},
+			{Key: aws.String("tmp/c"), VersionId: aws.String("c1"), IsLatest: aws.Bool(true), LastModified: aws.Time(created), StorageClass: aws.String("STANDARD")},
+			{Key: aws.String("v"), VersionId: aws.String("v3"), IsLatest: aws.Bool(true), LastModified: aws.Time(day(3)), StorageClass: aws.String("STANDARD")},
+			{Key: aws.String("v"), VersionId: aws.String("v2"), LastModified: aws.Time(day(2)), StorageClass: aws.String("STANDARD")},
+			{Key: aws.String("v"), VersionId: aws.String("v1"), LastModified: aws.Time(day(1)), StorageClass: aws.String("STANDARD")},
+		},
+	}
+	markers := &s3.ListObjectVersionsOutput{
+		Versions: []*s3.ObjectVersion{
+			{Key: aws.String("deleted"), VersionId: aws.String("d1"), LastModified: aws.Time(day(1)), StorageClass: aws.String("STANDARD")},
+		},
+		DeleteMarkers: []*s3.DeleteMarkerEntry{
+			{Key: aws.String("deleted"), VersionId: aws.String("dm"), IsLatest: aws.Bool(true), LastModified: aws.Time(day(2))},
+		},
+	}
+
+	predictions := Evaluate(cfg, listing, markers)
+
+	expect := []Prediction{
+		{Key: "tmp/c", VersionID: "c1", Action: ActionExpire, Date: day(7), RuleID: "tmp"},
+		{Key: "deleted", VersionID: "d1", Action: ActionExpireNoncurrentVersion, Date: day(9), RuleID: "deleted"},
+		{Key: "deleted", VersionID: "dm", Action: ActionRemoveDeleteMarker, Date: day(9), RuleID: "deleted"},
+		{Key: "v", VersionID: "v1", Action: ActionExpireNoncurrentVersion, Date: day(9), RuleID: "versions"},
+		{Key: "logs/a", VersionID: "a1", Action: ActionTransition, StorageClass: StorageClassArchive, Date: day(1).AddDate(0, 0, 31), RuleID: "archive-logs"},
+		{Key: "logs/a", VersionID: "a1", Action: ActionExpire, Date: day(1).AddDate(0, 0, 366), RuleID: "archive-logs"},
+		{Key: "logs/archived", VersionID: "b1", Action: ActionExpire, Date: day(1).AddDate(0, 0, 366), RuleID: "archive-logs"},
+	}
+
+	if e, a := len(expect), len(predictions); e != a {
+		t.Fatalf("expect %v predictions, got %v, %v", e, a, predictions)
+	}
+	for i, e := range expect {
+		if a := predictions[i]; e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+}
+
+func TestEvaluate_ExpirationDate(t *testing.T) {
+	b := NewBuilder()
+	b.Rule("a").ExpireOnDate(day(10))
+	cfg, err := b.Build()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	predictions := Evaluate(cfg, &s3.ListObjectVersionsOutput{
+		Versions: []*s3.ObjectVersion{
+			{Key: aws.String("old"), LastModified: aws.Time(day(1))},
+			{Key: aws.String("new"), LastModified: aws.Time(day(20).Add(time.Hour))},
+		},
+	})
+
+	if e, a := 2, len(predictions); e != a {
+		t.Fatalf("expect %v predictions, got %v", e, a)
+	}
+	if e, a := day(10), predictions[0].Date; !e.Equal(a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	// Objects created after the date expire on the next run
+	if e, a := day(21), predictions[1].Date; !e.Equal(a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestEvaluate_LargeDays(t *testing.T) {
+	cases := map[string]struct {
+		Days   int64
+		Expect time.Time
+	}{
+		"past duration range": {Days: 200000, Expect: day(1).AddDate(0, 0, 200000)},
+		"past max days":       {Days: math.MaxInt64, Expect: day(1).AddDate(0, 0, MaxDays)},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			// Not validated, Evaluate must not overflow either way
+			cfg := &s3.LifecycleConfiguration{
+				Rules: []*s3.LifecycleRule{{
+					ID:         aws.String("a"),
+					Status:     aws.String(s3.ExpirationStatusEnabled),
+					Filter:     &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+					Expiration: &s3.LifecycleExpiration{Days: aws.Int64(c.Days)},
+				}},
+			}
+
+			predictions := Evaluate(cfg, &s3.ListObjectVersionsOutput{
+				Versions: []*s3.ObjectVersion{
+					{Key: aws.String("a"), LastModified: aws.Time(day(1))},
+				},
+			})
+
+			if e, a := 1, len(predictions); e != a {
+				t.Fatalf("expect %v predictions, got %v", e, a)
+			}
+			if e, a := c.Expect, predictions[0].Date; !e.Equal(a) {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestEvaluate_IgnoresTagAndSizeFilters(t *testing.T) {
+	cfg := &s3.LifecycleConfiguration{
+		Rules: []*s3.LifecycleRule{
+			{
+				ID:         aws.String("tagged"),
+				Filter:     &s3.LifecycleRuleFilter{Tag: &s3.Tag{Key: aws.String("k"), Value: aws.String("v")}},
+				Status:     aws.String(s3.ExpirationStatusEnabled),
+				Expiration: &s3.LifecycleExpiration{Days: aws.Int64(1)},
+			},
+			{
+				ID:         aws.String("large"),
+				Filter:     &s3.LifecycleRuleFilter{ObjectSizeGreaterThan: aws.Int64(1024)},
+				Status:     aws.String(s3.ExpirationStatusEnabled),
+				Expiration: &s3.LifecycleExpiration{Days: aws.Int64(2)},
+			},
+		},
+	}
+
+	predictions := Evaluate(cfg, &s3.ListObjectVersionsOutput{
+		Versions: []*s3.ObjectVersion{
+			{Key: aws.String("small"), LastModified: aws.Time(day(1)), Size: aws.Int64(10)},
+			{Key: aws.String("large"), LastModified: aws.Time(day(1)), Size: aws.Int64(2048)},
+		},
+	})
+
+	if e, a := 1, len(predictions); e != a {
+		t.Fatalf("expect %v predictions, got %v", e, a)
+	}
+	if e, a := "large", predictions[0].RuleID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestEvaluate_Versioned(t *testing.T) {
+	b := NewBuilder()
+	b.Rule("expire").ExpireAfterDays(10).ExpireNoncurrentVersionsAfterDays(7)
+	cfg, err := b.Build()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	cases := map[string]struct {
+		Versions []*s3.ObjectVersion
+		Expect   []Prediction
+	}{
+		"current and noncurrent": {
+			Versions: []*s3.ObjectVersion{
+				{Key: aws.String("k"), VersionId: aws.String("k1"), IsLatest: aws.Bool(false), LastModified: aws.Time(day(1))},
+				{Key: aws.String("k"), VersionId: aws.String("k2"), IsLatest: aws.Bool(true), LastModified: aws.Time(day(3))},
+			},
+			Expect: []Prediction{
+				{Key: "k", VersionID: "k1", Action: ActionExpireNoncurrentVersion, Date: day(10), RuleID: "expire"},
+				{Key: "k", VersionID: "k2", Action: ActionExpire, Date: day(13), RuleID: "expire"},
+			},
+		},
+		"same last modified": {
+			Versions: []*s3.ObjectVersion{
+				{Key: aws.String("k"), VersionId: aws.String("k1"), IsLatest: aws.Bool(false), LastModified: aws.Time(day(2))},
+				{Key: aws.String("k"), VersionId: aws.String("k2"), IsLatest: aws.Bool(true), LastModified: aws.Time(day(2))},
+			},
+			Expect: []Prediction{
+				{Key: "k", VersionID: "k1", Action: ActionExpireNoncurrentVersion, Date: day(9), RuleID: "expire"},
+				{Key: "k", VersionID: "k2", Action: ActionExpire, Date: day(12), RuleID: "expire"},
+			},
+		},
+		"current not listed": {
+			Versions: []*s3.ObjectVersion{
+				{Key: aws.String("k"), VersionId: aws.String("k2"), IsLatest: aws.Bool(false), LastModified: aws.Time(day(3))},
+				{Key: aws.String("k"), VersionId: aws.String("k1"), IsLatest: aws.Bool(false), LastModified: aws.Time(day(1))},
+			},
+			Expect: []Prediction{
+				{Key: "k", VersionID: "k1", Action: ActionExpireNoncurrentVersion, Date: day(10), RuleID: "expire"},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			predictions := Evaluate(cfg, &s3.ListObjectVersionsOutput{Versions: c.Versions})
+
+			if e, a := len(c.Expect), len(predictions); e != a {
+				t.Fatalf("expect %v predictions, got %v, %v", e, a, predictions)
+			}
+			for i, e := range c.Expect {
+				if a := predictions[i]; e != a {
+					t.Errorf("%d, expect %v, got %v", i, e, a)
+				}
+			}
+		})
+	}
+}
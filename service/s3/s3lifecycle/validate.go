@@ -0,0 +1,265 @@
+package s3lifecycle
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// ErrCodeInvalidLifecycleConfiguration is the error code of the
+// awserr.BatchedErrors returned when a lifecycle configuration is not valid.
+const ErrCodeInvalidLifecycleConfiguration = "InvalidLifecycleConfiguration"
+
+const (
+	// MaxRules is the number of rules a lifecycle configuration can have.
+	MaxRules = 1000
+
+	// MaxRuleIDLength is the length limit of a lifecycle rule ID.
+	MaxRuleIDLength = 255
+
+	// MaxNewerNoncurrentVersions is the largest number of noncurrent
+	// versions a rule can retain.
+	MaxNewerNoncurrentVersions = 100
+
+	// MaxDays is the largest day count of a lifecycle action, the API
+	// represents days as 32-bit integers.
+	MaxDays = math.MaxInt32
+)
+
+// Validate returns an error listing the problems of the lifecycle
+// configuration that IBM COS would reject or that make rules conflict:
+//
+//   - rules without actions, duplicate rule IDs or invalid statuses
+//   - transitions to storage classes other than the IBM COS archive classes,
+//     more than one transition per rule, or noncurrent version transitions
+//   - actions with both, or neither, days and date, days that are negative
+//     or more than MaxDays, or dates that are not midnight UTC
+//   - transitions that do not happen before the expiration of the rule
+//   - enabled rules with overlapping prefixes that both transition, or both
+//     expire, objects
+//
+// Rules are considered overlapping when the prefix of one starts with the
+// prefix of the other, regardless of their tag and object size filters.
+func Validate(cfg *s3.LifecycleConfiguration) error {
+	var errs []error
+	addErr := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	switch {
+	case cfg == nil || len(cfg.Rules) == 0:
+		addErr("lifecycle configuration must have at least one rule")
+	case len(cfg.Rules) > MaxRules:
+		addErr("lifecycle configuration has %d rules, more than %d", len(cfg.Rules), MaxRules)
+	}
+
+	ids := map[string]struct{}{}
+	if cfg != nil {
+		for i, rule := range cfg.Rules {
+			name := ruleName(i, rule)
+			if rule == nil {
+				addErr("%s: rule must not be nil", name)
+				continue
+			}
+
+			if id := aws.StringValue(rule.ID); len(id) != 0 {
+				if _, ok := ids[id]; ok {
+					addErr("%s: duplicate rule ID", name)
+				}
+				ids[id] = struct{}{}
+				if len(id) > MaxRuleIDLength {
+					addErr("%s: rule ID longer than %d characters", name, MaxRuleIDLength)
+				}
+			}
+
+			for _, msg := range validateRule(rule) {
+				addErr("%s: %s", name, msg)
+			}
+		}
+
+		for _, msg := range validateOverlaps(cfg.Rules) {
+			addErr("%s", msg)
+		}
+	}
+
+	if len(errs) > 0 {
+		return awserr.NewBatchError(ErrCodeInvalidLifecycleConfiguration,
+			"lifecycle configuration is invalid", errs)
+	}
+	return nil
+}
+
+func validateRule(rule *s3.LifecycleRule) []string {
+	var msgs []string
+
+	switch aws.StringValue(rule.Status) {
+	case s3.ExpirationStatusEnabled, s3.ExpirationStatusDisabled:
+	default:
+		msgs = append(msgs, fmt.Sprintf("status must be %s or %s",
+			s3.ExpirationStatusEnabled, s3.ExpirationStatusDisabled))
+	}
+
+	if rule.Filter == nil && rule.Prefix == nil {
+		msgs = append(msgs, "filter is required")
+	}
+	if rule.Filter != nil && rule.Prefix != nil {
+		msgs = append(msgs, "deprecated rule prefix must not be used with a filter")
+	}
+
+	if rule.Expiration == nil && len(rule.Transitions) == 0 &&
+		rule.NoncurrentVersionExpiration == nil && len(rule.NoncurrentVersionTransitions) == 0 &&
+		rule.AbortIncompleteMultipartUpload == nil {
+		msgs = append(msgs, "rule must have at least one action")
+	}
+
+	if len(rule.Transitions) > 1 {
+		msgs = append(msgs, "IBM COS supports a single transition per rule")
+	}
+	for _, t := range rule.Transitions {
+		if t == nil {
+			continue
+		}
+		switch sc := aws.StringValue(t.StorageClass); sc {
+		case StorageClassArchive, StorageClassAcceleratedArchive:
+		default:
+			msgs = append(msgs, fmt.Sprintf("transition storage class %q must be %s or %s",
+				sc, StorageClassArchive, StorageClassAcceleratedArchive))
+		}
+		msgs = append(msgs, validateDaysOrDate("transition", t.Days, t.Date, 0)...)
+	}
+
+	if len(rule.NoncurrentVersionTransitions) > 0 {
+		msgs = append(msgs, "IBM COS does not support noncurrent version transitions")
+	}
+
+	if exp := rule.Expiration; exp != nil {
+		if aws.BoolValue(exp.ExpiredObjectDeleteMarker) {
+			if exp.Days != nil || exp.Date != nil {
+				msgs = append(msgs, "expired object delete marker must not be used with expiration days or date")
+			}
+		} else {
+			msgs = append(msgs, validateDaysOrDate("expiration", exp.Days, exp.Date, 1)...)
+		}
+
+		for _, t := range rule.Transitions {
+			if t == nil {
+				continue
+			}
+			if t.Days != nil && exp.Days != nil && *t.Days >= *exp.Days {
+				msgs = append(msgs, fmt.Sprintf("transition after %d days must happen before expiration after %d days",
+					*t.Days, *exp.Days))
+			}
+			if t.Date != nil && exp.Date != nil && !t.Date.Before(*exp.Date) {
+				msgs = append(msgs, fmt.Sprintf("transition on %s must happen before expiration on %s",
+					t.Date.Format("2006-01-02"), exp.Date.Format("2006-01-02")))
+			}
+		}
+	}
+
+	if nve := rule.NoncurrentVersionExpiration; nve != nil {
+		if d := aws.Int64Value(nve.NoncurrentDays); d < 1 || d > MaxDays {
+			msgs = append(msgs, fmt.Sprintf("noncurrent version expiration days must be between 1 and %d", MaxDays))
+		}
+		if n := nve.NewerNoncurrentVersions; n != nil && (*n < 1 || *n > MaxNewerNoncurrentVersions) {
+			msgs = append(msgs, fmt.Sprintf("newer noncurrent versions must be between 1 and %d",
+				MaxNewerNoncurrentVersions))
+		}
+	}
+
+	if abort := rule.AbortIncompleteMultipartUpload; abort != nil {
+		if d := aws.Int64Value(abort.DaysAfterInitiation); d < 1 || d > MaxDays {
+			msgs = append(msgs, fmt.Sprintf("abort incomplete multipart upload days must be between 1 and %d", MaxDays))
+		}
+		if hasTagFilter(rule) {
+			msgs = append(msgs, "abort incomplete multipart upload must not be used with tag filters")
+		}
+	}
+
+	return msgs
+}
+
+func validateDaysOrDate(action string, days *int64, date *time.Time, minDays int64) []string {
+	switch {
+	case days != nil && date != nil:
+		return []string{action + " must have days or date, not both"}
+	case days == nil && date == nil:
+		return []string{action + " must have days or date"}
+	case days != nil && (*days < minDays || *days > MaxDays):
+		return []string{fmt.Sprintf("%s days must be between %d and %d", action, minDays, MaxDays)}
+	case date != nil && !isMidnightUTC(*date):
+		return []string{action + " date must be midnight UTC"}
+	}
+	return nil
+}
+
+// validateOverlaps returns the conflicts between enabled rules with
+// overlapping prefixes.
+func validateOverlaps(rules []*s3.LifecycleRule) []string {
+	var msgs []string
+	for i, a := range rules {
+		if !ruleEnabled(a) {
+			continue
+		}
+		for j := i + 1; j < len(rules); j++ {
+			b := rules[j]
+			if !ruleEnabled(b) || !prefixesOverlap(rulePrefix(a), rulePrefix(b)) {
+				continue
+			}
+			if len(a.Transitions) > 0 && len(b.Transitions) > 0 {
+				msgs = append(msgs, fmt.Sprintf("%s and %s: overlapping prefixes %q and %q both transition objects",
+					ruleName(i, a), ruleName(j, b), rulePrefix(a), rulePrefix(b)))
+			}
+			if expiresCurrent(a) && expiresCurrent(b) {
+				msgs = append(msgs, fmt.Sprintf("%s and %s: overlapping prefixes %q and %q both expire objects",
+					ruleName(i, a), ruleName(j, b), rulePrefix(a), rulePrefix(b)))
+			}
+		}
+	}
+	return msgs
+}
+
+func ruleName(i int, rule *s3.LifecycleRule) string {
+	if rule != nil && len(aws.StringValue(rule.ID)) != 0 {
+		return fmt.Sprintf("rule %q", aws.StringValue(rule.ID))
+	}
+	return fmt.Sprintf("rule %d", i)
+}
+
+func ruleEnabled(rule *s3.LifecycleRule) bool {
+	return rule != nil && aws.StringValue(rule.Status) == s3.ExpirationStatusEnabled
+}
+
+func expiresCurrent(rule *s3.LifecycleRule) bool {
+	return rule.Expiration != nil && (rule.Expiration.Days != nil || rule.Expiration.Date != nil)
+}
+
+// rulePrefix returns the key prefix of the rule's filter, or of the
+// deprecated rule prefix.
+func rulePrefix(rule *s3.LifecycleRule) string {
+	if f := rule.Filter; f != nil {
+		if f.And != nil {
+			return aws.StringValue(f.And.Prefix)
+		}
+		return aws.StringValue(f.Prefix)
+	}
+	return aws.StringValue(rule.Prefix)
+}
+
+func hasTagFilter(rule *s3.LifecycleRule) bool {
+	f := rule.Filter
+	return f != nil && (f.Tag != nil || (f.And != nil && len(f.And.Tags) > 0))
+}
+
+func prefixesOverlap(a, b string) bool {
+	return strings.HasPrefix(a, b) || strings.HasPrefix(b, a)
+}
+
+func isMidnightUTC(t time.Time) bool {
+	t = t.UTC()
+	return t.Equal(t.Truncate(24 * time.Hour))
+}
@@ -0,0 +1,213 @@
+package s3lifecycle
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+func TestBuilderBuild(t *testing.T) {
+	b := NewBuilder()
+	b.Rule("archive-logs").Prefix("logs/").
+		TransitionAfterDays(30, StorageClassArchive).
+		ExpireAfterDays(365)
+	b.Rule("tmp").Prefix("tmp/").
+		ExpireAfterDays(1).
+		AbortIncompleteMultipartUploadAfterDays(1)
+	b.Rule("versions").Prefix("").
+		ExpireNoncurrentVersionsAfterDays(7).
+		KeepNewerNoncurrentVersions(2)
+
+	cfg, err := b.Build()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 3, len(cfg.Rules); e != a {
+		t.Fatalf("expect %v rules, got %v", e, a)
+	}
+	rule := cfg.Rules[0]
+	if e, a := "logs/", aws.StringValue(rule.Filter.Prefix); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := s3.ExpirationStatusEnabled, aws.StringValue(rule.Status); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := int64(30), aws.Int64Value(rule.Transitions[0].Days); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := int64(365), aws.Int64Value(rule.Expiration.Days); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := int64(2), aws.Int64Value(cfg.Rules[2].NoncurrentVersionExpiration.NewerNoncurrentVersions); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	date := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		Build     func(b *Builder)
+		ExpectErr []string
+	}{
+		"no rules": {
+			Build:     func(b *Builder) {},
+			ExpectErr: []string{"at least one rule"},
+		},
+		"no action": {
+			Build:     func(b *Builder) { b.Rule("a").Prefix("a/") },
+			ExpectErr: []string{`rule "a": rule must have at least one action`},
+		},
+		"duplicate id": {
+			Build: func(b *Builder) {
+				b.Rule("a").Prefix("a/").ExpireAfterDays(1)
+				b.Rule("a").Prefix("b/").ExpireAfterDays(1)
+			},
+			ExpectErr: []string{"duplicate rule ID"},
+		},
+		"transition after expiration": {
+			Build: func(b *Builder) {
+				b.Rule("a").TransitionAfterDays(30, StorageClassArchive).ExpireAfterDays(30)
+			},
+			ExpectErr: []string{"transition after 30 days must happen before expiration after 30 days"},
+		},
+		"transition date after expiration date": {
+			Build: func(b *Builder) {
+				b.Rule("a").TransitionOnDate(date, StorageClassArchive).ExpireOnDate(date)
+			},
+			ExpectErr: []string{"must happen before expiration on 2030-01-01"},
+		},
+		"not archive storage class": {
+			Build: func(b *Builder) {
+				b.Rule("a").TransitionAfterDays(30, s3.TransitionStorageClassStandardIa)
+			},
+			ExpectErr: []string{`storage class "STANDARD_IA"`},
+		},
+		"multiple transitions": {
+			Build: func(b *Builder) {
+				b.Rule("a").
+					TransitionAfterDays(30, StorageClassAcceleratedArchive).
+					TransitionAfterDays(60, StorageClassArchive)
+			},
+			ExpectErr: []string{"single transition per rule"},
+		},
+		"days and date": {
+			Build: func(b *Builder) {
+				b.Rule("a").ExpireAfterDays(30).ExpireOnDate(date)
+			},
+			ExpectErr: []string{"expiration must have days or date, not both"},
+		},
+		"date not midnight": {
+			Build: func(b *Builder) {
+				b.Rule("a").ExpireOnDate(date.Add(time.Hour))
+			},
+			ExpectErr: []string{"expiration date must be midnight UTC"},
+		},
+		"zero expiration days": {
+			Build: func(b *Builder) {
+				b.Rule("a").ExpireAfterDays(0)
+			},
+			ExpectErr: []string{"expiration days must be between 1 and 2147483647"},
+		},
+		"too many expiration days": {
+			Build: func(b *Builder) {
+				b.Rule("a").ExpireAfterDays(MaxDays + 1)
+			},
+			ExpectErr: []string{"expiration days must be between 1 and 2147483647"},
+		},
+		"too many noncurrent days": {
+			Build: func(b *Builder) {
+				b.Rule("a").ExpireNoncurrentVersionsAfterDays(MaxDays + 1)
+			},
+			ExpectErr: []string{"noncurrent version expiration days must be between 1 and 2147483647"},
+		},
+		"delete marker with days": {
+			Build: func(b *Builder) {
+				b.Rule("a").ExpireAfterDays(30).ExpireDeleteMarkers()
+			},
+			ExpectErr: []string{"expired object delete marker must not be used with expiration days or date"},
+		},
+		"newer noncurrent versions": {
+			Build: func(b *Builder) {
+				b.Rule("a").ExpireNoncurrentVersionsAfterDays(1).KeepNewerNoncurrentVersions(101)
+			},
+			ExpectErr: []string{"newer noncurrent versions must be between 1 and 100"},
+		},
+		"overlapping transitions": {
+			Build: func(b *Builder) {
+				b.Rule("a").Prefix("logs/").TransitionAfterDays(30, StorageClassArchive)
+				b.Rule("b").Prefix("logs/app/").TransitionAfterDays(10, StorageClassAcceleratedArchive)
+			},
+			ExpectErr: []string{`rule "a" and rule "b": overlapping prefixes "logs/" and "logs/app/" both transition objects`},
+		},
+		"overlapping expirations": {
+			Build: func(b *Builder) {
+				b.Rule("a").ExpireAfterDays(30)
+				b.Rule("b").Prefix("tmp/").ExpireAfterDays(1)
+			},
+			ExpectErr: []string{"both expire objects"},
+		},
+		"overlapping disabled": {
+			Build: func(b *Builder) {
+				b.Rule("a").ExpireAfterDays(30)
+				b.Rule("b").Prefix("tmp/").ExpireAfterDays(1).Disabled()
+			},
+		},
+		"disjoint prefixes": {
+			Build: func(b *Builder) {
+				b.Rule("a").Prefix("logs/").TransitionAfterDays(30, StorageClassArchive).ExpireAfterDays(60)
+				b.Rule("b").Prefix("tmp/").TransitionAfterDays(1, StorageClassArchive).ExpireAfterDays(2)
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			b := NewBuilder()
+			c.Build(b)
+			_, err := b.Build()
+
+			if len(c.ExpectErr) == 0 {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			if e, a := ErrCodeInvalidLifecycleConfiguration, err.(awserr.Error).Code(); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			for _, e := range c.ExpectErr {
+				if a := err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect %q in error, got %v", e, a)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate_NoncurrentVersionTransitions(t *testing.T) {
+	err := Validate(&s3.LifecycleConfiguration{
+		Rules: []*s3.LifecycleRule{{
+			Filter: &s3.LifecycleRuleFilter{},
+			Status: aws.String(s3.ExpirationStatusEnabled),
+			NoncurrentVersionTransitions: []*s3.NoncurrentVersionTransition{{
+				NoncurrentDays: aws.Int64(30),
+				StorageClass:   aws.String(StorageClassArchive),
+			}},
+		}},
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := "rule 0: IBM COS does not support noncurrent version transitions", err.Error(); !strings.Contains(a, e) {
+		t.Errorf("expect %q in error, got %v", e, a)
+	}
+}
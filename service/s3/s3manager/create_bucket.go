@@ -0,0 +1,116 @@
+package s3manager
+
+import (
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+)
+
+// DefaultKeyProtectEncryptionAlgorithm is the Key Protect encryption
+// algorithm used when a root key CRN is provided without an algorithm.
+const DefaultKeyProtectEncryptionAlgorithm = "AES256"
+
+// CreateBucketInput provides the parameters for CreateBucket.
+type CreateBucketInput struct {
+	// Name of the bucket to create. Required.
+	Bucket string
+
+	// IBM COS location of the bucket, e.g. us-south, us or ams03. Required.
+	Location string
+
+	// Storage class of the bucket. Defaults to the IBM COS default, standard.
+	StorageClass s3.BucketStorageClass
+
+	// IBM COS service instance ID the bucket is created in. Defaults to the
+	// service instance ID of the client's credentials.
+	ServiceInstanceID string
+
+	// CRN of the Key Protect, or Hyper Protect Crypto Services, root key
+	// encrypting the bucket.
+	KeyProtectRootKeyCRN string
+
+	// Key Protect encryption algorithm, defaults to
+	// DefaultKeyProtectEncryptionAlgorithm when KeyProtectRootKeyCRN is set.
+	KeyProtectEncryptionAlgorithm string
+}
+
+// CreateBucket creates a bucket with the LocationConstraint provisioning code
+// of the location and storage class, waits until the bucket exists, and
+// returns the bucket's location as reported by ListBucketsExtended.
+//
+//	location, err := s3manager.CreateBucket(ctx, svc, &s3manager.CreateBucketInput{
+//	    Bucket:       "my-bucket",
+//	    Location:     "us-south",
+//	    StorageClass: s3.BucketStorageClassSmart,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("Bucket created in %s with storage class %s\n", location.Location, location.StorageClass)
+//
+// The request options are applied to the CreateBucket and ListBucketsExtended
+// requests.
+func CreateBucket(ctx aws.Context, svc s3iface.S3API, input *CreateBucketInput, opts ...request.Option) (s3.BucketLocation, error) {
+	if len(input.Bucket) == 0 || len(input.Location) == 0 {
+		return s3.BucketLocation{}, awserr.New(request.InvalidParameterErrCode,
+			"bucket and location are required to create a bucket", nil)
+	}
+
+	createInput := &s3.CreateBucketInput{
+		Bucket: aws.String(input.Bucket),
+		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String(s3.FormatBucketLocationConstraint(input.Location, input.StorageClass)),
+		},
+	}
+	if len(input.ServiceInstanceID) != 0 {
+		createInput.IBMServiceInstanceId = aws.String(input.ServiceInstanceID)
+	}
+	if len(input.KeyProtectRootKeyCRN) != 0 {
+		algorithm := input.KeyProtectEncryptionAlgorithm
+		if len(algorithm) == 0 {
+			algorithm = DefaultKeyProtectEncryptionAlgorithm
+		}
+		createInput.IBMSSEKPCustomerRootKeyCrn = aws.String(input.KeyProtectRootKeyCRN)
+		createInput.IBMSSEKPEncryptionAlgorithm = aws.String(algorithm)
+	}
+
+	if _, err := svc.CreateBucketWithContext(ctx, createInput, opts...); err != nil {
+		return s3.BucketLocation{}, err
+	}
+
+	if err := svc.WaitUntilBucketExistsWithContext(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(input.Bucket),
+	}); err != nil {
+		return s3.BucketLocation{}, err
+	}
+
+	listInput := &s3.ListBucketsExtendedInput{
+		Prefix: aws.String(input.Bucket),
+	}
+	if len(input.ServiceInstanceID) != 0 {
+		listInput.IBMServiceInstanceId = aws.String(input.ServiceInstanceID)
+	}
+
+	var constraint *string
+	err := svc.ListBucketsExtendedPagesWithContext(ctx, listInput,
+		func(page *s3.ListBucketsExtendedOutput, lastPage bool) bool {
+			for _, b := range page.Buckets {
+				if aws.StringValue(b.Name) == input.Bucket {
+					constraint = b.LocationConstraint
+					return false
+				}
+			}
+			return true
+		}, opts...)
+	if err != nil {
+		return s3.BucketLocation{}, err
+	}
+	if constraint == nil {
+		return s3.BucketLocation{}, awserr.New("NotFound",
+			"bucket "+input.Bucket+" not found in ListBucketsExtended", nil)
+	}
+
+	return s3.ParseBucketLocationConstraint(aws.StringValue(constraint))
+}
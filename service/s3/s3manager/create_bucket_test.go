@@ -0,0 +1,81 @@
+package s3manager
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/awstesting/unit"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+func TestCreateBucket(t *testing.T) {
+	svc := s3.New(unit.Session, &aws.Config{
+		Region:           aws.String("us-south"),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+
+	var ops []string
+	var createReq *http.Request
+	var createBody []byte
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		ops = append(ops, r.Operation.Name)
+
+		body := ""
+		switch r.Operation.Name {
+		case "CreateBucket":
+			createReq = r.HTTPRequest
+			createBody, _ = ioutil.ReadAll(r.HTTPRequest.Body)
+		case "ListBucketsExtended":
+			body = `<ListAllMyBucketsResult><Buckets>` +
+				`<Bucket><Name>my-bucket-other</Name><LocationConstraint>us-south-standard</LocationConstraint></Bucket>` +
+				`<Bucket><Name>my-bucket</Name><LocationConstraint>us-south-smart</LocationConstraint></Bucket>` +
+				`</Buckets><IsTruncated>false</IsTruncated></ListAllMyBucketsResult>`
+		}
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+
+	location, err := CreateBucket(aws.BackgroundContext(), svc, &CreateBucketInput{
+		Bucket:               "my-bucket",
+		Location:             "us-south",
+		StorageClass:         s3.BucketStorageClassSmart,
+		ServiceInstanceID:    "instance-id",
+		KeyProtectRootKeyCRN: "crn:v1:bluemix:public:kms:us-south:a/acc1:kp1:key:key1",
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := []string{"CreateBucket", "HeadBucket", "ListBucketsExtended"}, ops; len(e) != len(a) || e[0] != a[0] || e[1] != a[1] || e[2] != a[2] {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "us-south-smart", string(createBody); !bytes.Contains([]byte(a), []byte("<LocationConstraint>"+e+"</LocationConstraint>")) {
+		t.Errorf("expect %v in body, got %v", e, a)
+	}
+	if e, a := "instance-id", createReq.Header.Get("ibm-service-instance-id"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "crn:v1:bluemix:public:kms:us-south:a/acc1:kp1:key:key1", createReq.Header.Get("ibm-sse-kp-customer-root-key-crn"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := DefaultKeyProtectEncryptionAlgorithm, createReq.Header.Get("ibm-sse-kp-encryption-algorithm"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := (s3.BucketLocation{Location: "us-south", StorageClass: s3.BucketStorageClassSmart}), location; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestCreateBucket_MissingParams(t *testing.T) {
+	if _, err := CreateBucket(aws.BackgroundContext(), s3.New(unit.Session), &CreateBucketInput{Bucket: "my-bucket"}); err == nil {
+		t.Errorf("expect error, got none")
+	}
+}
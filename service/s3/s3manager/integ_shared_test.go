@@ -38,6 +38,11 @@ func TestMain(m *testing.M) {
 		if err := s3integ.CleanupBucket(svc, *bucketName); err != nil {
 			fmt.Fprintln(os.Stderr, err)
 		}
+		// IBM COS SDK Code -- START
+		if err := integration.StopRecording(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		// IBM COS SDK Code -- END
 		if r := recover(); r != nil {
 			fmt.Fprintln(os.Stderr, "S3 integrationt tests paniced,", r)
 			result = 1
@@ -0,0 +1,389 @@
+package s3manager
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+)
+
+const (
+	// DefaultReplicationMonitorInterval is the interval between checks of
+	// ReplicationMonitor.Run.
+	DefaultReplicationMonitorInterval = 5 * time.Minute
+
+	// DefaultReplicationMaxReattempts is the number of replication reattempts
+	// triggered by ReplicationMonitor.Reattempt before giving up.
+	DefaultReplicationMaxReattempts = 3
+
+	// DefaultReplicationReattemptMinDelay is the delay after the first
+	// replication reattempt, doubled after each further reattempt.
+	DefaultReplicationReattemptMinDelay = 30 * time.Second
+
+	// DefaultReplicationReattemptMaxDelay is the longest delay between
+	// replication reattempts.
+	DefaultReplicationReattemptMaxDelay = 10 * time.Minute
+)
+
+const (
+	// ErrCodeReplicationFailuresRemain is the error code returned when objects
+	// still fail to replicate after the replication reattempts.
+	ErrCodeReplicationFailuresRemain = "ReplicationFailuresRemain"
+
+	// ErrCodeInvalidReplicationConfiguration is the error code returned when a
+	// replication configuration cannot be applied to the bucket.
+	ErrCodeInvalidReplicationConfiguration = "InvalidReplicationConfiguration"
+)
+
+// ReplicationFailureCauseUnknown is the cause failures without a
+// SyncFailureCause are classified under.
+const ReplicationFailureCauseUnknown = "Unknown"
+
+// ReplicationFailure is an object version that failed to replicate.
+type ReplicationFailure struct {
+	Key                string
+	VersionID          string
+	SyncType           string
+	Cause              string
+	FirstSyncAttempted time.Time
+	LastSyncAttempted  time.Time
+}
+
+// ReplicationReport is the replication failures of a bucket, classified by
+// failure cause.
+type ReplicationReport struct {
+	Bucket   string
+	Failures []ReplicationFailure
+	ByCause  map[string][]ReplicationFailure
+}
+
+// Causes returns the failure causes of the report, sorted.
+func (r *ReplicationReport) Causes() []string {
+	causes := make([]string, 0, len(r.ByCause))
+	for cause := range r.ByCause {
+		causes = append(causes, cause)
+	}
+	sort.Strings(causes)
+	return causes
+}
+
+// ReplicationEventType is the type of a ReplicationEvent.
+type ReplicationEventType int
+
+const (
+	// ReplicationFailuresListed is sent after the replication failures of
+	// the bucket have been listed.
+	ReplicationFailuresListed ReplicationEventType = iota
+
+	// ReplicationReattemptTriggered is sent after a replication reattempt
+	// has been triggered.
+	ReplicationReattemptTriggered
+
+	// ReplicationRecovered is sent when no replication failures remain after
+	// a reattempt.
+	ReplicationRecovered
+
+	// ReplicationReattemptsExhausted is sent when replication failures
+	// remain after the last reattempt.
+	ReplicationReattemptsExhausted
+
+	// ReplicationMonitorError is sent when a replication API call failed.
+	ReplicationMonitorError
+)
+
+// ReplicationEvent is sent to the OnEvent callback of the ReplicationMonitor.
+type ReplicationEvent struct {
+	Type   ReplicationEventType
+	Bucket string
+	Time   time.Time
+
+	// Reattempt number, zero before the first reattempt.
+	Attempt int
+
+	// Number of replication failures, in total and by cause, for
+	// ReplicationFailuresListed, ReplicationRecovered and
+	// ReplicationReattemptsExhausted events.
+	Failures        int
+	FailuresByCause map[string]int
+
+	// Error of ReplicationMonitorError events.
+	Err error
+}
+
+// ReplicationMonitor lists the replication failures of IBM COS buckets, and
+// triggers replication reattempts with backoff until the failures are
+// resolved.
+type ReplicationMonitor struct {
+	// The client to use when monitoring replication.
+	S3 s3iface.S3API
+
+	// Interval between checks of Run.
+	Interval time.Duration
+
+	// Number of replication reattempts triggered by Reattempt.
+	MaxReattempts int
+
+	// Delay after the first reattempt, doubled after each further reattempt
+	// up to ReattemptMaxDelay.
+	ReattemptMinDelay time.Duration
+	ReattemptMaxDelay time.Duration
+
+	// OnEvent, if set, is called with the events and metrics of the monitor.
+	// Must be safe to call from the goroutine of the monitor.
+	OnEvent func(ReplicationEvent)
+
+	// List of request options that will be passed down to individual API
+	// operation requests made by the monitor.
+	RequestOptions []request.Option
+}
+
+// NewReplicationMonitor creates a new ReplicationMonitor instance to monitor
+// replication with the client. Pass in additional functional options to
+// customize the monitor's behavior.
+//
+// Example:
+//
+//	monitor := s3manager.NewReplicationMonitor(s3.New(sess), func(m *s3manager.ReplicationMonitor) {
+//	    m.OnEvent = func(e s3manager.ReplicationEvent) {
+//	        log.Printf("%s: %d replication failures %v", e.Bucket, e.Failures, e.FailuresByCause)
+//	    }
+//	})
+//	err := monitor.Run(ctx, "my-bucket")
+func NewReplicationMonitor(svc s3iface.S3API, options ...func(*ReplicationMonitor)) *ReplicationMonitor {
+	m := &ReplicationMonitor{
+		S3:                svc,
+		Interval:          DefaultReplicationMonitorInterval,
+		MaxReattempts:     DefaultReplicationMaxReattempts,
+		ReattemptMinDelay: DefaultReplicationReattemptMinDelay,
+		ReattemptMaxDelay: DefaultReplicationReattemptMaxDelay,
+	}
+
+	for _, option := range options {
+		option(m)
+	}
+
+	return m
+}
+
+// Check pages through the replication failures of the bucket and returns
+// them classified by failure cause. If listing the failures fails, the report
+// of the failures listed before the error is returned along with it.
+func (m *ReplicationMonitor) Check(ctx aws.Context, bucket string) (*ReplicationReport, error) {
+	return m.check(ctx, bucket, 0)
+}
+
+func (m *ReplicationMonitor) check(ctx aws.Context, bucket string, attempt int) (*ReplicationReport, error) {
+	report := &ReplicationReport{
+		Bucket:  bucket,
+		ByCause: map[string][]ReplicationFailure{},
+	}
+
+	err := m.S3.ListBucketReplicationFailuresPagesWithContext(ctx,
+		&s3.ListBucketReplicationFailuresInput{Bucket: aws.String(bucket)},
+		func(page *s3.ListBucketReplicationFailuresOutput, lastPage bool) bool {
+			if page.ListReplicationFailureResult == nil {
+				return true
+			}
+			for _, obj := range page.ListReplicationFailureResult.Contents {
+				failure := ReplicationFailure{
+					Key:                aws.StringValue(obj.Key),
+					VersionID:          aws.StringValue(obj.VersionId),
+					SyncType:           aws.StringValue(obj.SyncType),
+					Cause:              aws.StringValue(obj.SyncFailureCause),
+					FirstSyncAttempted: aws.TimeValue(obj.FirstSyncAttempted),
+					LastSyncAttempted:  aws.TimeValue(obj.LastSyncAttempted),
+				}
+				cause := failure.Cause
+				if len(cause) == 0 {
+					cause = ReplicationFailureCauseUnknown
+				}
+				report.Failures = append(report.Failures, failure)
+				report.ByCause[cause] = append(report.ByCause[cause], failure)
+			}
+			return true
+		}, m.RequestOptions...)
+	if err != nil {
+		m.sendEvent(ReplicationEvent{Type: ReplicationMonitorError, Bucket: bucket, Attempt: attempt, Err: err})
+		return report, err
+	}
+
+	m.sendEvent(m.reportEvent(ReplicationFailuresListed, report, attempt))
+	return report, nil
+}
+
+// Reattempt triggers replication reattempts of the bucket while replication
+// failures remain, up to MaxReattempts, waiting with exponential backoff
+// between reattempts. Returns the last report of replication failures.
+//
+// Returns an error with code ErrCodeReplicationFailuresRemain, along with the
+// report, if failures remain after the last reattempt. Errors listing the
+// failures are returned along with the partial report, see Check.
+func (m *ReplicationMonitor) Reattempt(ctx aws.Context, bucket string) (*ReplicationReport, error) {
+	report, err := m.check(ctx, bucket, 0)
+	if err != nil || len(report.Failures) == 0 {
+		return report, err
+	}
+
+	delay := m.ReattemptMinDelay
+	for attempt := 1; attempt <= m.MaxReattempts; attempt++ {
+		_, err := m.S3.PutBucketReplicationReattemptWithContext(ctx,
+			&s3.PutBucketReplicationReattemptInput{Bucket: aws.String(bucket)},
+			m.RequestOptions...)
+		if err != nil {
+			m.sendEvent(ReplicationEvent{Type: ReplicationMonitorError, Bucket: bucket, Attempt: attempt, Err: err})
+			return report, err
+		}
+		m.sendEvent(ReplicationEvent{Type: ReplicationReattemptTriggered, Bucket: bucket, Attempt: attempt})
+
+		if err := aws.SleepWithContext(ctx, delay); err != nil {
+			return report, err
+		}
+		if delay *= 2; m.ReattemptMaxDelay > 0 && delay > m.ReattemptMaxDelay {
+			delay = m.ReattemptMaxDelay
+		}
+
+		if report, err = m.check(ctx, bucket, attempt); err != nil {
+			return report, err
+		}
+		if len(report.Failures) == 0 {
+			m.sendEvent(m.reportEvent(ReplicationRecovered, report, attempt))
+			return report, nil
+		}
+	}
+
+	m.sendEvent(m.reportEvent(ReplicationReattemptsExhausted, report, m.MaxReattempts))
+	return report, awserr.New(ErrCodeReplicationFailuresRemain,
+		"replication failures remain for bucket "+bucket+" after reattempts", nil)
+}
+
+// Run calls Reattempt for the bucket every Interval, until the context is
+// canceled. Errors are sent to OnEvent, Run only returns the error of the
+// context.
+func (m *ReplicationMonitor) Run(ctx aws.Context, bucket string) error {
+	interval := m.Interval
+	if interval <= 0 {
+		interval = DefaultReplicationMonitorInterval
+	}
+
+	for {
+		m.Reattempt(ctx, bucket)
+
+		if err := aws.SleepWithContext(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
+func (m *ReplicationMonitor) reportEvent(typ ReplicationEventType, report *ReplicationReport, attempt int) ReplicationEvent {
+	byCause := make(map[string]int, len(report.ByCause))
+	for cause, failures := range report.ByCause {
+		byCause[cause] = len(failures)
+	}
+
+	return ReplicationEvent{
+		Type:            typ,
+		Bucket:          report.Bucket,
+		Attempt:         attempt,
+		Failures:        len(report.Failures),
+		FailuresByCause: byCause,
+	}
+}
+
+func (m *ReplicationMonitor) sendEvent(e ReplicationEvent) {
+	if m.OnEvent == nil {
+		return
+	}
+	e.Time = time.Now()
+	m.OnEvent(e)
+}
+
+// ValidateReplicationConfiguration returns an error if the replication
+// configuration cannot be applied to the bucket, because versioning is not
+// enabled on the bucket or on the destination bucket of an enabled rule.
+//
+// Destination buckets may be given as an IBM COS bucket CRN, an S3 bucket
+// ARN or a bucket name. The client must be able to get the versioning state
+// of the destination buckets, e.g. with bucket location routing enabled for
+// destinations in other locations.
+func ValidateReplicationConfiguration(ctx aws.Context, svc s3iface.S3API, bucket string, cfg *s3.ReplicationConfiguration, opts ...request.Option) error {
+	if cfg == nil || len(cfg.Rules) == 0 {
+		return awserr.New(ErrCodeInvalidReplicationConfiguration,
+			"replication configuration must have at least one rule", nil)
+	}
+
+	if err := validateVersioningEnabled(ctx, svc, bucket, opts...); err != nil {
+		return err
+	}
+
+	checked := map[string]struct{}{}
+	for _, rule := range cfg.Rules {
+		if rule == nil || aws.StringValue(rule.Status) != s3.ReplicationRuleStatusEnabled {
+			continue
+		}
+		if rule.Destination == nil || len(aws.StringValue(rule.Destination.Bucket)) == 0 {
+			return awserr.New(ErrCodeInvalidReplicationConfiguration,
+				"replication rule "+aws.StringValue(rule.ID)+" has no destination bucket", nil)
+		}
+
+		dest := replicationDestinationBucket(aws.StringValue(rule.Destination.Bucket))
+		if dest == bucket {
+			return awserr.New(ErrCodeInvalidReplicationConfiguration,
+				"replication rule "+aws.StringValue(rule.ID)+" replicates bucket "+bucket+" to itself", nil)
+		}
+		if _, ok := checked[dest]; ok {
+			continue
+		}
+		checked[dest] = struct{}{}
+
+		if err := validateVersioningEnabled(ctx, svc, dest, opts...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PutBucketReplication validates the replication configuration with
+// ValidateReplicationConfiguration before setting it on the bucket.
+func PutBucketReplication(ctx aws.Context, svc s3iface.S3API, input *s3.PutBucketReplicationInput, opts ...request.Option) error {
+	err := ValidateReplicationConfiguration(ctx, svc, aws.StringValue(input.Bucket),
+		input.ReplicationConfiguration, opts...)
+	if err != nil {
+		return err
+	}
+
+	_, err = svc.PutBucketReplicationWithContext(ctx, input, opts...)
+	return err
+}
+
+func validateVersioningEnabled(ctx aws.Context, svc s3iface.S3API, bucket string, opts ...request.Option) error {
+	out, err := svc.GetBucketVersioningWithContext(ctx,
+		&s3.GetBucketVersioningInput{Bucket: aws.String(bucket)}, opts...)
+	if err != nil {
+		return err
+	}
+	if aws.StringValue(out.Status) != s3.BucketVersioningStatusEnabled {
+		return awserr.New(ErrCodeInvalidReplicationConfiguration,
+			"versioning must be enabled on bucket "+bucket+" for replication", nil)
+	}
+	return nil
+}
+
+// replicationDestinationBucket returns the bucket name of a replication
+// destination, e.g.
+// crn:v1:bluemix:public:cloud-object-storage:global:a/<account>:<instance>:bucket:<name>
+// or arn:aws:s3:::<name>.
+func replicationDestinationBucket(dest string) string {
+	if i := strings.LastIndex(dest, ":bucket:"); strings.HasPrefix(dest, "crn:") && i >= 0 {
+		return dest[i+len(":bucket:"):]
+	}
+	if strings.HasPrefix(dest, "arn:") {
+		return dest[strings.LastIndex(dest, ":")+1:]
+	}
+	return dest
+}
@@ -0,0 +1,306 @@
+package s3manager
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/awstesting/unit"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+const replicationFailuresPage1 = `<ListReplicationFailureResult>` +
+	`<Name>bucket</Name><KeyCount>2</KeyCount><IsTruncated>true</IsTruncated><NextContinuationToken>token1</NextContinuationToken>` +
+	`<Contents><Key>a</Key><VersionId>v1</VersionId><SyncType>PUT</SyncType><SyncFailureCause>AccessDenied</SyncFailureCause>` +
+	`<FirstSyncAttempted>2023-01-01T00:00:00Z</FirstSyncAttempted><LastSyncAttempted>2023-01-02T00:00:00Z</LastSyncAttempted></Contents>` +
+	`<Contents><Key>b</Key><VersionId>v1</VersionId><SyncType>PUT</SyncType><SyncFailureCause>AccessDenied</SyncFailureCause>` +
+	`<FirstSyncAttempted>2023-01-01T00:00:00Z</FirstSyncAttempted><LastSyncAttempted>2023-01-02T00:00:00Z</LastSyncAttempted></Contents>` +
+	`</ListReplicationFailureResult>`
+
+const replicationFailuresPage2 = `<ListReplicationFailureResult>` +
+	`<Name>bucket</Name><KeyCount>1</KeyCount><IsTruncated>false</IsTruncated>` +
+	`<Contents><Key>c</Key><VersionId>v2</VersionId><SyncType>DELETE</SyncType>` +
+	`<FirstSyncAttempted>2023-01-01T00:00:00Z</FirstSyncAttempted><LastSyncAttempted>2023-01-02T00:00:00Z</LastSyncAttempted></Contents>` +
+	`</ListReplicationFailureResult>`
+
+const replicationFailuresEmpty = `<ListReplicationFailureResult>` +
+	`<Name>bucket</Name><KeyCount>0</KeyCount><IsTruncated>false</IsTruncated>` +
+	`</ListReplicationFailureResult>`
+
+func newReplicationClient(handler func(r *request.Request) string) *s3.S3 {
+	svc := s3.New(unit.Session, &aws.Config{
+		Region:           aws.String("us-south"),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(handler(r)))),
+		}
+	})
+	return svc
+}
+
+func TestReplicationMonitorCheck(t *testing.T) {
+	var tokens []string
+	svc := newReplicationClient(func(r *request.Request) string {
+		token := r.HTTPRequest.URL.Query().Get("continuation-token")
+		tokens = append(tokens, token)
+		if len(token) == 0 {
+			return replicationFailuresPage1
+		}
+		return replicationFailuresPage2
+	})
+
+	var events []ReplicationEvent
+	monitor := NewReplicationMonitor(svc, func(m *ReplicationMonitor) {
+		m.OnEvent = func(e ReplicationEvent) { events = append(events, e) }
+	})
+
+	report, err := monitor.Check(aws.BackgroundContext(), "bucket")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := []string{"", "token1"}, tokens; len(e) != len(a) || e[0] != a[0] || e[1] != a[1] {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 3, len(report.Failures); e != a {
+		t.Fatalf("expect %v failures, got %v", e, a)
+	}
+	if e, a := []string{"AccessDenied", ReplicationFailureCauseUnknown}, report.Causes(); len(e) != len(a) || e[0] != a[0] || e[1] != a[1] {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "DELETE", report.ByCause[ReplicationFailureCauseUnknown][0].SyncType; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), report.Failures[0].LastSyncAttempted; !e.Equal(a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	if e, a := 1, len(events); e != a {
+		t.Fatalf("expect %v events, got %v", e, a)
+	}
+	if e, a := ReplicationFailuresListed, events[0].Type; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 2, events[0].FailuresByCause["AccessDenied"]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestReplicationMonitorReattempt(t *testing.T) {
+	cases := map[string]struct {
+		RecoverAfter   int
+		ExpectErr      bool
+		ExpectLast     ReplicationEventType
+		ExpectAttempts int
+	}{
+		"recovered": {
+			RecoverAfter:   2,
+			ExpectLast:     ReplicationRecovered,
+			ExpectAttempts: 2,
+		},
+		"exhausted": {
+			RecoverAfter:   5,
+			ExpectErr:      true,
+			ExpectLast:     ReplicationReattemptsExhausted,
+			ExpectAttempts: 3,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			attempts := 0
+			svc := newReplicationClient(func(r *request.Request) string {
+				switch r.Operation.Name {
+				case "PutBucketReplicationReattempt":
+					attempts++
+				case "ListBucketReplicationFailures":
+					if attempts >= c.RecoverAfter {
+						return replicationFailuresEmpty
+					}
+					return replicationFailuresPage2
+				}
+				return ""
+			})
+
+			var events []ReplicationEvent
+			monitor := NewReplicationMonitor(svc, func(m *ReplicationMonitor) {
+				m.ReattemptMinDelay = time.Millisecond
+				m.ReattemptMaxDelay = 2 * time.Millisecond
+				m.OnEvent = func(e ReplicationEvent) { events = append(events, e) }
+			})
+
+			report, err := monitor.Reattempt(aws.BackgroundContext(), "bucket")
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				if e, a := ErrCodeReplicationFailuresRemain, err.(awserr.Error).Code(); e != a {
+					t.Errorf("expect %v, got %v", e, a)
+				}
+				if e, a := 1, len(report.Failures); e != a {
+					t.Errorf("expect %v failures, got %v", e, a)
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectAttempts, attempts; e != a {
+				t.Errorf("expect %v reattempts, got %v", e, a)
+			}
+			if e, a := c.ExpectLast, events[len(events)-1].Type; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestReplicationMonitorReattempt_CheckError(t *testing.T) {
+	attempts := 0
+	svc := newReplicationClient(func(r *request.Request) string {
+		switch r.Operation.Name {
+		case "PutBucketReplicationReattempt":
+			attempts++
+		case "ListBucketReplicationFailures":
+			if len(r.HTTPRequest.URL.Query().Get("continuation-token")) == 0 {
+				return replicationFailuresPage1
+			}
+			if attempts > 0 {
+				return `<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`
+			}
+			return replicationFailuresPage2
+		}
+		return ""
+	})
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		if attempts > 0 && len(r.HTTPRequest.URL.Query().Get("continuation-token")) != 0 {
+			r.HTTPResponse.StatusCode = 403
+		}
+	})
+
+	monitor := NewReplicationMonitor(svc, func(m *ReplicationMonitor) {
+		m.ReattemptMinDelay = time.Millisecond
+	})
+
+	report, err := monitor.Reattempt(aws.BackgroundContext(), "bucket")
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != "AccessDenied" {
+		t.Fatalf("expect AccessDenied error, got %v", err)
+	}
+	if e, a := 1, attempts; e != a {
+		t.Errorf("expect %v reattempts, got %v", e, a)
+	}
+	if report == nil {
+		t.Fatalf("expect partial report, got none")
+	}
+	if e, a := 2, len(report.Failures); e != a {
+		t.Errorf("expect %v failures, got %v", e, a)
+	}
+}
+
+func TestReplicationMonitorReattempt_NoFailures(t *testing.T) {
+	svc := newReplicationClient(func(r *request.Request) string {
+		if r.Operation.Name == "PutBucketReplicationReattempt" {
+			t.Errorf("expect no reattempt")
+		}
+		return replicationFailuresEmpty
+	})
+
+	report, err := NewReplicationMonitor(svc).Reattempt(aws.BackgroundContext(), "bucket")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 0, len(report.Failures); e != a {
+		t.Errorf("expect %v failures, got %v", e, a)
+	}
+}
+
+func TestPutBucketReplication(t *testing.T) {
+	cases := map[string]struct {
+		Versioning map[string]string
+		Dest       string
+		ExpectErr  bool
+	}{
+		"crn destination": {
+			Versioning: map[string]string{"src": "Enabled", "dest": "Enabled"},
+			Dest:       "crn:v1:bluemix:public:cloud-object-storage:global:a/acc1:inst1:bucket:dest",
+		},
+		"arn destination": {
+			Versioning: map[string]string{"src": "Enabled", "dest": "Enabled"},
+			Dest:       "arn:aws:s3:::dest",
+		},
+		"destination not versioned": {
+			Versioning: map[string]string{"src": "Enabled", "dest": "Suspended"},
+			Dest:       "dest",
+			ExpectErr:  true,
+		},
+		"source not versioned": {
+			Versioning: map[string]string{"dest": "Enabled"},
+			Dest:       "dest",
+			ExpectErr:  true,
+		},
+		"replicate to itself": {
+			Versioning: map[string]string{"src": "Enabled"},
+			Dest:       "arn:aws:s3:::src",
+			ExpectErr:  true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			put := false
+			svc := newReplicationClient(func(r *request.Request) string {
+				switch r.Operation.Name {
+				case "GetBucketVersioning":
+					bucket := aws.StringValue(r.Params.(*s3.GetBucketVersioningInput).Bucket)
+					return `<VersioningConfiguration><Status>` + c.Versioning[bucket] + `</Status></VersioningConfiguration>`
+				case "PutBucketReplication":
+					put = true
+				}
+				return ""
+			})
+
+			err := PutBucketReplication(aws.BackgroundContext(), svc, &s3.PutBucketReplicationInput{
+				Bucket: aws.String("src"),
+				ReplicationConfiguration: &s3.ReplicationConfiguration{
+					Rules: []*s3.ReplicationRule{{
+						ID:          aws.String("rule1"),
+						Status:      aws.String(s3.ReplicationRuleStatusEnabled),
+						Priority:    aws.Int64(1),
+						Filter:      &s3.ReplicationRuleFilter{},
+						Destination: &s3.Destination{Bucket: aws.String(c.Dest)},
+						DeleteMarkerReplication: &s3.DeleteMarkerReplication{
+							Status: aws.String(s3.DeleteMarkerReplicationStatusDisabled),
+						},
+					}},
+				},
+			})
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				if e, a := ErrCodeInvalidReplicationConfiguration, err.(awserr.Error).Code(); e != a {
+					t.Errorf("expect %v, got %v", e, a)
+				}
+				if put {
+					t.Errorf("expect replication not put")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if !put {
+				t.Errorf("expect replication put")
+			}
+		})
+	}
+}
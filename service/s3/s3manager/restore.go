@@ -0,0 +1,386 @@
+package s3manager
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+)
+
+const (
+	// DefaultRestoreConcurrency is the number of RestoreObject and HeadObject
+	// requests the RestoreManager sends in parallel.
+	DefaultRestoreConcurrency = 10
+
+	// DefaultRestoreDays is the number of days restored objects stay
+	// readable.
+	DefaultRestoreDays = 1
+
+	// DefaultRestorePollInterval is the interval between checks of the
+	// restores in progress.
+	DefaultRestorePollInterval = 5 * time.Minute
+)
+
+// ErrCodeBatchRestore is the error code of the BatchError returned when
+// objects failed to restore.
+const ErrCodeBatchRestore = "BatchedRestoreIncomplete"
+
+// errCodeRestoreAlreadyInProgress is returned by RestoreObject when a restore
+// of the object has already been requested.
+const errCodeRestoreAlreadyInProgress = "RestoreAlreadyInProgress"
+
+// RestoreObjectStatus is the status of the restore of an object.
+type RestoreObjectStatus string
+
+// Restore statuses of RestoreObjectState.
+const (
+	// RestorePending objects have not been requested to restore yet.
+	RestorePending RestoreObjectStatus = "PENDING"
+
+	// RestoreInProgress objects are being restored.
+	RestoreInProgress RestoreObjectStatus = "IN_PROGRESS"
+
+	// RestoreRestored objects are readable.
+	RestoreRestored RestoreObjectStatus = "RESTORED"
+
+	// RestoreFailed objects failed to restore, and are requested to restore
+	// again when the RestoreState is resumed.
+	RestoreFailed RestoreObjectStatus = "FAILED"
+)
+
+// RestoreObjectState is the restore state of an archived object.
+type RestoreObjectState struct {
+	Key       string              `json:"key"`
+	VersionID string              `json:"version_id,omitempty"`
+	Status    RestoreObjectStatus `json:"status"`
+
+	// Error message of RestoreFailed objects.
+	Error string `json:"error,omitempty"`
+
+	// Time the restored copy of RestoreRestored objects expires.
+	ExpiryDate time.Time `json:"expiry_date,omitempty"`
+}
+
+// RestoreState is the state of a bulk restore of RestoreManager. The state
+// can be saved as JSON, e.g. in the OnCheckpoint callback, and the restore
+// resumed with the unmarshaled state.
+type RestoreState struct {
+	Bucket  string                `json:"bucket"`
+	Objects []*RestoreObjectState `json:"objects"`
+
+	m sync.Mutex
+}
+
+// NewRestoreState returns the state of a restore of the keys of the bucket,
+// pending restore.
+func NewRestoreState(bucket string, keys ...string) *RestoreState {
+	state := &RestoreState{Bucket: bucket}
+	for _, key := range keys {
+		state.Objects = append(state.Objects, &RestoreObjectState{Key: key, Status: RestorePending})
+	}
+	return state
+}
+
+// MarshalJSON marshals the state, safe to call while the restore is running.
+func (s *RestoreState) MarshalJSON() ([]byte, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	type restoreState RestoreState
+	return json.Marshal((*restoreState)(s))
+}
+
+// Count returns the number of objects with the status.
+func (s *RestoreState) Count(status RestoreObjectStatus) int {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	n := 0
+	for _, obj := range s.Objects {
+		if obj.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *RestoreState) withStatus(status RestoreObjectStatus) []*RestoreObjectState {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	var objs []*RestoreObjectState
+	for _, obj := range s.Objects {
+		if obj.Status == status {
+			objs = append(objs, obj)
+		}
+	}
+	return objs
+}
+
+func (s *RestoreState) update(obj *RestoreObjectState, fn func(*RestoreObjectState)) {
+	s.m.Lock()
+	fn(obj)
+	s.m.Unlock()
+}
+
+// RestoreManager restores archived objects in bulk, polling their restore
+// status until they are readable.
+type RestoreManager struct {
+	// The client to use when restoring objects.
+	S3 s3iface.S3API
+
+	// Number of RestoreObject and HeadObject requests sent in parallel.
+	Concurrency int
+
+	// Number of days the restored objects stay readable.
+	Days int64
+
+	// Restore tier, one of the s3.Tier values. Defaults to the tier of the
+	// bucket's archive rule when empty.
+	Tier string
+
+	// Interval between checks of the restores in progress.
+	PollInterval time.Duration
+
+	// OnRestored, if set, is called once for each object when it becomes
+	// readable.
+	OnRestored func(bucket string, obj RestoreObjectState)
+
+	// OnCheckpoint, if set, is called with the state after each round of
+	// requests, e.g. to save it for resuming the restore.
+	OnCheckpoint func(*RestoreState)
+
+	// List of request options that will be passed down to individual API
+	// operation requests made by the manager.
+	RequestOptions []request.Option
+}
+
+// NewRestoreManager creates a new RestoreManager instance to restore archived
+// objects with the client. Pass in additional functional options to customize
+// the manager's behavior.
+//
+// Example:
+//
+//	manager := s3manager.NewRestoreManager(s3.New(sess), func(m *s3manager.RestoreManager) {
+//	    m.Days = 7
+//	    m.OnRestored = func(bucket string, obj s3manager.RestoreObjectState) {
+//	        log.Printf("%s/%s readable until %v", bucket, obj.Key, obj.ExpiryDate)
+//	    }
+//	})
+//	err := manager.Restore(ctx, s3manager.NewRestoreState("my-bucket", keys...))
+func NewRestoreManager(svc s3iface.S3API, options ...func(*RestoreManager)) *RestoreManager {
+	m := &RestoreManager{
+		S3:           svc,
+		Concurrency:  DefaultRestoreConcurrency,
+		Days:         DefaultRestoreDays,
+		PollInterval: DefaultRestorePollInterval,
+	}
+
+	for _, option := range options {
+		option(m)
+	}
+
+	return m
+}
+
+// Restore requests the restore of the pending and failed objects of the
+// state, and polls the objects in progress until they are restored. The state
+// is updated as the restore progresses.
+//
+// Failing to check the status of a restore in progress is not a restore
+// failure, the status is checked again after PollInterval until the context
+// is done.
+//
+// Returns a BatchError with the objects that failed to restore, or the
+// context's error if it is canceled. The state of the objects not requested
+// or checked before the context was canceled is left unchanged, so the state
+// can be resumed.
+func (m *RestoreManager) Restore(ctx aws.Context, state *RestoreState) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for _, obj := range state.withStatus(RestoreFailed) {
+		state.update(obj, func(obj *RestoreObjectState) {
+			obj.Status = RestorePending
+			obj.Error = ""
+		})
+	}
+
+	restored := m.forEach(ctx, state, state.withStatus(RestorePending), m.requestRestore)
+	m.checkpoint(state, restored)
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for {
+		inProgress := state.withStatus(RestoreInProgress)
+		if len(inProgress) == 0 {
+			break
+		}
+		if err := aws.SleepWithContext(ctx, m.PollInterval); err != nil {
+			return err
+		}
+
+		restored := m.forEach(ctx, state, inProgress, m.checkRestore)
+		m.checkpoint(state, restored)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+
+	var errs []Error
+	for _, obj := range state.withStatus(RestoreFailed) {
+		errs = append(errs, newError(awserr.New(ErrCodeBatchRestore, obj.Error, nil),
+			aws.String(state.Bucket), aws.String(obj.Key)))
+	}
+	if len(errs) != 0 {
+		return NewBatchError(ErrCodeBatchRestore, "some objects failed to restore", errs)
+	}
+	return nil
+}
+
+// forEach calls fn for each of the objects with the manager's concurrency,
+// until the context is done, and returns the objects that became readable.
+func (m *RestoreManager) forEach(ctx aws.Context, state *RestoreState, objs []*RestoreObjectState,
+	fn func(aws.Context, *RestoreState, *RestoreObjectState)) []*RestoreObjectState {
+	concurrency := m.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultRestoreConcurrency
+	}
+
+	ch := make(chan *RestoreObjectState)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for obj := range ch {
+				fn(ctx, state, obj)
+			}
+		}()
+	}
+dispatch:
+	for _, obj := range objs {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case ch <- obj:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(ch)
+	wg.Wait()
+
+	var restored []*RestoreObjectState
+	for _, obj := range objs {
+		if obj.Status == RestoreRestored {
+			restored = append(restored, obj)
+		}
+	}
+	return restored
+}
+
+func (m *RestoreManager) requestRestore(ctx aws.Context, state *RestoreState, obj *RestoreObjectState) {
+	input := &s3.RestoreObjectInput{
+		Bucket:         aws.String(state.Bucket),
+		Key:            aws.String(obj.Key),
+		RestoreRequest: &s3.RestoreRequest{Days: aws.Int64(m.Days)},
+	}
+	if len(obj.VersionID) != 0 {
+		input.VersionId = aws.String(obj.VersionID)
+	}
+	if len(m.Tier) != 0 {
+		input.RestoreRequest.GlacierJobParameters = &s3.GlacierJobParameters{Tier: aws.String(m.Tier)}
+	}
+
+	_, err := m.S3.RestoreObjectWithContext(ctx, input, m.RequestOptions...)
+	if err != nil && ctx.Err() != nil {
+		return // canceled, the object is still pending
+	}
+	state.update(obj, func(obj *RestoreObjectState) {
+		switch code := errCode(err); {
+		case err == nil, code == errCodeRestoreAlreadyInProgress:
+			obj.Status = RestoreInProgress
+		case code == s3.ErrCodeObjectAlreadyInActiveTierError:
+			obj.Status = RestoreRestored
+		default:
+			obj.Status = RestoreFailed
+			obj.Error = err.Error()
+		}
+	})
+}
+
+func (m *RestoreManager) checkRestore(ctx aws.Context, state *RestoreState, obj *RestoreObjectState) {
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(state.Bucket),
+		Key:    aws.String(obj.Key),
+	}
+	if len(obj.VersionID) != 0 {
+		input.VersionId = aws.String(obj.VersionID)
+	}
+
+	out, err := m.S3.HeadObjectWithContext(ctx, input, m.RequestOptions...)
+	if err != nil && (ctx.Err() != nil || !isObjectNotFound(err)) {
+		return // status is checked again in the next round
+	}
+
+	var status s3.RestoreStatus
+	if err == nil {
+		if out.Restore == nil {
+			err = awserr.New(s3.ErrCodeObjectRestoreNotRequested, "object has no restore status", nil)
+		} else {
+			status, err = s3.ParseRestoreStatus(aws.StringValue(out.Restore))
+		}
+	}
+
+	state.update(obj, func(obj *RestoreObjectState) {
+		switch {
+		case err != nil:
+			obj.Status = RestoreFailed
+			obj.Error = err.Error()
+		case !status.Ongoing:
+			obj.Status = RestoreRestored
+			obj.ExpiryDate = status.ExpiryDate
+		}
+	})
+}
+
+func (m *RestoreManager) checkpoint(state *RestoreState, restored []*RestoreObjectState) {
+	if m.OnRestored != nil {
+		for _, obj := range restored {
+			m.OnRestored(state.Bucket, *obj)
+		}
+	}
+	if m.OnCheckpoint != nil {
+		m.OnCheckpoint(state)
+	}
+}
+
+// isObjectNotFound returns if the HeadObject error is for an object that does
+// not exist, which cannot be restored.
+func isObjectNotFound(err error) bool {
+	if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() == http.StatusNotFound {
+		return true
+	}
+	switch errCode(err) {
+	case s3.ErrCodeNoSuchKey, "NotFound":
+		return true
+	}
+	return false
+}
+
+func errCode(err error) string {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code()
+	}
+	return ""
+}
@@ -0,0 +1,281 @@
+package s3manager
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+)
+
+type restoreClient struct {
+	s3iface.S3API
+
+	m sync.Mutex
+
+	// Restore errors by key
+	restoreErrs map[string]error
+
+	// Restore headers returned by successive HeadObject calls, by key
+	heads map[string][]string
+
+	// Errors returned by successive HeadObject calls, by key, before the
+	// restore headers
+	headErrs map[string][]error
+
+	// Called with the key of each RestoreObject call
+	onRestore func(key string)
+
+	restored []*s3.RestoreObjectInput
+}
+
+func (c *restoreClient) RestoreObjectWithContext(ctx aws.Context, input *s3.RestoreObjectInput, opts ...request.Option) (*s3.RestoreObjectOutput, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.restored = append(c.restored, input)
+	if c.onRestore != nil {
+		c.onRestore(aws.StringValue(input.Key))
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, awserr.New(request.CanceledErrorCode, "request context canceled", err)
+	}
+	if err := c.restoreErrs[aws.StringValue(input.Key)]; err != nil {
+		return nil, err
+	}
+	return &s3.RestoreObjectOutput{}, nil
+}
+
+func (c *restoreClient) HeadObjectWithContext(ctx aws.Context, input *s3.HeadObjectInput, opts ...request.Option) (*s3.HeadObjectOutput, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	key := aws.StringValue(input.Key)
+	if errs := c.headErrs[key]; len(errs) != 0 {
+		c.headErrs[key] = errs[1:]
+		return nil, errs[0]
+	}
+	heads := c.heads[key]
+	if len(heads) == 0 {
+		return &s3.HeadObjectOutput{}, nil
+	}
+	if len(heads) > 1 {
+		c.heads[key] = heads[1:]
+	}
+	return &s3.HeadObjectOutput{Restore: aws.String(heads[0])}, nil
+}
+
+const restoredHeader = `ongoing-request="false", expiry-date="Wed, 07 Nov 2018 00:00:00 GMT"`
+
+func TestRestoreManager(t *testing.T) {
+	client := &restoreClient{
+		restoreErrs: map[string]error{
+			"active":   awserr.New(s3.ErrCodeObjectAlreadyInActiveTierError, "already in active tier", nil),
+			"inflight": awserr.New("RestoreAlreadyInProgress", "restore in progress", nil),
+			"denied":   awserr.New("AccessDenied", "access denied", nil),
+		},
+		heads: map[string][]string{
+			"a":        {`ongoing-request="true"`, `ongoing-request="true"`, restoredHeader},
+			"inflight": {restoredHeader},
+		},
+	}
+
+	var restored []string
+	checkpoints := 0
+	m := NewRestoreManager(client, func(m *RestoreManager) {
+		m.Days = 3
+		m.Tier = s3.TierBulk
+		m.PollInterval = 0
+		m.OnRestored = func(bucket string, obj RestoreObjectState) {
+			restored = append(restored, obj.Key)
+		}
+		m.OnCheckpoint = func(*RestoreState) { checkpoints++ }
+	})
+
+	state := NewRestoreState("bucket", "a", "active", "inflight", "denied")
+	err := m.Restore(aws.BackgroundContext(), state)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("expect *BatchError, got %T", err)
+	}
+	if e, a := 1, len(batchErr.Errors); e != a {
+		t.Fatalf("expect %v errors, got %v", e, a)
+	}
+	if e, a := "denied", aws.StringValue(batchErr.Errors[0].Key); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	expectStatus := map[string]RestoreObjectStatus{
+		"a":        RestoreRestored,
+		"active":   RestoreRestored,
+		"inflight": RestoreRestored,
+		"denied":   RestoreFailed,
+	}
+	for _, obj := range state.Objects {
+		if e, a := expectStatus[obj.Key], obj.Status; e != a {
+			t.Errorf("%s, expect %v, got %v", obj.Key, e, a)
+		}
+	}
+	if e, a := 2018, state.Objects[0].ExpiryDate.Year(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	expectRestored := []string{"active", "inflight", "a"}
+	if e, a := len(expectRestored), len(restored); e != a {
+		t.Fatalf("expect %v restored, got %v", e, a)
+	}
+	for i, e := range expectRestored {
+		if a := restored[i]; e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+	if e, a := 4, checkpoints; e != a {
+		t.Errorf("expect %v checkpoints, got %v", e, a)
+	}
+
+	for _, input := range client.restored {
+		if e, a := int64(3), aws.Int64Value(input.RestoreRequest.Days); e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+		if e, a := s3.TierBulk, aws.StringValue(input.RestoreRequest.GlacierJobParameters.Tier); e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	}
+}
+
+func TestRestoreManager_Resume(t *testing.T) {
+	state := NewRestoreState("bucket", "done", "polling", "failed")
+	state.Objects[0].Status = RestoreRestored
+	state.Objects[1].Status = RestoreInProgress
+	state.Objects[2].Status = RestoreFailed
+	state.Objects[2].Error = "access denied"
+
+	b, err := json.Marshal(state)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	resumed := &RestoreState{}
+	if err := json.Unmarshal(b, resumed); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	client := &restoreClient{
+		heads: map[string][]string{
+			"polling": {restoredHeader},
+			"failed":  {restoredHeader},
+		},
+	}
+	m := NewRestoreManager(client, func(m *RestoreManager) {
+		m.PollInterval = 0
+	})
+	if err := m.Restore(aws.BackgroundContext(), resumed); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 1, len(client.restored); e != a {
+		t.Fatalf("expect %v restore requests, got %v", e, a)
+	}
+	if e, a := "failed", aws.StringValue(client.restored[0].Key); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 3, resumed.Count(RestoreRestored); e != a {
+		t.Errorf("expect %v restored, got %v", e, a)
+	}
+	if e, a := "", resumed.Objects[2].Error; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestRestoreManager_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &restoreClient{
+		onRestore: func(key string) {
+			if key == "b" {
+				cancel()
+			}
+		},
+	}
+	checkpoints := 0
+	m := NewRestoreManager(client, func(m *RestoreManager) {
+		m.Concurrency = 1
+		m.PollInterval = 0
+		m.OnCheckpoint = func(*RestoreState) { checkpoints++ }
+	})
+
+	state := NewRestoreState("bucket", "a", "b", "c", "d")
+	err := m.Restore(ctx, state)
+	if e, a := context.Canceled, err; e != a {
+		t.Fatalf("expect %v error, got %v", e, a)
+	}
+
+	expectStatus := map[string]RestoreObjectStatus{
+		"a": RestoreInProgress,
+		"b": RestorePending,
+		"c": RestorePending,
+		"d": RestorePending,
+	}
+	for _, obj := range state.Objects {
+		if e, a := expectStatus[obj.Key], obj.Status; e != a {
+			t.Errorf("%s, expect %v, got %v", obj.Key, e, a)
+		}
+		if e, a := "", obj.Error; e != a {
+			t.Errorf("%s, expect %v, got %v", obj.Key, e, a)
+		}
+	}
+	if n := len(client.restored); n > 3 {
+		t.Errorf("expect no restore requests after cancel, got %v", n)
+	}
+	if e, a := 1, checkpoints; e != a {
+		t.Errorf("expect %v checkpoints, got %v", e, a)
+	}
+}
+
+func TestRestoreManager_StatusCheckError(t *testing.T) {
+	client := &restoreClient{
+		headErrs: map[string][]error{
+			"a": {
+				awserr.NewRequestFailure(awserr.New("InternalError", "internal error", nil), 500, "req-1"),
+				awserr.New(request.ErrCodeRequestError, "send request failed", nil),
+			},
+			"gone": {
+				awserr.NewRequestFailure(awserr.New("NotFound", "Not Found", nil), 404, "req-2"),
+			},
+		},
+		heads: map[string][]string{
+			"a": {restoredHeader},
+		},
+	}
+	m := NewRestoreManager(client, func(m *RestoreManager) {
+		m.PollInterval = 0
+	})
+
+	state := NewRestoreState("bucket", "a", "gone")
+	err := m.Restore(aws.BackgroundContext(), state)
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("expect *BatchError, got %T, %v", err, err)
+	}
+	if e, a := 1, len(batchErr.Errors); e != a {
+		t.Fatalf("expect %v errors, got %v", e, a)
+	}
+	if e, a := "gone", aws.StringValue(batchErr.Errors[0].Key); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	if e, a := RestoreRestored, state.Objects[0].Status; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := RestoreFailed, state.Objects[1].Status; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
@@ -0,0 +1,76 @@
+package s3manageriface_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager/s3manageriface"
+)
+
+func TestFakeUploader(t *testing.T) {
+	fake := &s3manageriface.FakeUploader{
+		UploadFunc: func(input *s3manager.UploadInput, _ ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
+			return &s3manager.UploadOutput{Location: "/bucket/" + aws.StringValue(input.Key)}, nil
+		},
+	}
+
+	var uploader s3manageriface.UploaderAPI = fake
+	out, err := uploader.UploadWithContext(aws.BackgroundContext(), &s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "/bucket/key", out.Location; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	err = fake.UploadWithIterator(aws.BackgroundContext(), &s3manager.UploadObjectsIterator{})
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != s3manageriface.ErrCodeFakeNotImplemented {
+		t.Errorf("expect %v error, got %v", s3manageriface.ErrCodeFakeNotImplemented, err)
+	}
+
+	calls := fake.Calls()
+	if e, a := 2, len(calls); e != a {
+		t.Fatalf("expect %v calls, got %v", e, a)
+	}
+	if e, a := "UploadWithContext", calls[0].Method; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "key", aws.StringValue(calls[0].Args[1].(*s3manager.UploadInput).Key); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestFakeDownloader(t *testing.T) {
+	fake := &s3manageriface.FakeDownloader{}
+
+	buf := aws.NewWriteAtBuffer(nil)
+	_, err := fake.Download(buf, &s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != s3manageriface.ErrCodeFakeNotImplemented {
+		t.Errorf("expect %v error, got %v", s3manageriface.ErrCodeFakeNotImplemented, err)
+	}
+
+	fake.DownloadWithContextFunc = func(_ aws.Context, w io.WriterAt, _ *s3.GetObjectInput, _ ...func(*s3manager.Downloader)) (int64, error) {
+		n, err := w.WriteAt([]byte("data"), 0)
+		return int64(n), err
+	}
+	n, err := fake.Download(buf, &s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int64(4), n; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "data", string(buf.Bytes()); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 2, len(fake.CallsTo("Download")); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
@@ -0,0 +1,3 @@
+package s3manageriface
+
+//go:generate go run -tags codegen ../../../../private/model/cli/gen-iface-fakes/main.go -in interface.go -out fake.go FakeUploader=UploaderAPI,UploadWithIterator FakeDownloader=DownloaderAPI,DownloadWithIterator
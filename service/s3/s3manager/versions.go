@@ -0,0 +1,410 @@
+package s3manager
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+)
+
+// MaxCopyObjectSize is the largest object CopyObject can copy. Larger object
+// versions are restored with a multipart copy.
+const MaxCopyObjectSize int64 = 5 * 1024 * 1024 * 1024
+
+// DefaultCopyPartSize is the part size of multipart copies.
+const DefaultCopyPartSize int64 = 500 * 1024 * 1024
+
+// ErrCodeBatchVersionActions is the error code of the BatchError returned when
+// some actions of a VersionPlan failed.
+const ErrCodeBatchVersionActions = "BatchedVersionActionsIncomplete"
+
+// VersionActionType is the type of a VersionAction.
+type VersionActionType int
+
+const (
+	// VersionActionCopy copies SourceVersionID over the object, making the
+	// copy the current version.
+	VersionActionCopy VersionActionType = iota
+
+	// VersionActionDeleteVersion permanently deletes the object version or
+	// delete marker VersionID.
+	VersionActionDeleteVersion
+
+	// VersionActionDeleteObject deletes the object, adding a delete marker
+	// in front of its current version.
+	VersionActionDeleteObject
+)
+
+// String returns the name of the action type.
+func (t VersionActionType) String() string {
+	switch t {
+	case VersionActionCopy:
+		return "Copy"
+	case VersionActionDeleteVersion:
+		return "DeleteVersion"
+	case VersionActionDeleteObject:
+		return "DeleteObject"
+	default:
+		return "Unknown"
+	}
+}
+
+// VersionAction is an action of a VersionPlan on an object.
+type VersionAction struct {
+	Type VersionActionType
+	Key  string
+
+	// Version, or delete marker, deleted by VersionActionDeleteVersion.
+	VersionID string
+
+	// Version, and its size, copied by VersionActionCopy.
+	SourceVersionID string
+	Size            int64
+}
+
+// String returns a description of the action.
+func (a VersionAction) String() string {
+	switch a.Type {
+	case VersionActionCopy:
+		return fmt.Sprintf("copy %s version %s", a.Key, a.SourceVersionID)
+	case VersionActionDeleteVersion:
+		return fmt.Sprintf("delete %s version %s", a.Key, a.VersionID)
+	default:
+		return fmt.Sprintf("delete %s", a.Key)
+	}
+}
+
+// VersionPlan is the actions restoring, or purging, the versions of objects
+// in a bucket. Plans are returned by PlanRestoreVersions and
+// PlanPurgeVersions, and can be reviewed as a dry run before calling
+// Execute.
+type VersionPlan struct {
+	Bucket  string
+	Actions []VersionAction
+
+	// Part size of multipart copies, defaults to DefaultCopyPartSize.
+	CopyPartSize int64
+}
+
+// RestoreVersionsInput provides the parameters for PlanRestoreVersions.
+type RestoreVersionsInput struct {
+	// Bucket of the objects to restore. Versioning must be enabled.
+	Bucket string
+
+	// Prefix of the keys of the objects to restore.
+	Prefix string
+
+	// Time to restore the objects to.
+	Time time.Time
+}
+
+// PurgeVersionsInput provides the parameters for PlanPurgeVersions.
+type PurgeVersionsInput struct {
+	// Bucket of the objects to purge.
+	Bucket string
+
+	// Prefix of the keys of the objects to purge.
+	Prefix string
+
+	// Number of newest noncurrent versions, and delete markers, kept for each
+	// object.
+	KeepNoncurrent int
+}
+
+// versionEntry is an object version or delete marker of a listing.
+type versionEntry struct {
+	versionID    string
+	lastModified time.Time
+	size         int64
+	isLatest     bool
+	deleteMarker bool
+}
+
+// PlanRestoreVersions returns the plan restoring the objects under the prefix
+// to their state at the time:
+//
+//   - objects whose version at the time is only hidden by newer delete
+//     markers have the delete markers deleted
+//   - objects whose version at the time was overwritten have the version
+//     copied back
+//   - objects that did not exist, or were deleted, at the time are deleted
+//
+// Newer versions are kept as noncurrent versions.
+func PlanRestoreVersions(ctx aws.Context, svc s3iface.S3API, input *RestoreVersionsInput, opts ...request.Option) (*VersionPlan, error) {
+	versions, err := listVersions(ctx, svc, input.Bucket, input.Prefix, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &VersionPlan{Bucket: input.Bucket}
+	for _, key := range sortedVersionKeys(versions) {
+		entries := versions[key]
+		current := entries[0]
+
+		// Newest entry at the time, entries are sorted newest first
+		var target *versionEntry
+		newer := 0
+		for i := range entries {
+			if !entries[i].lastModified.After(input.Time) {
+				target = &entries[i]
+				break
+			}
+			newer++
+		}
+
+		switch {
+		case target == nil || target.deleteMarker:
+			if !current.deleteMarker {
+				plan.Actions = append(plan.Actions, VersionAction{Type: VersionActionDeleteObject, Key: key})
+			}
+		case target.versionID == current.versionID:
+		case onlyDeleteMarkers(entries[:newer]):
+			for _, e := range entries[:newer] {
+				plan.Actions = append(plan.Actions, VersionAction{
+					Type:      VersionActionDeleteVersion,
+					Key:       key,
+					VersionID: e.versionID,
+				})
+			}
+		default:
+			plan.Actions = append(plan.Actions, VersionAction{
+				Type:            VersionActionCopy,
+				Key:             key,
+				SourceVersionID: target.versionID,
+				Size:            target.size,
+			})
+		}
+	}
+
+	return plan, nil
+}
+
+// PlanPurgeVersions returns the plan permanently deleting the noncurrent
+// versions, and delete markers, of the objects under the prefix beyond the
+// KeepNoncurrent newest. Current versions are never deleted.
+func PlanPurgeVersions(ctx aws.Context, svc s3iface.S3API, input *PurgeVersionsInput, opts ...request.Option) (*VersionPlan, error) {
+	versions, err := listVersions(ctx, svc, input.Bucket, input.Prefix, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &VersionPlan{Bucket: input.Bucket}
+	for _, key := range sortedVersionKeys(versions) {
+		noncurrent := 0
+		for _, e := range versions[key][1:] {
+			if noncurrent++; noncurrent <= input.KeepNoncurrent {
+				continue
+			}
+			plan.Actions = append(plan.Actions, VersionAction{
+				Type:      VersionActionDeleteVersion,
+				Key:       key,
+				VersionID: e.versionID,
+			})
+		}
+	}
+
+	return plan, nil
+}
+
+// Execute runs the actions of the plan. Copies and object deletes are made
+// one by one, version deletes are batched with BatchDelete.
+//
+// Returns a BatchError listing the keys whose actions failed.
+func (p *VersionPlan) Execute(ctx aws.Context, svc s3iface.S3API, opts ...request.Option) error {
+	var errs []Error
+	var deletes []BatchDeleteObject
+
+	for _, a := range p.Actions {
+		var err error
+		switch a.Type {
+		case VersionActionCopy:
+			err = p.copyVersion(ctx, svc, a, opts...)
+		case VersionActionDeleteObject:
+			_, err = svc.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+				Bucket: aws.String(p.Bucket),
+				Key:    aws.String(a.Key),
+			}, opts...)
+		case VersionActionDeleteVersion:
+			deletes = append(deletes, BatchDeleteObject{Object: &s3.DeleteObjectInput{
+				Bucket:    aws.String(p.Bucket),
+				Key:       aws.String(a.Key),
+				VersionId: aws.String(a.VersionID),
+			}})
+		}
+		if err != nil {
+			errs = append(errs, newError(err, aws.String(p.Bucket), aws.String(a.Key)))
+		}
+	}
+
+	if len(deletes) > 0 {
+		err := NewBatchDeleteWithClient(svc).Delete(ctx, &DeleteObjectsIterator{Objects: deletes})
+		if batchErr, ok := err.(*BatchError); ok {
+			errs = append(errs, batchErr.Errors...)
+		} else if err != nil {
+			errs = append(errs, newError(err, aws.String(p.Bucket), nil))
+		}
+	}
+
+	if len(errs) > 0 {
+		return NewBatchError(ErrCodeBatchVersionActions, "some version actions have failed.", errs)
+	}
+	return nil
+}
+
+// copyVersion copies the source version over the object, with a multipart
+// copy if the version is larger than MaxCopyObjectSize. The multipart upload
+// is aborted if copying the parts, or completing the upload, fails.
+func (p *VersionPlan) copyVersion(ctx aws.Context, svc s3iface.S3API, a VersionAction, opts ...request.Option) error {
+	source := copySource(p.Bucket, a.Key, a.SourceVersionID)
+	if a.Size <= MaxCopyObjectSize {
+		_, err := svc.CopyObjectWithContext(ctx, &s3.CopyObjectInput{
+			Bucket:     aws.String(p.Bucket),
+			Key:        aws.String(a.Key),
+			CopySource: aws.String(source),
+		}, opts...)
+		return err
+	}
+
+	head, err := svc.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket:    aws.String(p.Bucket),
+		Key:       aws.String(a.Key),
+		VersionId: aws.String(a.SourceVersionID),
+	}, opts...)
+	if err != nil {
+		return err
+	}
+
+	upload, err := svc.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:             aws.String(p.Bucket),
+		Key:                aws.String(a.Key),
+		CacheControl:       head.CacheControl,
+		ContentDisposition: head.ContentDisposition,
+		ContentEncoding:    head.ContentEncoding,
+		ContentLanguage:    head.ContentLanguage,
+		ContentType:        head.ContentType,
+		Metadata:           head.Metadata,
+	}, opts...)
+	if err != nil {
+		return err
+	}
+
+	err = p.copyParts(ctx, svc, a.Key, source, upload.UploadId, aws.Int64Value(head.ContentLength), opts...)
+	if err != nil {
+		svc.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(p.Bucket),
+			Key:      aws.String(a.Key),
+			UploadId: upload.UploadId,
+		}, opts...)
+		return err
+	}
+	return nil
+}
+
+// copyParts copies the source to the multipart upload in parts of
+// CopyPartSize, and completes the upload.
+func (p *VersionPlan) copyParts(ctx aws.Context, svc s3iface.S3API, key, source string, uploadID *string, size int64, opts ...request.Option) error {
+	partSize := p.CopyPartSize
+	if partSize <= 0 {
+		partSize = DefaultCopyPartSize
+	}
+
+	var parts []*s3.CompletedPart
+	for start, num := int64(0), int64(1); start < size; start, num = start+partSize, num+1 {
+		end := start + partSize - 1
+		if end >= size {
+			end = size - 1
+		}
+		out, err := svc.UploadPartCopyWithContext(ctx, &s3.UploadPartCopyInput{
+			Bucket:          aws.String(p.Bucket),
+			Key:             aws.String(key),
+			CopySource:      aws.String(source),
+			CopySourceRange: aws.String(fmt.Sprintf("bytes=%d-%d", start, end)),
+			PartNumber:      aws.Int64(num),
+			UploadId:        uploadID,
+		}, opts...)
+		if err != nil {
+			return err
+		}
+		parts = append(parts, &s3.CompletedPart{ETag: out.CopyPartResult.ETag, PartNumber: aws.Int64(num)})
+	}
+
+	_, err := svc.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(p.Bucket),
+		Key:             aws.String(key),
+		UploadId:        uploadID,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	}, opts...)
+	return err
+}
+
+// listVersions returns the versions and delete markers of the objects under
+// the prefix, sorted current first, then newest first, for each key.
+func listVersions(ctx aws.Context, svc s3iface.S3API, bucket, prefix string, opts ...request.Option) (map[string][]versionEntry, error) {
+	versions := map[string][]versionEntry{}
+	err := svc.ListObjectVersionsPagesWithContext(ctx, &s3.ListObjectVersionsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		for _, v := range page.Versions {
+			key := aws.StringValue(v.Key)
+			versions[key] = append(versions[key], versionEntry{
+				versionID:    aws.StringValue(v.VersionId),
+				lastModified: aws.TimeValue(v.LastModified),
+				size:         aws.Int64Value(v.Size),
+				isLatest:     aws.BoolValue(v.IsLatest),
+			})
+		}
+		for _, m := range page.DeleteMarkers {
+			key := aws.StringValue(m.Key)
+			versions[key] = append(versions[key], versionEntry{
+				versionID:    aws.StringValue(m.VersionId),
+				lastModified: aws.TimeValue(m.LastModified),
+				isLatest:     aws.BoolValue(m.IsLatest),
+				deleteMarker: true,
+			})
+		}
+		return true
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entries := range versions {
+		sort.SliceStable(entries, func(i, j int) bool {
+			if entries[i].isLatest != entries[j].isLatest {
+				return entries[i].isLatest
+			}
+			return entries[i].lastModified.After(entries[j].lastModified)
+		})
+	}
+	return versions, nil
+}
+
+func sortedVersionKeys(versions map[string][]versionEntry) []string {
+	keys := make([]string, 0, len(versions))
+	for key := range versions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func onlyDeleteMarkers(entries []versionEntry) bool {
+	for _, e := range entries {
+		if !e.deleteMarker {
+			return false
+		}
+	}
+	return true
+}
+
+// copySource returns the CopySource of the object version.
+func copySource(bucket, key, versionID string) string {
+	source := (&url.URL{Path: bucket + "/" + key}).EscapedPath()
+	return source + "?versionId=" + url.QueryEscape(versionID)
+}
@@ -0,0 +1,284 @@
+package s3manager
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+)
+
+type versionsClient struct {
+	s3iface.S3API
+
+	listing *s3.ListObjectVersionsOutput
+	failKey string
+	// failOp is the multipart copy operation failing, if any.
+	failOp string
+
+	calls []string
+}
+
+func (c *versionsClient) ListObjectVersionsPagesWithContext(ctx aws.Context, input *s3.ListObjectVersionsInput, fn func(*s3.ListObjectVersionsOutput, bool) bool, opts ...request.Option) error {
+	fn(c.listing, true)
+	return nil
+}
+
+func (c *versionsClient) CopyObjectWithContext(ctx aws.Context, input *s3.CopyObjectInput, opts ...request.Option) (*s3.CopyObjectOutput, error) {
+	c.calls = append(c.calls, "CopyObject "+aws.StringValue(input.Key)+" "+aws.StringValue(input.CopySource))
+	if aws.StringValue(input.Key) == c.failKey {
+		return nil, awserr.New("AccessDenied", "access denied", nil)
+	}
+	return &s3.CopyObjectOutput{}, nil
+}
+
+func (c *versionsClient) DeleteObjectWithContext(ctx aws.Context, input *s3.DeleteObjectInput, opts ...request.Option) (*s3.DeleteObjectOutput, error) {
+	c.calls = append(c.calls, "DeleteObject "+aws.StringValue(input.Key))
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func (c *versionsClient) DeleteObjectsWithContext(ctx aws.Context, input *s3.DeleteObjectsInput, opts ...request.Option) (*s3.DeleteObjectsOutput, error) {
+	for _, o := range input.Delete.Objects {
+		c.calls = append(c.calls, "DeleteObjects "+aws.StringValue(o.Key)+" "+aws.StringValue(o.VersionId))
+	}
+	return &s3.DeleteObjectsOutput{}, nil
+}
+
+func (c *versionsClient) HeadObjectWithContext(ctx aws.Context, input *s3.HeadObjectInput, opts ...request.Option) (*s3.HeadObjectOutput, error) {
+	c.calls = append(c.calls, "HeadObject "+aws.StringValue(input.VersionId))
+	return &s3.HeadObjectOutput{ContentLength: aws.Int64(25), ContentType: aws.String("text/plain")}, nil
+}
+
+func (c *versionsClient) CreateMultipartUploadWithContext(ctx aws.Context, input *s3.CreateMultipartUploadInput, opts ...request.Option) (*s3.CreateMultipartUploadOutput, error) {
+	c.calls = append(c.calls, "CreateMultipartUpload "+aws.StringValue(input.ContentType))
+	return &s3.CreateMultipartUploadOutput{UploadId: aws.String("upload")}, nil
+}
+
+func (c *versionsClient) UploadPartCopyWithContext(ctx aws.Context, input *s3.UploadPartCopyInput, opts ...request.Option) (*s3.UploadPartCopyOutput, error) {
+	c.calls = append(c.calls, fmt.Sprintf("UploadPartCopy %d %s", aws.Int64Value(input.PartNumber), aws.StringValue(input.CopySourceRange)))
+	if c.failOp == "UploadPartCopy" && aws.Int64Value(input.PartNumber) == 2 {
+		return nil, awserr.New("InternalError", "internal error", nil)
+	}
+	return &s3.UploadPartCopyOutput{CopyPartResult: &s3.CopyPartResult{ETag: aws.String("etag")}}, nil
+}
+
+func (c *versionsClient) CompleteMultipartUploadWithContext(ctx aws.Context, input *s3.CompleteMultipartUploadInput, opts ...request.Option) (*s3.CompleteMultipartUploadOutput, error) {
+	c.calls = append(c.calls, fmt.Sprintf("CompleteMultipartUpload %d", len(input.MultipartUpload.Parts)))
+	if c.failOp == "CompleteMultipartUpload" {
+		return nil, awserr.New("InvalidPart", "invalid part", nil)
+	}
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
+
+func (c *versionsClient) AbortMultipartUploadWithContext(ctx aws.Context, input *s3.AbortMultipartUploadInput, opts ...request.Option) (*s3.AbortMultipartUploadOutput, error) {
+	c.calls = append(c.calls, "AbortMultipartUpload "+aws.StringValue(input.UploadId))
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+func versionsDay(d int) *time.Time {
+	return aws.Time(time.Date(2023, 1, d, 0, 0, 0, 0, time.UTC))
+}
+
+func version(key, id string, d int, latest bool) *s3.ObjectVersion {
+	return &s3.ObjectVersion{Key: aws.String(key), VersionId: aws.String(id), LastModified: versionsDay(d), IsLatest: aws.Bool(latest), Size: aws.Int64(10)}
+}
+
+func deleteMarker(key, id string, d int, latest bool) *s3.DeleteMarkerEntry {
+	return &s3.DeleteMarkerEntry{Key: aws.String(key), VersionId: aws.String(id), LastModified: versionsDay(d), IsLatest: aws.Bool(latest)}
+}
+
+var versionsListing = &s3.ListObjectVersionsOutput{
+	Versions: []*s3.ObjectVersion{
+		// overwritten after day 5
+		version("overwritten", "o2", 6, true),
+		version("overwritten", "o1", 2, false),
+		// deleted after day 5
+		version("deleted", "d1", 2, false),
+		// unchanged since day 5
+		version("unchanged", "u1", 2, true),
+		// created after day 5
+		version("created", "c1", 6, true),
+		// many versions
+		version("many", "m4", 4, true),
+		version("many", "m3", 3, false),
+		version("many", "m1", 1, false),
+	},
+	DeleteMarkers: []*s3.DeleteMarkerEntry{
+		deleteMarker("deleted", "dm2", 7, true),
+		deleteMarker("deleted", "dm1", 6, false),
+		deleteMarker("many", "mm2", 2, false),
+	},
+}
+
+func planActions(plan *VersionPlan) []string {
+	var actions []string
+	for _, a := range plan.Actions {
+		actions = append(actions, a.String())
+	}
+	return actions
+}
+
+func expectStrings(t *testing.T, e, a []string) {
+	t.Helper()
+	if len(e) != len(a) {
+		t.Fatalf("expect %v, got %v", e, a)
+	}
+	for i := range e {
+		if e[i] != a[i] {
+			t.Errorf("%d, expect %v, got %v", i, e[i], a[i])
+		}
+	}
+}
+
+func TestPlanRestoreVersions(t *testing.T) {
+	client := &versionsClient{listing: versionsListing}
+
+	plan, err := PlanRestoreVersions(aws.BackgroundContext(), client, &RestoreVersionsInput{
+		Bucket: "bucket",
+		Time:   *versionsDay(5),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expectStrings(t, []string{
+		"delete created",
+		"delete deleted version dm2",
+		"delete deleted version dm1",
+		"copy overwritten version o1",
+	}, planActions(plan))
+
+	// Plans are dry runs until executed
+	if e, a := 0, len(client.calls); e != a {
+		t.Fatalf("expect no calls, got %v", client.calls)
+	}
+
+	if err := plan.Execute(aws.BackgroundContext(), client); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	expectStrings(t, []string{
+		"DeleteObject created",
+		"CopyObject overwritten bucket/overwritten?versionId=o1",
+		"DeleteObjects deleted dm2",
+		"DeleteObjects deleted dm1",
+	}, client.calls)
+}
+
+func TestPlanPurgeVersions(t *testing.T) {
+	client := &versionsClient{listing: versionsListing}
+
+	plan, err := PlanPurgeVersions(aws.BackgroundContext(), client, &PurgeVersionsInput{
+		Bucket:         "bucket",
+		KeepNoncurrent: 1,
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expectStrings(t, []string{
+		"delete deleted version d1",
+		"delete many version mm2",
+		"delete many version m1",
+	}, planActions(plan))
+}
+
+func TestVersionPlanExecute_Errors(t *testing.T) {
+	client := &versionsClient{failKey: "b"}
+	plan := &VersionPlan{
+		Bucket: "bucket",
+		Actions: []VersionAction{
+			{Type: VersionActionCopy, Key: "a", SourceVersionID: "1"},
+			{Type: VersionActionCopy, Key: "b", SourceVersionID: "1"},
+		},
+	}
+
+	err := plan.Execute(aws.BackgroundContext(), client)
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("expect BatchError, got %T %v", err, err)
+	}
+	if e, a := ErrCodeBatchVersionActions, batchErr.Code(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 1, len(batchErr.Errors); e != a {
+		t.Fatalf("expect %v errors, got %v", e, a)
+	}
+	if e, a := "b", aws.StringValue(batchErr.Errors[0].Key); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestVersionPlanExecute_MultipartCopy(t *testing.T) {
+	client := &versionsClient{}
+	plan := &VersionPlan{
+		Bucket:       "bucket",
+		CopyPartSize: 10,
+		Actions: []VersionAction{
+			{Type: VersionActionCopy, Key: "large", SourceVersionID: "1", Size: MaxCopyObjectSize + 1},
+		},
+	}
+
+	if err := plan.Execute(aws.BackgroundContext(), client); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	expectStrings(t, []string{
+		"HeadObject 1",
+		"CreateMultipartUpload text/plain",
+		"UploadPartCopy 1 bytes=0-9",
+		"UploadPartCopy 2 bytes=10-19",
+		"UploadPartCopy 3 bytes=20-24",
+		"CompleteMultipartUpload 3",
+	}, client.calls)
+}
+
+func TestVersionPlanExecute_MultipartCopyAbort(t *testing.T) {
+	cases := map[string]struct {
+		FailOp      string
+		ExpectCalls []string
+	}{
+		"part copy": {
+			FailOp: "UploadPartCopy",
+			ExpectCalls: []string{
+				"HeadObject 1",
+				"CreateMultipartUpload text/plain",
+				"UploadPartCopy 1 bytes=0-9",
+				"UploadPartCopy 2 bytes=10-19",
+				"AbortMultipartUpload upload",
+			},
+		},
+		"complete": {
+			FailOp: "CompleteMultipartUpload",
+			ExpectCalls: []string{
+				"HeadObject 1",
+				"CreateMultipartUpload text/plain",
+				"UploadPartCopy 1 bytes=0-9",
+				"UploadPartCopy 2 bytes=10-19",
+				"UploadPartCopy 3 bytes=20-24",
+				"CompleteMultipartUpload 3",
+				"AbortMultipartUpload upload",
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &versionsClient{failOp: c.FailOp}
+			plan := &VersionPlan{
+				Bucket:       "bucket",
+				CopyPartSize: 10,
+				Actions: []VersionAction{
+					{Type: VersionActionCopy, Key: "large", SourceVersionID: "1", Size: MaxCopyObjectSize + 1},
+				},
+			}
+
+			if err := plan.Execute(aws.BackgroundContext(), client); err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			expectStrings(t, c.ExpectCalls, client.calls)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package s3policy
+
+import "strings"
+
+// ServicePrefix is the service prefix of bucket policy actions.
+const ServicePrefix = "s3"
+
+// Actions are the s3 actions bucket policy statements can allow or deny,
+// without the service prefix. Action names are case insensitive.
+var Actions = []string{
+	"AbortMultipartUpload",
+	"BypassGovernanceRetention",
+	"CreateBucket",
+	"DeleteBucket",
+	"DeleteBucketPolicy",
+	"DeleteBucketWebsite",
+	"DeleteObject",
+	"DeleteObjectTagging",
+	"DeleteObjectVersion",
+	"DeleteObjectVersionTagging",
+	"GetBucketAcl",
+	"GetBucketCORS",
+	"GetBucketLocation",
+	"GetBucketLogging",
+	"GetBucketNotification",
+	"GetBucketObjectLockConfiguration",
+	"GetBucketPolicy",
+	"GetBucketPublicAccessBlock",
+	"GetBucketTagging",
+	"GetBucketVersioning",
+	"GetBucketWebsite",
+	"GetLifecycleConfiguration",
+	"GetObject",
+	"GetObjectAcl",
+	"GetObjectLegalHold",
+	"GetObjectRetention",
+	"GetObjectTagging",
+	"GetObjectVersion",
+	"GetObjectVersionAcl",
+	"GetObjectVersionTagging",
+	"GetReplicationConfiguration",
+	"ListAllMyBuckets",
+	"ListBucket",
+	"ListBucketMultipartUploads",
+	"ListBucketVersions",
+	"ListMultipartUploadParts",
+	"PutBucketAcl",
+	"PutBucketCORS",
+	"PutBucketLogging",
+	"PutBucketNotification",
+	"PutBucketObjectLockConfiguration",
+	"PutBucketPolicy",
+	"PutBucketPublicAccessBlock",
+	"PutBucketTagging",
+	"PutBucketVersioning",
+	"PutBucketWebsite",
+	"PutLifecycleConfiguration",
+	"PutObject",
+	"PutObjectAcl",
+	"PutObjectLegalHold",
+	"PutObjectRetention",
+	"PutObjectTagging",
+	"PutObjectVersionAcl",
+	"PutObjectVersionTagging",
+	"PutReplicationConfiguration",
+	"RestoreObject",
+}
+
+// Condition operators. Operators other than Null can be suffixed with
+// IfExists, and prefixed with ForAllValues: or ForAnyValue: for multivalued
+// condition keys.
+const (
+	ConditionStringEquals              = "StringEquals"
+	ConditionStringNotEquals           = "StringNotEquals"
+	ConditionStringEqualsIgnoreCase    = "StringEqualsIgnoreCase"
+	ConditionStringNotEqualsIgnoreCase = "StringNotEqualsIgnoreCase"
+	ConditionStringLike                = "StringLike"
+	ConditionStringNotLike             = "StringNotLike"
+	ConditionNumericEquals             = "NumericEquals"
+	ConditionNumericNotEquals          = "NumericNotEquals"
+	ConditionNumericLessThan           = "NumericLessThan"
+	ConditionNumericLessThanEquals     = "NumericLessThanEquals"
+	ConditionNumericGreaterThan        = "NumericGreaterThan"
+	ConditionNumericGreaterThanEquals  = "NumericGreaterThanEquals"
+	ConditionDateEquals                = "DateEquals"
+	ConditionDateNotEquals             = "DateNotEquals"
+	ConditionDateLessThan              = "DateLessThan"
+	ConditionDateLessThanEquals        = "DateLessThanEquals"
+	ConditionDateGreaterThan           = "DateGreaterThan"
+	ConditionDateGreaterThanEquals     = "DateGreaterThanEquals"
+	ConditionBool                      = "Bool"
+	ConditionBinaryEquals              = "BinaryEquals"
+	ConditionIPAddress                 = "IpAddress"
+	ConditionNotIPAddress              = "NotIpAddress"
+	ConditionArnEquals                 = "ArnEquals"
+	ConditionArnNotEquals              = "ArnNotEquals"
+	ConditionArnLike                   = "ArnLike"
+	ConditionArnNotLike                = "ArnNotLike"
+	ConditionNull                      = "Null"
+)
+
+// Condition operator modifiers.
+const (
+	ConditionIfExists     = "IfExists"
+	ConditionForAllValues = "ForAllValues:"
+	ConditionForAnyValue  = "ForAnyValue:"
+)
+
+var conditionOperators = []string{
+	ConditionStringEquals, ConditionStringNotEquals,
+	ConditionStringEqualsIgnoreCase, ConditionStringNotEqualsIgnoreCase,
+	ConditionStringLike, ConditionStringNotLike,
+	ConditionNumericEquals, ConditionNumericNotEquals,
+	ConditionNumericLessThan, ConditionNumericLessThanEquals,
+	ConditionNumericGreaterThan, ConditionNumericGreaterThanEquals,
+	ConditionDateEquals, ConditionDateNotEquals,
+	ConditionDateLessThan, ConditionDateLessThanEquals,
+	ConditionDateGreaterThan, ConditionDateGreaterThanEquals,
+	ConditionBool, ConditionBinaryEquals,
+	ConditionIPAddress, ConditionNotIPAddress,
+	ConditionArnEquals, ConditionArnNotEquals,
+	ConditionArnLike, ConditionArnNotLike,
+	ConditionNull,
+}
+
+// baseConditionOperator returns the operator without its modifiers, or an
+// empty string if the operator is not known.
+func baseConditionOperator(op string) string {
+	base := strings.TrimPrefix(strings.TrimPrefix(op, ConditionForAllValues), ConditionForAnyValue)
+	trimmed := strings.TrimSuffix(base, ConditionIfExists)
+	for _, o := range conditionOperators {
+		if o == base {
+			return o
+		}
+		if o == trimmed && o != ConditionNull {
+			return o
+		}
+	}
+	return ""
+}
+
+// knownAction returns if the action name, which may contain wildcards,
+// matches at least one of the s3 actions.
+func knownAction(name string) bool {
+	for _, a := range Actions {
+		if matchWildcard(strings.ToLower(name), strings.ToLower(a)) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchWildcard returns if the value matches the pattern, where * matches
+// any sequence of characters and ? matches any single character.
+func matchWildcard(pattern, value string) bool {
+	var p, v int
+	star, next := -1, 0
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			star, next = p, v
+			p++
+		case star >= 0:
+			next++
+			p, v = star+1, next
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
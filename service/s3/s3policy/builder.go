@@ -0,0 +1,123 @@
+package s3policy
+
+// Builder builds a bucket policy document.
+type Builder struct {
+	policy *Policy
+}
+
+// NewBuilder returns a Builder of a policy document without statements,
+// using the current policy language version.
+func NewBuilder() *Builder {
+	return &Builder{policy: &Policy{Version: Version20121017}}
+}
+
+// ID sets the ID of the policy document.
+func (b *Builder) ID(id string) *Builder {
+	b.policy.ID = id
+	return b
+}
+
+// Statement adds a statement with the Sid, allowing its actions, to the
+// policy document and returns it. The Sid may be empty.
+func (b *Builder) Statement(sid string) *StatementBuilder {
+	s := &Statement{Sid: sid, Effect: EffectAllow}
+	b.policy.Statements = append(b.policy.Statements, s)
+	return &StatementBuilder{stmt: s}
+}
+
+// Build returns the policy document, or an error listing the problems found
+// by Validate.
+func (b *Builder) Build() (*Policy, error) {
+	if err := Validate(b.policy); err != nil {
+		return nil, err
+	}
+	return b.policy, nil
+}
+
+// StatementBuilder builds a policy statement.
+type StatementBuilder struct {
+	stmt *Statement
+}
+
+// Allow allows the actions of the statement.
+func (s *StatementBuilder) Allow() *StatementBuilder {
+	s.stmt.Effect = EffectAllow
+	return s
+}
+
+// Deny denies the actions of the statement.
+func (s *StatementBuilder) Deny() *StatementBuilder {
+	s.stmt.Effect = EffectDeny
+	return s
+}
+
+// AnyPrincipal applies the statement to any principal.
+func (s *StatementBuilder) AnyPrincipal() *StatementBuilder {
+	s.stmt.Principal = AnyPrincipal()
+	return s
+}
+
+// Principals applies the statement to the principals of the type, e.g.
+// PrincipalAWS, in addition to the principals already added.
+func (s *StatementBuilder) Principals(principalType string, ids ...string) *StatementBuilder {
+	s.stmt.Principal = addPrincipals(s.stmt.Principal, principalType, ids)
+	return s
+}
+
+// NotPrincipals applies the statement to all principals except the
+// principals of the type.
+func (s *StatementBuilder) NotPrincipals(principalType string, ids ...string) *StatementBuilder {
+	s.stmt.NotPrincipal = addPrincipals(s.stmt.NotPrincipal, principalType, ids)
+	return s
+}
+
+// Actions adds the actions, e.g. "s3:GetObject" or "s3:Get*", to the
+// statement.
+func (s *StatementBuilder) Actions(actions ...string) *StatementBuilder {
+	s.stmt.Action = append(s.stmt.Action, actions...)
+	return s
+}
+
+// NotActions applies the statement to all actions except the actions.
+func (s *StatementBuilder) NotActions(actions ...string) *StatementBuilder {
+	s.stmt.NotAction = append(s.stmt.NotAction, actions...)
+	return s
+}
+
+// Resources adds the resource ARNs, e.g. from BucketARN or ObjectARN, to the
+// statement.
+func (s *StatementBuilder) Resources(resources ...string) *StatementBuilder {
+	s.stmt.Resource = append(s.stmt.Resource, resources...)
+	return s
+}
+
+// NotResources applies the statement to all resources except the resource
+// ARNs.
+func (s *StatementBuilder) NotResources(resources ...string) *StatementBuilder {
+	s.stmt.NotResource = append(s.stmt.NotResource, resources...)
+	return s
+}
+
+// Condition adds values of the condition key compared by the operator, e.g.
+// ConditionIPAddress, to the statement.
+func (s *StatementBuilder) Condition(operator, key string, values ...string) *StatementBuilder {
+	if s.stmt.Condition == nil {
+		s.stmt.Condition = Condition{}
+	}
+	if s.stmt.Condition[operator] == nil {
+		s.stmt.Condition[operator] = map[string]StringList{}
+	}
+	s.stmt.Condition[operator][key] = append(s.stmt.Condition[operator][key], values...)
+	return s
+}
+
+func addPrincipals(p *Principal, principalType string, ids []string) *Principal {
+	if p == nil {
+		p = &Principal{}
+	}
+	if p.IDs == nil {
+		p.IDs = map[string]StringList{}
+	}
+	p.IDs[principalType] = append(p.IDs[principalType], ids...)
+	return p
+}
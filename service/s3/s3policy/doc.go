@@ -0,0 +1,43 @@
+// Package s3policy provides types, a builder and a local validator for
+// bucket policy documents, as used by PutBucketPolicy and GetBucketPolicy.
+//
+// Policy document fields that can be either a string or an array, such as
+// Action and Resource, are unmarshaled into a StringList and marshaled back
+// as a string when they have a single element.
+//
+// Building a bucket policy:
+//
+//	b := s3policy.NewBuilder()
+//	b.Statement("AllowRead").
+//	    Principals(s3policy.PrincipalAWS, "arn:aws:iam::123456789012:root").
+//	    Actions("s3:GetObject", "s3:ListBucket").
+//	    Resources(s3policy.BucketARN("my-bucket"), s3policy.ObjectARN("my-bucket", "*"))
+//	b.Statement("DenyInsecureTransport").Deny().
+//	    AnyPrincipal().
+//	    Actions("s3:*").
+//	    Resources(s3policy.ObjectARN("my-bucket", "*")).
+//	    Condition(s3policy.ConditionBool, "aws:SecureTransport", "false")
+//
+//	policy, err := b.Build()
+//	if err != nil {
+//	    return err
+//	}
+//	_, err = svc.PutBucketPolicy(&s3.PutBucketPolicyInput{
+//	    Bucket: aws.String("my-bucket"),
+//	    Policy: aws.String(policy.String()),
+//	})
+//
+// Validating the policy of a bucket:
+//
+//	out, err := svc.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: aws.String("my-bucket")})
+//	if err != nil {
+//	    return err
+//	}
+//	policy, err := s3policy.ParseBucketPolicy(out)
+//	if err != nil {
+//	    return err
+//	}
+//	if err := s3policy.Validate(policy); err != nil {
+//	    fmt.Println(err)
+//	}
+package s3policy
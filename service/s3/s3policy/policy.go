@@ -0,0 +1,237 @@
+package s3policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// ErrCodeInvalidPolicy is the error code returned when a policy document
+// cannot be parsed, and of the awserr.BatchedErrors returned when a policy
+// document is not valid.
+const ErrCodeInvalidPolicy = "InvalidPolicy"
+
+// Policy language versions.
+const (
+	// Version20121017 is the current policy language version, required by
+	// policy variables.
+	Version20121017 = "2012-10-17"
+
+	// Version20081017 is the previous policy language version.
+	Version20081017 = "2008-10-17"
+)
+
+// Statement effects.
+const (
+	EffectAllow = "Allow"
+	EffectDeny  = "Deny"
+)
+
+// Principal types.
+const (
+	PrincipalAWS           = "AWS"
+	PrincipalCanonicalUser = "CanonicalUser"
+	PrincipalFederated     = "Federated"
+	PrincipalService       = "Service"
+)
+
+// Wildcard matches any principal, action or resource.
+const Wildcard = "*"
+
+// Policy is a bucket policy document.
+type Policy struct {
+	Version    string       `json:"Version,omitempty"`
+	ID         string       `json:"Id,omitempty"`
+	Statements []*Statement `json:"Statement"`
+}
+
+// Statement is a statement of a policy document. Statements have either
+// Principal or NotPrincipal, Action or NotAction, and Resource or
+// NotResource.
+type Statement struct {
+	Sid          string     `json:"Sid,omitempty"`
+	Effect       string     `json:"Effect"`
+	Principal    *Principal `json:"Principal,omitempty"`
+	NotPrincipal *Principal `json:"NotPrincipal,omitempty"`
+	Action       StringList `json:"Action,omitempty"`
+	NotAction    StringList `json:"NotAction,omitempty"`
+	Resource     StringList `json:"Resource,omitempty"`
+	NotResource  StringList `json:"NotResource,omitempty"`
+	Condition    Condition  `json:"Condition,omitempty"`
+}
+
+// Condition maps condition operators, e.g. StringEquals, to the values of
+// condition keys, e.g. aws:SourceIp, the operator compares.
+type Condition map[string]map[string]StringList
+
+// Principal is the principal of a statement: any principal, "*", or the IDs
+// of principals by type, e.g. {"AWS": ["arn:aws:iam::123456789012:root"]}.
+type Principal struct {
+	// Any principal. Marshaled as "*".
+	Any bool
+
+	// IDs of the principals by principal type.
+	IDs map[string]StringList
+}
+
+// AnyPrincipal returns the principal matching any principal.
+func AnyPrincipal() *Principal {
+	return &Principal{Any: true}
+}
+
+// NewPrincipal returns the principal of the type with the IDs.
+func NewPrincipal(principalType string, ids ...string) *Principal {
+	return &Principal{IDs: map[string]StringList{principalType: ids}}
+}
+
+// MarshalJSON marshals the principal as "*", or as the IDs by type.
+func (p Principal) MarshalJSON() ([]byte, error) {
+	if p.Any {
+		return json.Marshal(Wildcard)
+	}
+	return json.Marshal(p.IDs)
+}
+
+// UnmarshalJSON unmarshals the principal from "*", or from the IDs by type.
+func (p *Principal) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		if s != Wildcard {
+			return fmt.Errorf("principal must be %q or an object, got %q", Wildcard, s)
+		}
+		*p = Principal{Any: true}
+		return nil
+	}
+
+	var ids map[string]StringList
+	if err := json.Unmarshal(b, &ids); err != nil {
+		return err
+	}
+	*p = Principal{IDs: ids}
+	return nil
+}
+
+// StringList is a list of strings marshaled as a single string when it has
+// one element, and unmarshaled from either a string or an array. Boolean and
+// number values, as used by conditions, are unmarshaled as their JSON text.
+type StringList []string
+
+// MarshalJSON marshals the list as a string if it has a single element, or
+// as an array.
+func (l StringList) MarshalJSON() ([]byte, error) {
+	if len(l) == 1 {
+		return json.Marshal(l[0])
+	}
+	return json.Marshal([]string(l))
+}
+
+// UnmarshalJSON unmarshals the list from a string or an array.
+func (l *StringList) UnmarshalJSON(b []byte) error {
+	var values []json.RawMessage
+	if len(bytes.TrimSpace(b)) != 0 && bytes.TrimSpace(b)[0] == '[' {
+		if err := json.Unmarshal(b, &values); err != nil {
+			return err
+		}
+	} else {
+		values = []json.RawMessage{b}
+	}
+
+	list := make(StringList, 0, len(values))
+	for _, v := range values {
+		s, err := unmarshalScalar(v)
+		if err != nil {
+			return err
+		}
+		list = append(list, s)
+	}
+	*l = list
+	return nil
+}
+
+func unmarshalScalar(b json.RawMessage) (string, error) {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return "", err
+	}
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool, float64:
+		return string(bytes.TrimSpace(b)), nil
+	default:
+		return "", fmt.Errorf("expected string, number or boolean, got %s", b)
+	}
+}
+
+// UnmarshalJSON unmarshals the policy, accepting a single statement object
+// in place of the statement array.
+func (p *Policy) UnmarshalJSON(b []byte) error {
+	var doc struct {
+		Version   string          `json:"Version"`
+		ID        string          `json:"Id"`
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return err
+	}
+
+	*p = Policy{Version: doc.Version, ID: doc.ID}
+	stmt := bytes.TrimSpace(doc.Statement)
+	switch {
+	case len(stmt) == 0 || bytes.Equal(stmt, []byte("null")):
+	case stmt[0] == '{':
+		s := &Statement{}
+		if err := json.Unmarshal(stmt, s); err != nil {
+			return err
+		}
+		p.Statements = []*Statement{s}
+	default:
+		if err := json.Unmarshal(stmt, &p.Statements); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Parse parses a JSON policy document. The document is not validated.
+func Parse(data []byte) (*Policy, error) {
+	p := &Policy{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, awserr.New(ErrCodeInvalidPolicy, "failed to parse policy", err)
+	}
+	return p, nil
+}
+
+// ParseBucketPolicy parses the policy document returned by GetBucketPolicy.
+func ParseBucketPolicy(out *s3.GetBucketPolicyOutput) (*Policy, error) {
+	if out == nil || out.Policy == nil {
+		return nil, awserr.New(ErrCodeInvalidPolicy, "bucket policy is empty", nil)
+	}
+	return Parse([]byte(*out.Policy))
+}
+
+// String returns the JSON policy document, e.g. for PutBucketPolicy.
+func (p *Policy) String() string {
+	b, err := json.Marshal(p)
+	if err != nil {
+		// Policies only contain strings, marshaling cannot fail.
+		panic(fmt.Sprintf("failed to marshal policy, %v", err))
+	}
+	return string(b)
+}
+
+// BucketARN returns the ARN of the bucket, for statements of actions on
+// buckets such as s3:ListBucket.
+func BucketARN(bucket string) string {
+	return "arn:aws:s3:::" + bucket
+}
+
+// ObjectARN returns the ARN of the objects of the bucket matching the key,
+// which may contain wildcards, for statements of actions on objects such as
+// s3:GetObject.
+func ObjectARN(bucket, key string) string {
+	return BucketARN(bucket) + "/" + key
+}
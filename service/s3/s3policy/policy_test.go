@@ -0,0 +1,127 @@
+package s3policy
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+func TestParse(t *testing.T) {
+	policy, err := Parse([]byte(`{
+		"Version": "2012-10-17",
+		"Statement": {
+			"Sid": "Public",
+			"Effect": "Allow",
+			"Principal": "*",
+			"Action": "s3:GetObject",
+			"Resource": ["arn:aws:s3:::bucket/*"],
+			"Condition": {
+				"Bool": {"aws:SecureTransport": true},
+				"NumericLessThan": {"s3:max-keys": [10, "20"]}
+			}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 1, len(policy.Statements); e != a {
+		t.Fatalf("expect %v statements, got %v", e, a)
+	}
+	stmt := policy.Statements[0]
+	if !stmt.Principal.Any {
+		t.Errorf("expect any principal")
+	}
+	if e, a := (StringList{"s3:GetObject"}), stmt.Action; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := (StringList{"true"}), stmt.Condition[ConditionBool]["aws:SecureTransport"]; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := (StringList{"10", "20"}), stmt.Condition[ConditionNumericLessThan]["s3:max-keys"]; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	e := `{"Version":"2012-10-17","Statement":[{"Sid":"Public","Effect":"Allow","Principal":"*",` +
+		`"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*",` +
+		`"Condition":{"Bool":{"aws:SecureTransport":"true"},"NumericLessThan":{"s3:max-keys":["10","20"]}}}]}`
+	if a := policy.String(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	cases := map[string]string{
+		"not json":         `{`,
+		"principal string": `{"Statement":[{"Principal":"someone"}]}`,
+		"action object":    `{"Statement":[{"Action":{"a":"b"}}]}`,
+		"statement string": `{"Statement":"s"}`,
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(c))
+			if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != ErrCodeInvalidPolicy {
+				t.Errorf("expect %v error, got %v", ErrCodeInvalidPolicy, err)
+			}
+		})
+	}
+}
+
+func TestParseBucketPolicy(t *testing.T) {
+	policy, err := ParseBucketPolicy(&s3.GetBucketPolicyOutput{
+		Policy: aws.String(`{"Statement":[{"Effect":"Deny","Principal":{"AWS":["111122223333","444455556666"]},"Action":"s3:*","Resource":"*"}]}`),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := (StringList{"111122223333", "444455556666"}), policy.Statements[0].Principal.IDs[PrincipalAWS]; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	if _, err := ParseBucketPolicy(&s3.GetBucketPolicyOutput{}); err == nil {
+		t.Errorf("expect error for empty policy")
+	}
+}
+
+func TestBuilderBuild(t *testing.T) {
+	b := NewBuilder().ID("policy")
+	b.Statement("AllowRead").
+		Principals(PrincipalAWS, "arn:aws:iam::123456789012:root").
+		Principals(PrincipalAWS, "111122223333").
+		Actions("s3:GetObject", "s3:ListBucket").
+		Resources(BucketARN("bucket"), ObjectARN("bucket", "*"))
+	b.Statement("DenyInsecureTransport").Deny().
+		AnyPrincipal().
+		Actions("s3:*").
+		Resources(ObjectARN("bucket", "*")).
+		Condition(ConditionBool, "aws:SecureTransport", "false")
+
+	policy, err := b.Build()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(policy.String()), &doc); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := Version20121017, doc["Version"]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	parsed, err := Parse([]byte(policy.String()))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := policy, parsed; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e.String(), a.String())
+	}
+	if e, a := "arn:aws:s3:::bucket/*", parsed.Statements[0].Resource[1]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
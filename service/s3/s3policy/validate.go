@@ -0,0 +1,386 @@
+package s3policy
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws/arn"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	s3arn "github.com/IBM/ibm-cos-sdk-go/internal/s3shared/arn"
+)
+
+// MaxPolicySize is the size limit, in bytes, of a bucket policy document.
+const MaxPolicySize = 20 * 1024
+
+// Validate returns an error listing the problems of the policy document
+// that would be rejected, or that make statements conflict:
+//
+//   - documents without statements, larger than MaxPolicySize, or with an
+//     unknown version
+//   - duplicate statement IDs or invalid effects
+//   - statements without, or with both, Principal and NotPrincipal, Action
+//     and NotAction, or Resource and NotResource
+//   - actions that are not s3 actions, or wildcards matching no s3 action
+//   - resources that are not bucket, object or access point ARNs
+//   - unknown principal types, or malformed AWS principals
+//   - unknown condition operators, or values not matching their operator
+//   - allow statements entirely overridden by an unconditional deny
+//     statement
+func Validate(p *Policy) error {
+	var errs []error
+	addErr := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	switch {
+	case p == nil || len(p.Statements) == 0:
+		addErr("policy must have at least one statement")
+	case len(p.String()) > MaxPolicySize:
+		addErr("policy is %d bytes, larger than %d", len(p.String()), MaxPolicySize)
+	}
+
+	if p != nil {
+		switch p.Version {
+		case "", Version20121017, Version20081017:
+		default:
+			addErr("version %q must be %s or %s", p.Version, Version20121017, Version20081017)
+		}
+
+		sids := map[string]struct{}{}
+		for i, stmt := range p.Statements {
+			name := statementName(i, stmt)
+			if stmt == nil {
+				addErr("%s: statement must not be nil", name)
+				continue
+			}
+
+			if len(stmt.Sid) != 0 {
+				if _, ok := sids[stmt.Sid]; ok {
+					addErr("%s: duplicate statement ID", name)
+				}
+				sids[stmt.Sid] = struct{}{}
+			}
+
+			for _, msg := range validateStatement(stmt) {
+				addErr("%s: %s", name, msg)
+			}
+		}
+
+		for _, msg := range validateConflicts(p.Statements) {
+			addErr("%s", msg)
+		}
+	}
+
+	if len(errs) > 0 {
+		return awserr.NewBatchError(ErrCodeInvalidPolicy, "policy is invalid", errs)
+	}
+	return nil
+}
+
+func validateStatement(stmt *Statement) []string {
+	var msgs []string
+
+	switch stmt.Effect {
+	case EffectAllow, EffectDeny:
+	default:
+		msgs = append(msgs, fmt.Sprintf("effect %q must be %s or %s", stmt.Effect, EffectAllow, EffectDeny))
+	}
+
+	msgs = append(msgs, validateExclusive("Principal", stmt.Principal != nil, "NotPrincipal", stmt.NotPrincipal != nil)...)
+	msgs = append(msgs, validateExclusive("Action", len(stmt.Action) != 0, "NotAction", len(stmt.NotAction) != 0)...)
+	msgs = append(msgs, validateExclusive("Resource", len(stmt.Resource) != 0, "NotResource", len(stmt.NotResource) != 0)...)
+
+	for _, p := range []*Principal{stmt.Principal, stmt.NotPrincipal} {
+		msgs = append(msgs, validatePrincipal(p)...)
+	}
+	for _, a := range append(append([]string{}, stmt.Action...), stmt.NotAction...) {
+		if msg := validateAction(a); len(msg) != 0 {
+			msgs = append(msgs, msg)
+		}
+	}
+	for _, r := range append(append([]string{}, stmt.Resource...), stmt.NotResource...) {
+		if msg := validateResource(r); len(msg) != 0 {
+			msgs = append(msgs, msg)
+		}
+	}
+	msgs = append(msgs, validateCondition(stmt.Condition)...)
+
+	return msgs
+}
+
+func validateExclusive(name string, set bool, notName string, notSet bool) []string {
+	switch {
+	case set && notSet:
+		return []string{fmt.Sprintf("must have %s or %s, not both", name, notName)}
+	case !set && !notSet:
+		return []string{fmt.Sprintf("must have %s or %s", name, notName)}
+	}
+	return nil
+}
+
+func validateAction(action string) string {
+	if action == Wildcard {
+		return ""
+	}
+	parts := strings.SplitN(action, ":", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], ServicePrefix) {
+		return fmt.Sprintf("action %q must have the %s: prefix", action, ServicePrefix)
+	}
+	if !knownAction(parts[1]) {
+		return fmt.Sprintf("unknown action %q", action)
+	}
+	return ""
+}
+
+func validateResource(resource string) string {
+	if resource == Wildcard {
+		return ""
+	}
+	if _, err := s3arn.ParseResource(resource, parseResource); err != nil {
+		return fmt.Sprintf("resource %q is not a valid ARN, %v", resource, err)
+	}
+	return ""
+}
+
+// bucketResource is the resource of a bucket, or object, ARN.
+type bucketResource struct {
+	arn.ARN
+}
+
+func (r bucketResource) GetARN() arn.ARN {
+	return r.ARN
+}
+
+// parseResource parses the resource of bucket, object and access point
+// ARNs.
+func parseResource(a arn.ARN) (s3arn.Resource, error) {
+	if a.Service != ServicePrefix {
+		return nil, s3arn.InvalidARNError{ARN: a, Reason: "service must be " + ServicePrefix}
+	}
+
+	parts := s3arn.SplitResource(a.Resource)
+	if parts[0] == "accesspoint" {
+		return s3arn.ParseAccessPointResource(a, parts[1:])
+	}
+
+	if len(a.Region) != 0 || len(a.AccountID) != 0 {
+		return nil, s3arn.InvalidARNError{ARN: a, Reason: "region and account-id must not be set"}
+	}
+	if strings.HasPrefix(a.Resource, "/") {
+		return nil, s3arn.InvalidARNError{ARN: a, Reason: "bucket not set"}
+	}
+	return bucketResource{ARN: a}, nil
+}
+
+func validatePrincipal(p *Principal) []string {
+	if p == nil || p.Any {
+		return nil
+	}
+	if len(p.IDs) == 0 {
+		return []string{"principal must not be empty"}
+	}
+
+	var msgs []string
+	for _, t := range sortedKeys(p.IDs) {
+		ids := p.IDs[t]
+		switch t {
+		case PrincipalAWS, PrincipalCanonicalUser, PrincipalFederated, PrincipalService:
+		default:
+			msgs = append(msgs, fmt.Sprintf("unknown principal type %q", t))
+			continue
+		}
+		if len(ids) == 0 {
+			msgs = append(msgs, fmt.Sprintf("%s principal must not be empty", t))
+		}
+		for _, id := range ids {
+			switch {
+			case len(id) == 0:
+				msgs = append(msgs, fmt.Sprintf("%s principal must not be empty", t))
+			case t == PrincipalAWS && !validAWSPrincipal(id):
+				msgs = append(msgs, fmt.Sprintf("AWS principal %q must be %q, an account ID or an ARN", id, Wildcard))
+			}
+		}
+	}
+	return msgs
+}
+
+func validAWSPrincipal(id string) bool {
+	if id == Wildcard {
+		return true
+	}
+	if _, err := strconv.ParseUint(id, 10, 64); err == nil && len(id) == 12 {
+		return true
+	}
+	a, err := arn.Parse(id)
+	return err == nil && len(a.Partition) != 0 && len(a.Service) != 0 && len(a.Resource) != 0
+}
+
+func validateCondition(cond Condition) []string {
+	var msgs []string
+	ops := make([]string, 0, len(cond))
+	for op := range cond {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+
+	for _, op := range ops {
+		base := baseConditionOperator(op)
+		if len(base) == 0 {
+			msgs = append(msgs, fmt.Sprintf("unknown condition operator %q", op))
+			continue
+		}
+		if len(cond[op]) == 0 {
+			msgs = append(msgs, fmt.Sprintf("condition %s must have at least one key", op))
+		}
+		for _, key := range sortedKeys(cond[op]) {
+			values := cond[op][key]
+			if len(key) == 0 {
+				msgs = append(msgs, fmt.Sprintf("condition %s key must not be empty", op))
+			}
+			if len(values) == 0 {
+				msgs = append(msgs, fmt.Sprintf("condition %s %s must have at least one value", op, key))
+			}
+			for _, v := range values {
+				if !validConditionValue(base, v) {
+					msgs = append(msgs, fmt.Sprintf("condition %s %s value %q is not valid for the operator", op, key, v))
+				}
+			}
+		}
+	}
+	return msgs
+}
+
+func validConditionValue(op, v string) bool {
+	switch {
+	case strings.HasPrefix(op, "Numeric"):
+		_, err := strconv.ParseFloat(v, 64)
+		return err == nil
+	case strings.HasPrefix(op, "Date"):
+		return validDate(v)
+	case op == ConditionBool || op == ConditionNull:
+		return strings.EqualFold(v, "true") || strings.EqualFold(v, "false")
+	case op == ConditionBinaryEquals:
+		_, err := base64.StdEncoding.DecodeString(v)
+		return err == nil
+	case op == ConditionIPAddress || op == ConditionNotIPAddress:
+		if _, _, err := net.ParseCIDR(v); err == nil {
+			return true
+		}
+		return net.ParseIP(v) != nil
+	case strings.HasPrefix(op, "Arn"):
+		return arn.IsARN(v)
+	}
+	return true
+}
+
+func validDate(v string) bool {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05Z0700", "2006-01-02"} {
+		if _, err := time.Parse(layout, v); err == nil {
+			return true
+		}
+	}
+	_, err := strconv.ParseInt(v, 10, 64)
+	return err == nil
+}
+
+// validateConflicts returns the allow statements whose principals, actions
+// and resources are all matched by an unconditional deny statement, which
+// makes the allow statement have no effect.
+func validateConflicts(stmts []*Statement) []string {
+	var msgs []string
+	for i, allow := range stmts {
+		if !simpleStatement(allow) || allow.Effect != EffectAllow {
+			continue
+		}
+		for j, deny := range stmts {
+			if !simpleStatement(deny) || deny.Effect != EffectDeny || len(deny.Condition) != 0 {
+				continue
+			}
+			if coversPrincipal(deny.Principal, allow.Principal) &&
+				coversAll(deny.Action, allow.Action, true) &&
+				coversAll(deny.Resource, allow.Resource, false) {
+				msgs = append(msgs, fmt.Sprintf("%s is overridden by deny %s",
+					statementName(i, allow), statementName(j, deny)))
+				break
+			}
+		}
+	}
+	return msgs
+}
+
+// simpleStatement returns if the statement only has Principal, Action and
+// Resource, the statements conflicts are checked between.
+func simpleStatement(stmt *Statement) bool {
+	return stmt != nil && stmt.Principal != nil && stmt.NotPrincipal == nil &&
+		len(stmt.Action) != 0 && len(stmt.NotAction) == 0 &&
+		len(stmt.Resource) != 0 && len(stmt.NotResource) == 0
+}
+
+// coversAll returns if each value is matched by one of the patterns.
+func coversAll(patterns, values []string, ignoreCase bool) bool {
+	for _, v := range values {
+		if !covers(patterns, v, ignoreCase) {
+			return false
+		}
+	}
+	return true
+}
+
+func covers(patterns []string, v string, ignoreCase bool) bool {
+	for _, p := range patterns {
+		if ignoreCase {
+			p, v = strings.ToLower(p), strings.ToLower(v)
+		}
+		if matchWildcard(p, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func coversPrincipal(deny, allow *Principal) bool {
+	if deny.Any || hasWildcard(deny.IDs[PrincipalAWS]) {
+		return true
+	}
+	if allow.Any {
+		return false
+	}
+	for t, ids := range allow.IDs {
+		for _, id := range ids {
+			if !covers(deny.IDs[t], id, false) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func hasWildcard(ids []string) bool {
+	for _, id := range ids {
+		if id == Wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+func statementName(i int, stmt *Statement) string {
+	if stmt != nil && len(stmt.Sid) != 0 {
+		return fmt.Sprintf("statement %q", stmt.Sid)
+	}
+	return fmt.Sprintf("statement %d", i)
+}
+
+func sortedKeys(m map[string]StringList) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,217 @@
+package s3policy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+)
+
+func TestValidate(t *testing.T) {
+	bucket, objects := BucketARN("bucket"), ObjectARN("bucket", "*")
+
+	cases := map[string]struct {
+		Build     func(b *Builder)
+		ExpectErr []string
+	}{
+		"no statements": {
+			Build:     func(b *Builder) {},
+			ExpectErr: []string{"at least one statement"},
+		},
+		"valid": {
+			Build: func(b *Builder) {
+				b.Statement("a").AnyPrincipal().Actions("s3:Get*", "s3:listbucket").Resources(bucket, objects).
+					Condition(ConditionIPAddress, "aws:SourceIp", "10.0.0.0/8", "192.168.1.1").
+					Condition(ConditionForAnyValue+ConditionStringLike+ConditionIfExists, "s3:prefix", "logs/*").
+					Condition(ConditionDateLessThan, "aws:CurrentTime", "2030-01-01T00:00:00Z").
+					Condition(ConditionNull, "s3:x-amz-server-side-encryption", "true")
+				b.Statement("b").Deny().NotPrincipals(PrincipalAWS, "111122223333").
+					NotActions("s3:GetObject").Resources("*")
+				b.Statement("c").Principals(PrincipalCanonicalUser, "id").Actions("*").
+					Resources("arn:aws:s3:us-south:111122223333:accesspoint/ap")
+			},
+		},
+		"missing fields": {
+			Build: func(b *Builder) { b.Statement("a") },
+			ExpectErr: []string{
+				`statement "a": must have Principal or NotPrincipal`,
+				`statement "a": must have Action or NotAction`,
+				`statement "a": must have Resource or NotResource`,
+			},
+		},
+		"both fields": {
+			Build: func(b *Builder) {
+				b.Statement("a").AnyPrincipal().NotPrincipals(PrincipalAWS, "*").
+					Actions("s3:GetObject").NotActions("s3:PutObject").
+					Resources(objects).NotResources(bucket)
+			},
+			ExpectErr: []string{
+				"must have Principal or NotPrincipal, not both",
+				"must have Action or NotAction, not both",
+				"must have Resource or NotResource, not both",
+			},
+		},
+		"duplicate sid": {
+			Build: func(b *Builder) {
+				b.Statement("a").AnyPrincipal().Actions("s3:GetObject").Resources(objects)
+				b.Statement("a").AnyPrincipal().Actions("s3:PutObject").Resources(objects)
+			},
+			ExpectErr: []string{"duplicate statement ID"},
+		},
+		"unknown actions": {
+			Build: func(b *Builder) {
+				b.Statement("").AnyPrincipal().Actions("s3:GetObjects", "s3:Frobnicate*", "iam:GetUser", "GetObject").
+					Resources(objects)
+			},
+			ExpectErr: []string{
+				`statement 0: unknown action "s3:GetObjects"`,
+				`unknown action "s3:Frobnicate*"`,
+				`action "iam:GetUser" must have the s3: prefix`,
+				`action "GetObject" must have the s3: prefix`,
+			},
+		},
+		"malformed resources": {
+			Build: func(b *Builder) {
+				b.Statement("a").AnyPrincipal().Actions("s3:GetObject").Resources(
+					"bucket/*",
+					"arn::s3:::bucket",
+					"arn:aws:iam::111122223333:root",
+					"arn:aws:s3:us-south::bucket",
+					"arn:aws:s3:us-south:111122223333:accesspoint",
+					"arn:aws:s3:::/key",
+				)
+			},
+			ExpectErr: []string{
+				`resource "bucket/*" is not a valid ARN, arn: invalid prefix`,
+				`resource "arn::s3:::bucket" is not a valid ARN`,
+				`resource "arn:aws:iam::111122223333:root" is not a valid ARN`,
+				"region and account-id must not be set",
+				"resource-id not set",
+				"bucket not set",
+			},
+		},
+		"principals": {
+			Build: func(b *Builder) {
+				b.Statement("a").Principals(PrincipalAWS, "user", "").Principals("IBM", "id").
+					Actions("s3:GetObject").Resources(objects)
+			},
+			ExpectErr: []string{
+				`AWS principal "user" must be "*", an account ID or an ARN`,
+				"AWS principal must not be empty",
+				`unknown principal type "IBM"`,
+			},
+		},
+		"conditions": {
+			Build: func(b *Builder) {
+				b.Statement("a").AnyPrincipal().Actions("s3:GetObject").Resources(objects).
+					Condition("StringEqualz", "k", "v").
+					Condition(ConditionNumericEquals, "s3:max-keys", "ten").
+					Condition(ConditionBool, "aws:SecureTransport", "yes").
+					Condition(ConditionIPAddress, "aws:SourceIp", "10.0.0.0/33").
+					Condition(ConditionDateGreaterThan, "aws:CurrentTime", "tomorrow").
+					Condition(ConditionArnLike, "aws:SourceArn", "bucket").
+					Condition(ConditionNull+ConditionIfExists, "k", "true").
+					Condition(ConditionStringEquals, "k")
+			},
+			ExpectErr: []string{
+				`unknown condition operator "StringEqualz"`,
+				`condition NumericEquals s3:max-keys value "ten"`,
+				`condition Bool aws:SecureTransport value "yes"`,
+				`condition IpAddress aws:SourceIp value "10.0.0.0/33"`,
+				`condition DateGreaterThan aws:CurrentTime value "tomorrow"`,
+				`condition ArnLike aws:SourceArn value "bucket"`,
+				`unknown condition operator "NullIfExists"`,
+				"condition StringEquals k must have at least one value",
+			},
+		},
+		"allow overridden by deny": {
+			Build: func(b *Builder) {
+				b.Statement("read").Principals(PrincipalAWS, "111122223333").
+					Actions("s3:GetObject").Resources(ObjectARN("bucket", "logs/*"))
+				b.Statement("deny-all").Deny().AnyPrincipal().Actions("s3:Get*").Resources(objects)
+			},
+			ExpectErr: []string{`statement "read" is overridden by deny statement "deny-all"`},
+		},
+		"conditional deny does not conflict": {
+			Build: func(b *Builder) {
+				b.Statement("read").Principals(PrincipalAWS, "111122223333").
+					Actions("s3:GetObject").Resources(objects)
+				b.Statement("deny").Deny().AnyPrincipal().Actions("s3:*").Resources(objects).
+					Condition(ConditionBool, "aws:SecureTransport", "false")
+				b.Statement("deny-other").Deny().Principals(PrincipalAWS, "444455556666").
+					Actions("s3:*").Resources(objects)
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			b := NewBuilder()
+			c.Build(b)
+			_, err := b.Build()
+
+			if len(c.ExpectErr) == 0 {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expect error")
+			}
+			berr, ok := err.(awserr.BatchedErrors)
+			if !ok {
+				t.Fatalf("expect batched error, got %T", err)
+			}
+			if e, a := ErrCodeInvalidPolicy, berr.Code(); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			for _, e := range c.ExpectErr {
+				if !strings.Contains(err.Error(), e) {
+					t.Errorf("expect error to contain %q, got %v", e, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate_Parsed(t *testing.T) {
+	policy, err := Parse([]byte(`{"Version":"2012-10-18","Statement":[{"Effect":"allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	err = Validate(policy)
+	for _, e := range []string{
+		`version "2012-10-18" must be 2012-10-17 or 2008-10-17`,
+		`statement 0: effect "allow" must be Allow or Deny`,
+	} {
+		if err == nil || !strings.Contains(err.Error(), e) {
+			t.Errorf("expect error to contain %q, got %v", e, err)
+		}
+	}
+}
+
+func TestMatchWildcard(t *testing.T) {
+	cases := []struct {
+		Pattern, Value string
+		Expect         bool
+	}{
+		{"*", "", true},
+		{"*", "anything", true},
+		{"get*", "getobject", true},
+		{"get*", "putobject", false},
+		{"*object", "getobject", true},
+		{"g?tobject", "getobject", true},
+		{"g?tobject", "gtobject", false},
+		{"a*b*c", "aXXbYYc", true},
+		{"a*b*c", "aXXbYY", false},
+		{"bucket/logs/*", "bucket/logs/2020/01", true},
+	}
+
+	for i, c := range cases {
+		if e, a := c.Expect, matchWildcard(c.Pattern, c.Value); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+// Package s3retention provides utilities to manage IBM COS Immutable Object
+// Storage (WORM) retention.
+//
+// The Manager validates retention periods against the bucket's protection
+// configuration before writing them, applies retention and legal holds to
+// every object under a prefix, and reports the retention state of objects.
+//
+//	mgr := s3retention.NewManager(s3.New(sess))
+//
+//	// Extend the retention of every object under logs/2023/ to 7 years
+//	err := mgr.ExtendRetentionUnderPrefix(ctx, "my-bucket", "logs/2023/", s3retention.Days(7*365))
+//
+//	// Report the retention expiry and legal holds of the objects
+//	report, err := mgr.Report(ctx, "my-bucket", "logs/2023/")
+//	for _, obj := range report.Objects {
+//	    fmt.Println(obj.Key, obj.RetentionExpirationDate, obj.Permanent, len(obj.LegalHolds))
+//	}
+//
+// Retention periods are written in whole seconds, permanent retention is
+// written as PermanentRetentionPeriod.
+package s3retention
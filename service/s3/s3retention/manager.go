@@ -0,0 +1,268 @@
+package s3retention
+
+import (
+	"sync"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+)
+
+// DefaultConcurrency is the number of objects updated, or reported on,
+// concurrently by the prefix operations of the Manager.
+const DefaultConcurrency = 5
+
+// ErrCodeBatchRetention is the error code of the s3manager.BatchError
+// returned when a prefix operation failed for some objects.
+const ErrCodeBatchRetention = "BatchedRetentionIncomplete"
+
+// Manager manages the retention and legal holds of objects in buckets with
+// Immutable Object Storage enabled.
+type Manager struct {
+	// The number of objects updated, or reported on, concurrently by the
+	// prefix operations.
+	Concurrency int
+
+	// The client to use when managing retention.
+	S3 s3iface.S3API
+
+	// List of request options that will be passed down to individual API
+	// operation requests made by the Manager.
+	RequestOptions []request.Option
+}
+
+// NewManager creates a new Manager instance to manage retention with the
+// client. Pass in additional functional options to customize the Manager's
+// behavior.
+//
+// Example:
+//
+//	mgr := s3retention.NewManager(s3.New(sess), func(m *s3retention.Manager) {
+//	    m.Concurrency = 10
+//	})
+func NewManager(svc s3iface.S3API, options ...func(*Manager)) *Manager {
+	m := &Manager{
+		S3:          svc,
+		Concurrency: DefaultConcurrency,
+	}
+
+	for _, option := range options {
+		option(m)
+	}
+
+	return m
+}
+
+// GetPolicy returns the protection configuration of the bucket.
+func (m *Manager) GetPolicy(ctx aws.Context, bucket string) (Policy, error) {
+	out, err := m.S3.GetBucketProtectionConfigurationWithContext(ctx,
+		&s3.GetBucketProtectionConfigurationInput{Bucket: aws.String(bucket)},
+		m.RequestOptions...)
+	if err != nil {
+		return Policy{}, err
+	}
+	return PolicyFromProtectionConfiguration(out.ProtectionConfiguration), nil
+}
+
+// PutPolicy validates and sets the protection configuration of the bucket.
+//
+// Returns an error, without updating the bucket, if the policy would disable
+// permanent retention on a bucket it is enabled on.
+func (m *Manager) PutPolicy(ctx aws.Context, bucket string, policy Policy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	if !policy.PermanentRetentionEnabled {
+		current, err := m.GetPolicy(ctx, bucket)
+		if err != nil {
+			return err
+		}
+		if current.PermanentRetentionEnabled {
+			return awserr.New(ErrCodeInvalidProtectionConfiguration,
+				"permanent retention cannot be disabled once enabled on bucket "+bucket, nil)
+		}
+	}
+
+	_, err := m.S3.PutBucketProtectionConfigurationWithContext(ctx,
+		&s3.PutBucketProtectionConfigurationInput{
+			Bucket:                  aws.String(bucket),
+			ProtectionConfiguration: policy.ProtectionConfiguration(),
+		}, m.RequestOptions...)
+	return err
+}
+
+// ExtendRetention sets the retention period of the object, measured from the
+// object's creation, after validating it against the bucket's protection
+// configuration. IBM COS rejects periods shorter than the object's current
+// retention.
+func (m *Manager) ExtendRetention(ctx aws.Context, bucket, key string, period Period) error {
+	policy, err := m.GetPolicy(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if err := policy.ValidatePeriod(period); err != nil {
+		return err
+	}
+	return m.extendRetention(ctx, bucket, key, period)
+}
+
+// ExtendRetentionUnderPrefix sets the retention period of every object whose
+// key starts with the prefix, after validating it against the bucket's
+// protection configuration.
+//
+// Returns an s3manager.BatchError listing the objects that failed to update.
+func (m *Manager) ExtendRetentionUnderPrefix(ctx aws.Context, bucket, prefix string, period Period) error {
+	policy, err := m.GetPolicy(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if err := policy.ValidatePeriod(period); err != nil {
+		return err
+	}
+
+	return m.forEachObject(ctx, bucket, prefix, "failed to extend retention of some objects",
+		func(key string) error {
+			return m.extendRetention(ctx, bucket, key, period)
+		})
+}
+
+func (m *Manager) extendRetention(ctx aws.Context, bucket, key string, period Period) error {
+	_, err := m.S3.ExtendObjectRetentionWithContext(ctx, &s3.ExtendObjectRetentionInput{
+		Bucket:             aws.String(bucket),
+		Key:                aws.String(key),
+		NewRetentionPeriod: aws.Int64(period.Seconds()),
+	}, m.RequestOptions...)
+	return err
+}
+
+// AddLegalHold adds the legal hold to the object.
+func (m *Manager) AddLegalHold(ctx aws.Context, bucket, key, id string) error {
+	if err := validateLegalHoldID(id); err != nil {
+		return err
+	}
+	return m.addLegalHold(ctx, bucket, key, id)
+}
+
+// AddLegalHoldUnderPrefix adds the legal hold to every object whose key
+// starts with the prefix.
+//
+// Returns an s3manager.BatchError listing the objects that failed to update.
+func (m *Manager) AddLegalHoldUnderPrefix(ctx aws.Context, bucket, prefix, id string) error {
+	if err := validateLegalHoldID(id); err != nil {
+		return err
+	}
+
+	return m.forEachObject(ctx, bucket, prefix, "failed to add legal hold "+id+" to some objects",
+		func(key string) error {
+			return m.addLegalHold(ctx, bucket, key, id)
+		})
+}
+
+func (m *Manager) addLegalHold(ctx aws.Context, bucket, key, id string) error {
+	_, err := m.S3.AddLegalHoldWithContext(ctx, &s3.AddLegalHoldInput{
+		Bucket:               aws.String(bucket),
+		Key:                  aws.String(key),
+		RetentionLegalHoldId: aws.String(id),
+	}, m.RequestOptions...)
+	return err
+}
+
+// RemoveLegalHold removes the legal hold from the object.
+func (m *Manager) RemoveLegalHold(ctx aws.Context, bucket, key, id string) error {
+	if err := validateLegalHoldID(id); err != nil {
+		return err
+	}
+	return m.removeLegalHold(ctx, bucket, key, id)
+}
+
+// RemoveLegalHoldUnderPrefix removes the legal hold from every object whose
+// key starts with the prefix.
+//
+// Returns an s3manager.BatchError listing the objects that failed to update.
+func (m *Manager) RemoveLegalHoldUnderPrefix(ctx aws.Context, bucket, prefix, id string) error {
+	if err := validateLegalHoldID(id); err != nil {
+		return err
+	}
+
+	return m.forEachObject(ctx, bucket, prefix, "failed to remove legal hold "+id+" from some objects",
+		func(key string) error {
+			return m.removeLegalHold(ctx, bucket, key, id)
+		})
+}
+
+func (m *Manager) removeLegalHold(ctx aws.Context, bucket, key, id string) error {
+	_, err := m.S3.DeleteLegalHoldWithContext(ctx, &s3.DeleteLegalHoldInput{
+		Bucket:               aws.String(bucket),
+		Key:                  aws.String(key),
+		RetentionLegalHoldId: aws.String(id),
+	}, m.RequestOptions...)
+	return err
+}
+
+// forEachObject calls fn, with up to Concurrency calls in flight, for every
+// object whose key starts with the prefix. Listing errors are returned as is,
+// errors of fn are collected into an s3manager.BatchError.
+func (m *Manager) forEachObject(ctx aws.Context, bucket, prefix, message string, fn func(key string) error) error {
+	var errs []s3manager.Error
+	var errsMu sync.Mutex
+
+	err := m.eachObject(ctx, bucket, prefix, func(key string) {
+		if err := fn(key); err != nil {
+			errsMu.Lock()
+			errs = append(errs, s3manager.Error{
+				OrigErr: err,
+				Bucket:  aws.String(bucket),
+				Key:     aws.String(key),
+			})
+			errsMu.Unlock()
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(errs) > 0 {
+		return s3manager.NewBatchError(ErrCodeBatchRetention, message, errs)
+	}
+	return nil
+}
+
+// eachObject lists the objects whose key starts with the prefix, calling fn
+// concurrently for each, and waits for all calls to return.
+func (m *Manager) eachObject(ctx aws.Context, bucket, prefix string, fn func(key string)) error {
+	concurrency := m.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	keys := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range keys {
+				fn(key)
+			}
+		}()
+	}
+
+	err := m.S3.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, obj := range page.Contents {
+			keys <- aws.StringValue(obj.Key)
+		}
+		return true
+	}, m.RequestOptions...)
+
+	close(keys)
+	wg.Wait()
+
+	return err
+}
@@ -0,0 +1,278 @@
+package s3retention
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+)
+
+type mockClient struct {
+	s3iface.S3API
+
+	config *s3.ProtectionConfiguration
+	keys   []string
+	holds  map[string]*s3.ListLegalHoldsOutput
+	fail   map[string]bool
+
+	m        sync.Mutex
+	putCfg   *s3.ProtectionConfiguration
+	extended map[string]int64
+	added    map[string]string
+	removed  map[string]string
+}
+
+func newMockClient(config *s3.ProtectionConfiguration, keys ...string) *mockClient {
+	return &mockClient{
+		config:   config,
+		keys:     keys,
+		holds:    map[string]*s3.ListLegalHoldsOutput{},
+		fail:     map[string]bool{},
+		extended: map[string]int64{},
+		added:    map[string]string{},
+		removed:  map[string]string{},
+	}
+}
+
+func (c *mockClient) GetBucketProtectionConfigurationWithContext(ctx aws.Context, input *s3.GetBucketProtectionConfigurationInput, opts ...request.Option) (*s3.GetBucketProtectionConfigurationOutput, error) {
+	return &s3.GetBucketProtectionConfigurationOutput{ProtectionConfiguration: c.config}, nil
+}
+
+func (c *mockClient) PutBucketProtectionConfigurationWithContext(ctx aws.Context, input *s3.PutBucketProtectionConfigurationInput, opts ...request.Option) (*s3.PutBucketProtectionConfigurationOutput, error) {
+	c.putCfg = input.ProtectionConfiguration
+	return &s3.PutBucketProtectionConfigurationOutput{}, nil
+}
+
+func (c *mockClient) ListObjectsV2PagesWithContext(ctx aws.Context, input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool, opts ...request.Option) error {
+	// One page per object
+	for i, key := range c.keys {
+		page := &s3.ListObjectsV2Output{Contents: []*s3.Object{{Key: aws.String(key)}}}
+		if !fn(page, i == len(c.keys)-1) {
+			break
+		}
+	}
+	return nil
+}
+
+func (c *mockClient) ExtendObjectRetentionWithContext(ctx aws.Context, input *s3.ExtendObjectRetentionInput, opts ...request.Option) (*s3.ExtendObjectRetentionOutput, error) {
+	key := aws.StringValue(input.Key)
+	if c.fail[key] {
+		return nil, awserr.New("InvalidRequest", "retention cannot be shortened", nil)
+	}
+	c.m.Lock()
+	c.extended[key] = aws.Int64Value(input.NewRetentionPeriod)
+	c.m.Unlock()
+	return &s3.ExtendObjectRetentionOutput{}, nil
+}
+
+func (c *mockClient) AddLegalHoldWithContext(ctx aws.Context, input *s3.AddLegalHoldInput, opts ...request.Option) (*s3.AddLegalHoldOutput, error) {
+	c.m.Lock()
+	c.added[aws.StringValue(input.Key)] = aws.StringValue(input.RetentionLegalHoldId)
+	c.m.Unlock()
+	return &s3.AddLegalHoldOutput{}, nil
+}
+
+func (c *mockClient) DeleteLegalHoldWithContext(ctx aws.Context, input *s3.DeleteLegalHoldInput, opts ...request.Option) (*s3.DeleteLegalHoldOutput, error) {
+	c.m.Lock()
+	c.removed[aws.StringValue(input.Key)] = aws.StringValue(input.RetentionLegalHoldId)
+	c.m.Unlock()
+	return &s3.DeleteLegalHoldOutput{}, nil
+}
+
+func (c *mockClient) ListLegalHoldsWithContext(ctx aws.Context, input *s3.ListLegalHoldsInput, opts ...request.Option) (*s3.ListLegalHoldsOutput, error) {
+	key := aws.StringValue(input.Key)
+	if c.fail[key] {
+		return nil, awserr.New("AccessDenied", "access denied", nil)
+	}
+	return c.holds[key], nil
+}
+
+var retentionConfig = &s3.ProtectionConfiguration{
+	DefaultRetention: &s3.BucketProtectionDefaultRetention{Days: aws.Int64(30)},
+	MinimumRetention: &s3.BucketProtectionMinimumRetention{Days: aws.Int64(1)},
+	MaximumRetention: &s3.BucketProtectionMaximumRetention{Days: aws.Int64(365)},
+	Status:           aws.String(s3.BucketProtectionStatusRetention),
+}
+
+func TestManagerExtendRetentionUnderPrefix(t *testing.T) {
+	var keys []string
+	for i := 0; i < 20; i++ {
+		keys = append(keys, fmt.Sprintf("logs/%02d", i))
+	}
+	client := newMockClient(retentionConfig, keys...)
+	client.fail["logs/07"] = true
+
+	err := NewManager(client).ExtendRetentionUnderPrefix(aws.BackgroundContext(), "bucket", "logs/", Days(90))
+	batchErr, ok := err.(*s3manager.BatchError)
+	if !ok {
+		t.Fatalf("expect BatchError, got %T %v", err, err)
+	}
+	if e, a := ErrCodeBatchRetention, batchErr.Code(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 1, len(batchErr.Errors); e != a {
+		t.Fatalf("expect %v errors, got %v", e, a)
+	}
+	if e, a := "logs/07", aws.StringValue(batchErr.Errors[0].Key); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	if e, a := 19, len(client.extended); e != a {
+		t.Errorf("expect %v objects extended, got %v", e, a)
+	}
+	for key, period := range client.extended {
+		if e, a := int64(90*86400), period; e != a {
+			t.Errorf("%s, expect %v, got %v", key, e, a)
+		}
+	}
+}
+
+func TestManagerExtendRetention_InvalidPeriod(t *testing.T) {
+	client := newMockClient(retentionConfig, "a")
+
+	mgr := NewManager(client)
+	for _, period := range []Period{Days(400), Permanent()} {
+		err := mgr.ExtendRetentionUnderPrefix(aws.BackgroundContext(), "bucket", "", period)
+		if err == nil {
+			t.Fatalf("%v, expect error, got none", period)
+		}
+		if e, a := ErrCodeInvalidRetentionPeriod, err.(awserr.Error).Code(); e != a {
+			t.Errorf("%v, expect %v, got %v", period, e, a)
+		}
+	}
+	if err := mgr.ExtendRetention(aws.BackgroundContext(), "bucket", "a", Days(0)); err == nil {
+		t.Errorf("expect error, got none")
+	}
+	if e, a := 0, len(client.extended); e != a {
+		t.Errorf("expect no objects extended, got %v", client.extended)
+	}
+}
+
+func TestManagerLegalHoldUnderPrefix(t *testing.T) {
+	client := newMockClient(retentionConfig, "a", "b", "c")
+	mgr := NewManager(client, func(m *Manager) {
+		m.Concurrency = 1
+	})
+
+	if err := mgr.AddLegalHoldUnderPrefix(aws.BackgroundContext(), "bucket", "", "case-1234"); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := mgr.RemoveLegalHoldUnderPrefix(aws.BackgroundContext(), "bucket", "", "case-1234"); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	for _, key := range client.keys {
+		if e, a := "case-1234", client.added[key]; e != a {
+			t.Errorf("%s, expect %v added, got %v", key, e, a)
+		}
+		if e, a := "case-1234", client.removed[key]; e != a {
+			t.Errorf("%s, expect %v removed, got %v", key, e, a)
+		}
+	}
+
+	for _, id := range []string{"", string(make([]byte, MaximumLegalHoldIDLength+1))} {
+		err := mgr.AddLegalHold(aws.BackgroundContext(), "bucket", "a", id)
+		if err == nil {
+			t.Fatalf("expect error, got none")
+		}
+		if e, a := ErrCodeInvalidLegalHoldID, err.(awserr.Error).Code(); e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	}
+}
+
+func TestManagerPutPolicy(t *testing.T) {
+	client := newMockClient(&s3.ProtectionConfiguration{
+		DefaultRetention:         &s3.BucketProtectionDefaultRetention{Days: aws.Int64(30)},
+		MinimumRetention:         &s3.BucketProtectionMinimumRetention{Days: aws.Int64(1)},
+		MaximumRetention:         &s3.BucketProtectionMaximumRetention{Days: aws.Int64(365)},
+		EnablePermanentRetention: aws.Bool(true),
+		Status:                   aws.String(s3.BucketProtectionStatusRetention),
+	})
+	mgr := NewManager(client)
+
+	policy := Policy{Enabled: true, MinimumDays: 1, DefaultDays: 60, MaximumDays: 730}
+	if err := mgr.PutPolicy(aws.BackgroundContext(), "bucket", policy); err == nil {
+		t.Fatalf("expect error disabling permanent retention, got none")
+	}
+	if client.putCfg != nil {
+		t.Fatalf("expect no configuration put, got %v", client.putCfg)
+	}
+
+	policy.PermanentRetentionEnabled = true
+	if err := mgr.PutPolicy(aws.BackgroundContext(), "bucket", policy); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := policy, PolicyFromProtectionConfiguration(client.putCfg); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestManagerReport(t *testing.T) {
+	created := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	client := newMockClient(retentionConfig, "c", "a", "b", "d")
+	client.holds["a"] = &s3.ListLegalHoldsOutput{
+		CreateTime:                    aws.Time(created),
+		RetentionPeriod:               aws.Int64(30 * 86400),
+		RetentionPeriodExpirationDate: aws.Time(created.Add(30 * 24 * time.Hour)),
+	}
+	client.holds["b"] = &s3.ListLegalHoldsOutput{
+		CreateTime:      aws.Time(created),
+		RetentionPeriod: aws.Int64(PermanentRetentionPeriod),
+	}
+	client.holds["c"] = &s3.ListLegalHoldsOutput{
+		CreateTime:                    aws.Time(created),
+		RetentionPeriod:               aws.Int64(86400),
+		RetentionPeriodExpirationDate: aws.Time(created.Add(24 * time.Hour)),
+		LegalHolds: []*s3.LegalHold{
+			{ID: aws.String("case-1234"), Date: aws.Time(created)},
+		},
+	}
+	client.fail["d"] = true
+
+	report, err := NewManager(client).Report(aws.BackgroundContext(), "bucket", "")
+	if _, ok := err.(*s3manager.BatchError); !ok {
+		t.Fatalf("expect BatchError, got %T %v", err, err)
+	}
+	if report == nil {
+		t.Fatalf("expect report, got none")
+	}
+	if e, a := (Policy{Enabled: true, MinimumDays: 1, DefaultDays: 30, MaximumDays: 365}), report.Policy; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	var keys []string
+	for _, obj := range report.Objects {
+		keys = append(keys, obj.Key)
+	}
+	if e, a := []string{"a", "b", "c"}, keys; fmt.Sprint(e) != fmt.Sprint(a) {
+		t.Fatalf("expect %v, got %v", e, a)
+	}
+
+	now := created.Add(10 * 24 * time.Hour)
+	a, b, c := report.Objects[0], report.Objects[1], report.Objects[2]
+	if e, a := Days(30), a.RetentionPeriod; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if !a.Protected(now) || a.Protected(created.Add(31*24*time.Hour)) {
+		t.Errorf("expect a protected until retention expiry")
+	}
+	if !b.Permanent || !b.Protected(now.Add(1000*24*time.Hour)) {
+		t.Errorf("expect b permanently protected, got %v", b)
+	}
+	if e, a := 1, len(c.LegalHolds); e != a {
+		t.Fatalf("expect %v legal holds, got %v", e, a)
+	}
+	if e, a := "case-1234", c.LegalHolds[0].ID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if !c.Protected(now) {
+		t.Errorf("expect c protected by legal hold after retention expiry")
+	}
+}
@@ -0,0 +1,227 @@
+package s3retention
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+const (
+	// PermanentRetentionPeriod is the retention period, in seconds, IBM COS
+	// uses for objects under permanent retention.
+	PermanentRetentionPeriod int64 = -1
+
+	// MaximumRetentionDays is the longest retention period IBM COS supports,
+	// 1000 years.
+	MaximumRetentionDays int64 = 365243
+
+	// MaximumLegalHolds is the number of legal holds an object can have.
+	MaximumLegalHolds = 100
+
+	// MaximumLegalHoldIDLength is the length limit of a legal hold ID.
+	MaximumLegalHoldIDLength = 64
+)
+
+const (
+	// ErrCodeRetentionNotEnabled is the error code returned when retention is
+	// written to a bucket without a protection configuration.
+	ErrCodeRetentionNotEnabled = "RetentionNotEnabled"
+
+	// ErrCodeInvalidRetentionPeriod is the error code returned when a
+	// retention period is outside of the bucket's protection configuration.
+	ErrCodeInvalidRetentionPeriod = "InvalidRetentionPeriod"
+
+	// ErrCodeInvalidProtectionConfiguration is the error code returned when a
+	// protection configuration is not valid.
+	ErrCodeInvalidProtectionConfiguration = "InvalidProtectionConfiguration"
+
+	// ErrCodeInvalidLegalHoldID is the error code returned when a legal hold
+	// ID is empty or too long.
+	ErrCodeInvalidLegalHoldID = "InvalidLegalHoldID"
+)
+
+// Period is a retention period. The zero value is a retention period of zero
+// seconds, which is valid if the bucket's minimum retention is zero days.
+type Period struct {
+	// Length of the retention, in seconds.
+	DurationSeconds int64
+
+	// Permanent retention, DurationSeconds is ignored.
+	Permanent bool
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// Days returns a retention Period of n days.
+func Days(n int64) Period {
+	return Period{DurationSeconds: daysToSeconds(n)}
+}
+
+// Permanent returns a permanent retention Period.
+func Permanent() Period {
+	return Period{Permanent: true}
+}
+
+// Seconds returns the retention period in seconds as written to IBM COS, or
+// PermanentRetentionPeriod if permanent.
+func (p Period) Seconds() int64 {
+	if p.Permanent {
+		return PermanentRetentionPeriod
+	}
+	return p.DurationSeconds
+}
+
+// String returns the retention period in a human readable form.
+func (p Period) String() string {
+	switch {
+	case p.Permanent:
+		return "permanent"
+	case p.DurationSeconds%secondsPerDay == 0:
+		return formatDays(p.DurationSeconds / secondsPerDay)
+	default:
+		return strconv.FormatInt(p.DurationSeconds, 10) + " seconds"
+	}
+}
+
+// PeriodFromSeconds returns the retention Period of a retention period in
+// seconds as returned by IBM COS.
+func PeriodFromSeconds(seconds int64) Period {
+	if seconds == PermanentRetentionPeriod {
+		return Permanent()
+	}
+	return Period{DurationSeconds: seconds}
+}
+
+// daysToSeconds returns n days in seconds, saturated to the int64 range.
+func daysToSeconds(n int64) int64 {
+	switch {
+	case n > math.MaxInt64/secondsPerDay:
+		return math.MaxInt64
+	case n < math.MinInt64/secondsPerDay:
+		return math.MinInt64
+	}
+	return n * secondsPerDay
+}
+
+// Policy is a bucket's protection configuration.
+type Policy struct {
+	// Retention is enabled on the bucket.
+	Enabled bool
+
+	// Retention period, in days, of objects written without one.
+	DefaultDays int64
+
+	// Shortest retention period, in days, objects can be written with.
+	MinimumDays int64
+
+	// Longest retention period, in days, objects can be written with.
+	MaximumDays int64
+
+	// Objects can be put under permanent retention. Once enabled, permanent
+	// retention cannot be disabled.
+	PermanentRetentionEnabled bool
+}
+
+// PolicyFromProtectionConfiguration returns the Policy of an IBM COS
+// protection configuration. A nil configuration is a Policy with retention
+// disabled.
+func PolicyFromProtectionConfiguration(cfg *s3.ProtectionConfiguration) Policy {
+	if cfg == nil {
+		return Policy{}
+	}
+
+	p := Policy{
+		Enabled:                   aws.StringValue(cfg.Status) == s3.BucketProtectionStatusRetention,
+		PermanentRetentionEnabled: aws.BoolValue(cfg.EnablePermanentRetention),
+	}
+	if cfg.DefaultRetention != nil {
+		p.DefaultDays = aws.Int64Value(cfg.DefaultRetention.Days)
+	}
+	if cfg.MinimumRetention != nil {
+		p.MinimumDays = aws.Int64Value(cfg.MinimumRetention.Days)
+	}
+	if cfg.MaximumRetention != nil {
+		p.MaximumDays = aws.Int64Value(cfg.MaximumRetention.Days)
+	}
+	return p
+}
+
+// ProtectionConfiguration returns the IBM COS protection configuration of
+// the Policy.
+func (p Policy) ProtectionConfiguration() *s3.ProtectionConfiguration {
+	cfg := &s3.ProtectionConfiguration{
+		DefaultRetention: &s3.BucketProtectionDefaultRetention{Days: aws.Int64(p.DefaultDays)},
+		MinimumRetention: &s3.BucketProtectionMinimumRetention{Days: aws.Int64(p.MinimumDays)},
+		MaximumRetention: &s3.BucketProtectionMaximumRetention{Days: aws.Int64(p.MaximumDays)},
+		Status:           aws.String(s3.BucketProtectionStatusRetention),
+	}
+	if p.PermanentRetentionEnabled {
+		cfg.EnablePermanentRetention = aws.Bool(true)
+	}
+	return cfg
+}
+
+// Validate returns an error if the retention periods of the Policy are not
+// ordered minimum <= default <= maximum, or exceed MaximumRetentionDays.
+func (p Policy) Validate() error {
+	switch {
+	case p.MinimumDays < 0:
+		return awserr.New(ErrCodeInvalidProtectionConfiguration,
+			"minimum retention must not be negative", nil)
+	case p.MinimumDays > p.DefaultDays || p.DefaultDays > p.MaximumDays:
+		return awserr.New(ErrCodeInvalidProtectionConfiguration,
+			"retention must be ordered minimum <= default <= maximum, got "+
+				formatDays(p.MinimumDays)+", "+formatDays(p.DefaultDays)+", "+formatDays(p.MaximumDays), nil)
+	case p.MaximumDays > MaximumRetentionDays:
+		return awserr.New(ErrCodeInvalidProtectionConfiguration,
+			"maximum retention must not exceed "+formatDays(MaximumRetentionDays), nil)
+	}
+	return nil
+}
+
+// ValidatePeriod returns an error if objects cannot be written with the
+// retention period under the Policy.
+func (p Policy) ValidatePeriod(period Period) error {
+	if !p.Enabled {
+		return awserr.New(ErrCodeRetentionNotEnabled, "bucket retention is not enabled", nil)
+	}
+
+	if period.Permanent {
+		if !p.PermanentRetentionEnabled {
+			return awserr.New(ErrCodeInvalidRetentionPeriod,
+				"permanent retention is not enabled on the bucket", nil)
+		}
+		return nil
+	}
+
+	if period.DurationSeconds < 0 {
+		return awserr.New(ErrCodeInvalidRetentionPeriod,
+			"retention period "+period.String()+" must not be negative", nil)
+	}
+	if period.DurationSeconds < daysToSeconds(p.MinimumDays) {
+		return awserr.New(ErrCodeInvalidRetentionPeriod,
+			"retention period "+period.String()+" is shorter than the bucket minimum of "+
+				formatDays(p.MinimumDays), nil)
+	}
+	if period.DurationSeconds > daysToSeconds(p.MaximumDays) {
+		return awserr.New(ErrCodeInvalidRetentionPeriod,
+			"retention period "+period.String()+" is longer than the bucket maximum of "+
+				formatDays(p.MaximumDays), nil)
+	}
+	return nil
+}
+
+func formatDays(days int64) string {
+	return strconv.FormatInt(days, 10) + " days"
+}
+
+func validateLegalHoldID(id string) error {
+	if len(id) == 0 || len(id) > MaximumLegalHoldIDLength {
+		return awserr.New(ErrCodeInvalidLegalHoldID,
+			"legal hold ID must be 1 to "+strconv.Itoa(MaximumLegalHoldIDLength)+" characters", nil)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package s3retention
+
+import (
+	"math"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+func TestPolicyFromProtectionConfiguration(t *testing.T) {
+	p := PolicyFromProtectionConfiguration(&s3.ProtectionConfiguration{
+		DefaultRetention:         &s3.BucketProtectionDefaultRetention{Days: aws.Int64(30)},
+		MinimumRetention:         &s3.BucketProtectionMinimumRetention{Days: aws.Int64(1)},
+		MaximumRetention:         &s3.BucketProtectionMaximumRetention{Days: aws.Int64(365)},
+		EnablePermanentRetention: aws.Bool(true),
+		Status:                   aws.String(s3.BucketProtectionStatusRetention),
+	})
+
+	expect := Policy{Enabled: true, DefaultDays: 30, MinimumDays: 1, MaximumDays: 365, PermanentRetentionEnabled: true}
+	if e, a := expect, p; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := expect, PolicyFromProtectionConfiguration(p.ProtectionConfiguration()); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := (Policy{}), PolicyFromProtectionConfiguration(nil); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	cases := map[string]struct {
+		Policy    Policy
+		ExpectErr bool
+	}{
+		"valid":             {Policy: Policy{MinimumDays: 1, DefaultDays: 30, MaximumDays: 365}},
+		"equal":             {Policy: Policy{MinimumDays: 0, DefaultDays: 0, MaximumDays: 0}},
+		"negative minimum":  {Policy: Policy{MinimumDays: -1, DefaultDays: 0, MaximumDays: 1}, ExpectErr: true},
+		"default < minimum": {Policy: Policy{MinimumDays: 10, DefaultDays: 5, MaximumDays: 365}, ExpectErr: true},
+		"default > maximum": {Policy: Policy{MinimumDays: 1, DefaultDays: 400, MaximumDays: 365}, ExpectErr: true},
+		"maximum too long":  {Policy: Policy{MaximumDays: MaximumRetentionDays + 1}, ExpectErr: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := c.Policy.Validate()
+			if e, a := c.ExpectErr, err != nil; e != a {
+				t.Fatalf("expect error %v, got %v", e, err)
+			}
+			if err != nil {
+				if e, a := ErrCodeInvalidProtectionConfiguration, err.(awserr.Error).Code(); e != a {
+					t.Errorf("expect %v, got %v", e, a)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyValidatePeriod(t *testing.T) {
+	policy := Policy{Enabled: true, MinimumDays: 1, DefaultDays: 30, MaximumDays: 365}
+
+	cases := map[string]struct {
+		Policy     Policy
+		Period     Period
+		ExpectCode string
+	}{
+		"within range":         {Policy: policy, Period: Days(30)},
+		"minimum":              {Policy: policy, Period: Days(1)},
+		"maximum":              {Policy: policy, Period: Days(365)},
+		"shorter than minimum": {Policy: policy, Period: Period{DurationSeconds: 23 * 60 * 60}, ExpectCode: ErrCodeInvalidRetentionPeriod},
+		"longer than maximum":  {Policy: policy, Period: Days(366), ExpectCode: ErrCodeInvalidRetentionPeriod},
+		"negative":             {Policy: Policy{Enabled: true}, Period: Period{DurationSeconds: -1}, ExpectCode: ErrCodeInvalidRetentionPeriod},
+		"permanent disabled":   {Policy: policy, Period: Permanent(), ExpectCode: ErrCodeInvalidRetentionPeriod},
+		"permanent enabled": {
+			Policy: Policy{Enabled: true, MaximumDays: 365, PermanentRetentionEnabled: true},
+			Period: Permanent(),
+		},
+		"retention disabled": {Policy: Policy{}, Period: Days(0), ExpectCode: ErrCodeRetentionNotEnabled},
+		"maximum retention": {
+			Policy: Policy{Enabled: true, MaximumDays: MaximumRetentionDays},
+			Period: Days(365),
+		},
+		"at maximum retention": {
+			Policy: Policy{Enabled: true, MaximumDays: MaximumRetentionDays},
+			Period: Days(MaximumRetentionDays),
+		},
+		"beyond maximum retention": {
+			Policy:     Policy{Enabled: true, MaximumDays: MaximumRetentionDays},
+			Period:     Days(MaximumRetentionDays + 1),
+			ExpectCode: ErrCodeInvalidRetentionPeriod,
+		},
+		"overflowing period": {
+			Policy:     Policy{Enabled: true, MaximumDays: MaximumRetentionDays},
+			Period:     Days(math.MaxInt64),
+			ExpectCode: ErrCodeInvalidRetentionPeriod,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := c.Policy.ValidatePeriod(c.Period)
+			if len(c.ExpectCode) == 0 {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			if e, a := c.ExpectCode, err.(awserr.Error).Code(); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestPeriodSeconds(t *testing.T) {
+	if e, a := int64(86400), Days(1).Seconds(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := PermanentRetentionPeriod, Permanent().Seconds(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := Days(2), PeriodFromSeconds(2*86400); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := Permanent(), PeriodFromSeconds(PermanentRetentionPeriod); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	maxSeconds := MaximumRetentionDays * 86400
+	if e, a := int64(31556995200), maxSeconds; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := maxSeconds, Days(MaximumRetentionDays).Seconds(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := Days(MaximumRetentionDays), PeriodFromSeconds(maxSeconds); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := int64(math.MaxInt64), PeriodFromSeconds(math.MaxInt64).Seconds(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "365243 days", Days(MaximumRetentionDays).String(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
@@ -0,0 +1,122 @@
+package s3retention
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+)
+
+// LegalHold is a legal hold of an object.
+type LegalHold struct {
+	// ID of the legal hold.
+	ID string
+
+	// Time the legal hold was added.
+	Date time.Time
+}
+
+// ObjectCompliance is the retention state of an object.
+type ObjectCompliance struct {
+	// Key of the object.
+	Key string
+
+	// Time the object was created, retention is measured from.
+	CreateTime time.Time
+
+	// Retention period of the object.
+	RetentionPeriod Period
+
+	// Time the retention of the object expires, zero if the object is under
+	// permanent retention.
+	RetentionExpirationDate time.Time
+
+	// The object is under permanent retention.
+	Permanent bool
+
+	// Legal holds of the object.
+	LegalHolds []LegalHold
+}
+
+// Protected returns true if the object cannot be deleted or overwritten at
+// the time, because of its retention or legal holds.
+func (o ObjectCompliance) Protected(t time.Time) bool {
+	return o.Permanent || len(o.LegalHolds) > 0 || t.Before(o.RetentionExpirationDate)
+}
+
+// ComplianceReport is the retention state of objects in a bucket.
+type ComplianceReport struct {
+	// Bucket the objects are in.
+	Bucket string
+
+	// Protection configuration of the bucket.
+	Policy Policy
+
+	// Retention state of the objects, sorted by key.
+	Objects []ObjectCompliance
+}
+
+// Report returns the retention state of every object whose key starts with
+// the prefix.
+//
+// Returns the objects that could be reported on, and an s3manager.BatchError
+// listing the objects whose legal holds could not be listed.
+func (m *Manager) Report(ctx aws.Context, bucket, prefix string) (*ComplianceReport, error) {
+	policy, err := m.GetPolicy(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ComplianceReport{Bucket: bucket, Policy: policy}
+	var mu sync.Mutex
+
+	err = m.forEachObject(ctx, bucket, prefix, "failed to report on some objects",
+		func(key string) error {
+			out, err := m.S3.ListLegalHoldsWithContext(ctx, &s3.ListLegalHoldsInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+			}, m.RequestOptions...)
+			if err != nil {
+				return err
+			}
+
+			obj := objectCompliance(key, out)
+			mu.Lock()
+			report.Objects = append(report.Objects, obj)
+			mu.Unlock()
+			return nil
+		})
+
+	sort.Slice(report.Objects, func(i, j int) bool {
+		return report.Objects[i].Key < report.Objects[j].Key
+	})
+
+	if _, ok := err.(*s3manager.BatchError); err != nil && !ok {
+		return nil, err
+	}
+	return report, err
+}
+
+func objectCompliance(key string, out *s3.ListLegalHoldsOutput) ObjectCompliance {
+	period := PeriodFromSeconds(aws.Int64Value(out.RetentionPeriod))
+
+	obj := ObjectCompliance{
+		Key:             key,
+		CreateTime:      aws.TimeValue(out.CreateTime),
+		RetentionPeriod: period,
+		Permanent:       period.Permanent,
+	}
+	if !period.Permanent {
+		obj.RetentionExpirationDate = aws.TimeValue(out.RetentionPeriodExpirationDate)
+	}
+	for _, hold := range out.LegalHolds {
+		obj.LegalHolds = append(obj.LegalHolds, LegalHold{
+			ID:   aws.StringValue(hold.ID),
+			Date: aws.TimeValue(hold.Date),
+		})
+	}
+	return obj
+}
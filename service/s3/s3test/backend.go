@@ -0,0 +1,186 @@
+package s3test
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// nullVersionID is the version ID of objects written while versioning is not
+// enabled.
+const nullVersionID = "null"
+
+type bucket struct {
+	name       string
+	created    time.Time
+	versioning string
+	protection *s3.ProtectionConfiguration
+
+	policy       string
+	tags         []*s3.Tag
+	notification *s3.NotificationConfiguration
+
+	// Versions of each key, oldest first.
+	objects map[string][]*objectVersion
+	uploads map[string]*upload
+}
+
+func newBucket(name string, created time.Time) *bucket {
+	return &bucket{
+		name:    name,
+		created: created,
+		objects: map[string][]*objectVersion{},
+		uploads: map[string]*upload{},
+	}
+}
+
+type objectVersion struct {
+	key          string
+	versionID    string
+	deleteMarker bool
+	data         []byte
+	etag         string
+	lastModified time.Time
+	contentType  string
+	metadata     map[string]string
+	tags         []*s3.Tag
+
+	// IBM COS retention, with the retention period in seconds.
+	created             time.Time
+	retentionPeriod     int64
+	retentionExpiration time.Time
+	legalHolds          []*s3.LegalHold
+}
+
+type upload struct {
+	id          string
+	key         string
+	initiated   time.Time
+	contentType string
+	metadata    map[string]string
+	tags        []*s3.Tag
+	parts       map[int64]*part
+}
+
+type part struct {
+	number       int64
+	data         []byte
+	etag         string
+	lastModified time.Time
+}
+
+// current returns the current version of the key, nil if the key has no
+// versions.
+func (b *bucket) current(key string) *objectVersion {
+	versions := b.objects[key]
+	if len(versions) == 0 {
+		return nil
+	}
+	return versions[len(versions)-1]
+}
+
+// version returns the version of the key, or the current version if the
+// version ID is empty.
+func (b *bucket) version(key, versionID string) *objectVersion {
+	if len(versionID) == 0 {
+		return b.current(key)
+	}
+	for _, v := range b.objects[key] {
+		if v.versionID == versionID {
+			return v
+		}
+	}
+	return nil
+}
+
+// put adds the version as the current version of the key. Versions with the
+// null version ID are replaced.
+func (b *bucket) put(v *objectVersion) {
+	versions := b.objects[v.key]
+	if v.versionID == nullVersionID {
+		versions = removeVersion(versions, nullVersionID)
+	}
+	b.objects[v.key] = append(versions, v)
+}
+
+// remove removes the version of the key.
+func (b *bucket) remove(key, versionID string) {
+	versions := removeVersion(b.objects[key], versionID)
+	if len(versions) == 0 {
+		delete(b.objects, key)
+		return
+	}
+	b.objects[key] = versions
+}
+
+func removeVersion(versions []*objectVersion, versionID string) []*objectVersion {
+	kept := versions[:0:0]
+	for _, v := range versions {
+		if v.versionID != versionID {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+// keys returns the keys of the bucket, sorted.
+func (b *bucket) keys() []string {
+	keys := make([]string, 0, len(b.objects))
+	for key := range b.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// newVersionID returns the version ID of a new version of an object, null if
+// versioning is not enabled.
+func (s *Server) newVersionID(b *bucket) string {
+	if b.versioning == s3.BucketVersioningStatusEnabled {
+		return s.nextID()
+	}
+	return nullVersionID
+}
+
+// protected returns whether the version cannot be deleted or overwritten,
+// because of its retention or legal holds.
+func (v *objectVersion) protected(now time.Time) bool {
+	if v.deleteMarker {
+		return false
+	}
+	return len(v.legalHolds) != 0 || v.retentionPeriod < 0 ||
+		(!v.retentionExpiration.IsZero() && now.Before(v.retentionExpiration))
+}
+
+func etagOf(data []byte) string {
+	sum := md5.Sum(data)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// multipartETag returns the ETag of a multipart object, the MD5 of the MD5 of
+// its parts followed by the number of parts.
+func multipartETag(parts []*part) string {
+	h := md5.New()
+	for _, p := range parts {
+		sum, _ := hex.DecodeString(strings.Trim(p.etag, `"`))
+		h.Write(sum)
+	}
+	return fmt.Sprintf(`"%s-%d"`, hex.EncodeToString(h.Sum(nil)), len(parts))
+}
+
+// metadataOf returns the user metadata of the request headers.
+func metadataOf(h http.Header) map[string]string {
+	metadata := map[string]string{}
+	for name, values := range h {
+		if strings.HasPrefix(strings.ToLower(name), "x-amz-meta-") && len(values) != 0 {
+			metadata[strings.ToLower(name[len("x-amz-meta-"):])] = values[0]
+		}
+	}
+	return metadata
+}
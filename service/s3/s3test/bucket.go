@@ -0,0 +1,436 @@
+package s3test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// defaultMaxKeys is the maximum number of keys returned by list operations.
+const defaultMaxKeys = 1000
+
+func (s *Server) listBuckets(w http.ResponseWriter, r *request) {
+	names := make([]string, 0, len(s.buckets))
+	for name := range s.buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if r.op == "ListBucketsExtended" {
+		out := &s3.ListBucketsExtendedOutput{Owner: s.owner(), IsTruncated: aws.Bool(false)}
+		for _, name := range names {
+			out.Buckets = append(out.Buckets, &s3.BucketExtended{
+				Name:               aws.String(name),
+				CreationDate:       aws.Time(s.buckets[name].created),
+				LocationConstraint: aws.String(DefaultRegion + "-standard"),
+			})
+		}
+		writeXML(w, http.StatusOK, "ListAllMyBucketsResult", out)
+		return
+	}
+
+	out := &s3.ListBucketsOutput{Owner: s.owner()}
+	for _, name := range names {
+		out.Buckets = append(out.Buckets, &s3.Bucket{
+			Name:         aws.String(name),
+			CreationDate: aws.Time(s.buckets[name].created),
+		})
+	}
+	writeXML(w, http.StatusOK, "ListAllMyBucketsResult", out)
+}
+
+func (s *Server) createBucket(w http.ResponseWriter, r *request) {
+	if _, ok := s.buckets[r.bucket]; ok {
+		writeError(w, http.StatusConflict, s3.ErrCodeBucketAlreadyOwnedByYou,
+			"Your previous request to create the named bucket succeeded and you already own it.")
+		return
+	}
+	s.buckets[r.bucket] = newBucket(r.bucket, s.now())
+	w.Header().Set("Location", "/"+r.bucket)
+}
+
+func (s *Server) deleteBucket(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	if len(b.objects) != 0 {
+		writeError(w, http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty.")
+		return
+	}
+	delete(s.buckets, r.bucket)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) headBucket(w http.ResponseWriter, r *request) {
+	s.bucket(w, r)
+}
+
+func (s *Server) getBucketLocation(w http.ResponseWriter, r *request) {
+	if _, ok := s.bucket(w, r); !ok {
+		return
+	}
+	writeXML(w, http.StatusOK, "LocationConstraint", &s3.GetBucketLocationOutput{
+		LocationConstraint: aws.String(DefaultRegion + "-standard"),
+	})
+}
+
+func (s *Server) getBucketVersioning(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	out := &s3.GetBucketVersioningOutput{}
+	if len(b.versioning) != 0 {
+		out.Status = aws.String(b.versioning)
+	}
+	writeXML(w, http.StatusOK, "VersioningConfiguration", out)
+}
+
+func (s *Server) putBucketVersioning(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	cfg := &s3.VersioningConfiguration{}
+	if !readXML(w, r, cfg) {
+		return
+	}
+	switch status := aws.StringValue(cfg.Status); status {
+	case s3.BucketVersioningStatusEnabled, s3.BucketVersioningStatusSuspended:
+		b.versioning = status
+	default:
+		writeError(w, http.StatusBadRequest, "MalformedXML", "invalid versioning status "+status)
+	}
+}
+
+func (s *Server) getBucketProtectionConfiguration(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	cfg := b.protection
+	if cfg == nil {
+		cfg = &s3.ProtectionConfiguration{}
+	}
+	writeXML(w, http.StatusOK, "ProtectionConfiguration", cfg)
+}
+
+func (s *Server) putBucketProtectionConfiguration(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	cfg := &s3.ProtectionConfiguration{}
+	if !readXML(w, r, cfg) {
+		return
+	}
+	if aws.StringValue(cfg.Status) != s3.BucketProtectionStatusRetention {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "protection status must be Retention")
+		return
+	}
+	if b.protection != nil && aws.BoolValue(b.protection.EnablePermanentRetention) &&
+		!aws.BoolValue(cfg.EnablePermanentRetention) {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "permanent retention cannot be disabled")
+		return
+	}
+	b.protection = cfg
+}
+
+// listEntry is an object version of a listing.
+type listEntry struct {
+	key     string
+	version *objectVersion
+}
+
+// page groups the entries by common prefix, and returns the entries and
+// common prefixes of a page of at most maxKeys. Entries whose common prefix is
+// the marker after are skipped, as the marker's prefix was already returned.
+func page(entries []listEntry, prefix, delimiter, after string, maxKeys int) (
+	items []listEntry, prefixes []string, truncated bool) {
+	count := 0
+	for _, e := range entries {
+		commonPrefix := ""
+		if len(delimiter) != 0 {
+			if i := strings.Index(e.key[len(prefix):], delimiter); i >= 0 {
+				commonPrefix = e.key[:len(prefix)+i+len(delimiter)]
+			}
+		}
+		if len(commonPrefix) != 0 {
+			if commonPrefix == after || (len(prefixes) != 0 && prefixes[len(prefixes)-1] == commonPrefix) {
+				continue
+			}
+		}
+
+		if count == maxKeys {
+			return items, prefixes, true
+		}
+		count++
+		if len(commonPrefix) != 0 {
+			prefixes = append(prefixes, commonPrefix)
+		} else {
+			items = append(items, e)
+		}
+	}
+	return items, prefixes, false
+}
+
+// listCurrent returns the current versions of the keys with the prefix after
+// the marker.
+func (b *bucket) listCurrent(prefix, after string) []listEntry {
+	var entries []listEntry
+	for _, key := range b.keys() {
+		if !strings.HasPrefix(key, prefix) || key <= after {
+			continue
+		}
+		if v := b.current(key); !v.deleteMarker {
+			entries = append(entries, listEntry{key: key, version: v})
+		}
+	}
+	return entries
+}
+
+// marker returns the marker of the next page, the last key or common prefix
+// of the page.
+func marker(items []listEntry, prefixes []string) string {
+	last := ""
+	if len(items) != 0 {
+		last = items[len(items)-1].key
+	}
+	if len(prefixes) != 0 && prefixes[len(prefixes)-1] > last {
+		last = prefixes[len(prefixes)-1]
+	}
+	return last
+}
+
+func maxKeysOf(w http.ResponseWriter, r *request, name string) (int, bool) {
+	v := r.URL.Query().Get(name)
+	if len(v) == 0 {
+		return defaultMaxKeys, true
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "invalid "+name+" "+v)
+		return 0, false
+	}
+	if n > defaultMaxKeys {
+		n = defaultMaxKeys
+	}
+	return n, true
+}
+
+func (s *Server) object(v *objectVersion) *s3.Object {
+	return &s3.Object{
+		Key:          aws.String(v.key),
+		ETag:         aws.String(v.etag),
+		Size:         aws.Int64(int64(len(v.data))),
+		LastModified: aws.Time(v.lastModified),
+		StorageClass: aws.String(s3.ObjectStorageClassStandard),
+		Owner:        s.owner(),
+	}
+}
+
+func commonPrefixes(prefixes []string) []*s3.CommonPrefix {
+	var cps []*s3.CommonPrefix
+	for _, p := range prefixes {
+		cps = append(cps, &s3.CommonPrefix{Prefix: aws.String(p)})
+	}
+	return cps
+}
+
+func optionalString(v string) *string {
+	if len(v) == 0 {
+		return nil
+	}
+	return aws.String(v)
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	maxKeys, ok := maxKeysOf(w, r, "max-keys")
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	prefix, delimiter, after := q.Get("prefix"), q.Get("delimiter"), q.Get("marker")
+
+	items, prefixes, truncated := page(b.listCurrent(prefix, after), prefix, delimiter, after, maxKeys)
+	out := &s3.ListObjectsOutput{
+		Name:           aws.String(b.name),
+		Prefix:         aws.String(prefix),
+		Delimiter:      optionalString(delimiter),
+		Marker:         aws.String(after),
+		MaxKeys:        aws.Int64(int64(maxKeys)),
+		IsTruncated:    aws.Bool(truncated),
+		CommonPrefixes: commonPrefixes(prefixes),
+	}
+	for _, e := range items {
+		out.Contents = append(out.Contents, s.object(e.version))
+	}
+	if truncated {
+		out.NextMarker = aws.String(marker(items, prefixes))
+	}
+	writeXML(w, http.StatusOK, "ListBucketResult", out)
+}
+
+func (s *Server) listObjectsV2(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	maxKeys, ok := maxKeysOf(w, r, "max-keys")
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	prefix, delimiter := q.Get("prefix"), q.Get("delimiter")
+	token, startAfter := q.Get("continuation-token"), q.Get("start-after")
+
+	after := startAfter
+	if len(token) != 0 {
+		b, err := base64.StdEncoding.DecodeString(token)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidArgument", "The continuation token provided is incorrect")
+			return
+		}
+		after = string(b)
+	}
+
+	items, prefixes, truncated := page(b.listCurrent(prefix, after), prefix, delimiter, after, maxKeys)
+	out := &s3.ListObjectsV2Output{
+		Name:              aws.String(b.name),
+		Prefix:            aws.String(prefix),
+		Delimiter:         optionalString(delimiter),
+		MaxKeys:           aws.Int64(int64(maxKeys)),
+		KeyCount:          aws.Int64(int64(len(items) + len(prefixes))),
+		IsTruncated:       aws.Bool(truncated),
+		ContinuationToken: optionalString(token),
+		StartAfter:        optionalString(startAfter),
+		CommonPrefixes:    commonPrefixes(prefixes),
+	}
+	for _, e := range items {
+		obj := s.object(e.version)
+		if q.Get("fetch-owner") != "true" {
+			obj.Owner = nil
+		}
+		out.Contents = append(out.Contents, obj)
+	}
+	if truncated {
+		out.NextContinuationToken = aws.String(base64.StdEncoding.EncodeToString([]byte(marker(items, prefixes))))
+	}
+	writeXML(w, http.StatusOK, "ListBucketResult", out)
+}
+
+func (s *Server) listObjectVersions(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	maxKeys, ok := maxKeysOf(w, r, "max-keys")
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	prefix, delimiter := q.Get("prefix"), q.Get("delimiter")
+	keyMarker, versionIDMarker := q.Get("key-marker"), q.Get("version-id-marker")
+
+	// Versions are listed newest first. The version ID marker skips the
+	// versions of the marker key up to and including it.
+	var entries []listEntry
+	for _, key := range b.keys() {
+		if !strings.HasPrefix(key, prefix) || key < keyMarker ||
+			(key == keyMarker && len(versionIDMarker) == 0) {
+			continue
+		}
+		versions := b.objects[key]
+		skip := key == keyMarker
+		for i := len(versions) - 1; i >= 0; i-- {
+			if skip {
+				skip = versions[i].versionID != versionIDMarker
+				continue
+			}
+			entries = append(entries, listEntry{key: key, version: versions[i]})
+		}
+	}
+
+	items, prefixes, truncated := page(entries, prefix, delimiter, keyMarker, maxKeys)
+	out := &s3.ListObjectVersionsOutput{
+		Name:            aws.String(b.name),
+		Prefix:          aws.String(prefix),
+		Delimiter:       optionalString(delimiter),
+		KeyMarker:       aws.String(keyMarker),
+		VersionIdMarker: aws.String(versionIDMarker),
+		MaxKeys:         aws.Int64(int64(maxKeys)),
+		IsTruncated:     aws.Bool(truncated),
+		CommonPrefixes:  commonPrefixes(prefixes),
+	}
+	for _, e := range items {
+		v := e.version
+		latest := b.current(v.key) == v
+		if v.deleteMarker {
+			out.DeleteMarkers = append(out.DeleteMarkers, &s3.DeleteMarkerEntry{
+				Key:          aws.String(v.key),
+				VersionId:    aws.String(v.versionID),
+				IsLatest:     aws.Bool(latest),
+				LastModified: aws.Time(v.lastModified),
+				Owner:        s.owner(),
+			})
+			continue
+		}
+		out.Versions = append(out.Versions, &s3.ObjectVersion{
+			Key:          aws.String(v.key),
+			VersionId:    aws.String(v.versionID),
+			IsLatest:     aws.Bool(latest),
+			ETag:         aws.String(v.etag),
+			Size:         aws.Int64(int64(len(v.data))),
+			LastModified: aws.Time(v.lastModified),
+			StorageClass: aws.String(s3.ObjectVersionStorageClassStandard),
+			Owner:        s.owner(),
+		})
+	}
+	if truncated {
+		next := marker(items, prefixes)
+		out.NextKeyMarker = aws.String(next)
+		if len(items) != 0 && items[len(items)-1].key == next {
+			out.NextVersionIdMarker = aws.String(items[len(items)-1].version.versionID)
+		}
+	}
+	writeXML(w, http.StatusOK, "ListVersionsResult", out)
+}
+
+func (s *Server) deleteObjects(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	del := &s3.Delete{}
+	if !readXML(w, r, del) {
+		return
+	}
+
+	out := &s3.DeleteObjectsOutput{}
+	for _, obj := range del.Objects {
+		deleted, err := s.deleteVersion(b, aws.StringValue(obj.Key), aws.StringValue(obj.VersionId))
+		if err != nil {
+			out.Errors = append(out.Errors, &s3.Error{
+				Key:       obj.Key,
+				VersionId: obj.VersionId,
+				Code:      aws.String(err.code),
+				Message:   aws.String(err.message),
+			})
+			continue
+		}
+		if !aws.BoolValue(del.Quiet) {
+			out.Deleted = append(out.Deleted, deleted)
+		}
+	}
+	writeXML(w, http.StatusOK, "DeleteResult", out)
+}
@@ -0,0 +1,138 @@
+package s3test
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// readConfig returns the body of a bucket configuration request, verifying
+// its required Content-MD5 header.
+func readConfig(w http.ResponseWriter, r *request) ([]byte, bool) {
+	data := r.body
+	md5Header := r.Header.Get("Content-MD5")
+	if len(md5Header) == 0 {
+		writeError(w, http.StatusBadRequest, "InvalidRequest",
+			"Missing required header for this request: Content-MD5")
+		return nil, false
+	}
+	sum := md5.Sum(data)
+	if md5Header != base64.StdEncoding.EncodeToString(sum[:]) {
+		writeError(w, http.StatusBadRequest, "BadDigest",
+			"The Content-MD5 you specified did not match what we received.")
+		return nil, false
+	}
+	return data, true
+}
+
+func (s *Server) getBucketPolicy(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	if len(b.policy) == 0 {
+		writeError(w, http.StatusNotFound, s3.ErrCodeNoSuchBucketPolicy, "The bucket policy does not exist")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(b.policy))
+}
+
+func (s *Server) putBucketPolicy(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	data, ok := readConfig(w, r)
+	if !ok {
+		return
+	}
+	var policy map[string]interface{}
+	if err := json.Unmarshal(data, &policy); err != nil {
+		writeError(w, http.StatusBadRequest, "MalformedPolicy", "Policies must be valid JSON")
+		return
+	}
+	b.policy = string(data)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteBucketPolicy(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	b.policy = ""
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getBucketTagging(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	if len(b.tags) == 0 {
+		writeError(w, http.StatusNotFound, s3.ErrCodeNoSuchTagSet, "There is no tag set associated with the bucket.")
+		return
+	}
+	writeXML(w, http.StatusOK, "Tagging", &s3.GetBucketTaggingOutput{TagSet: b.tags})
+}
+
+func (s *Server) putBucketTagging(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	if _, ok := readConfig(w, r); !ok {
+		return
+	}
+	tagging := &s3.Tagging{}
+	if !readXML(w, r, tagging) {
+		return
+	}
+	if err := tagging.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidTag", err.Error())
+		return
+	}
+	b.tags = sortTags(tagging.TagSet)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteBucketTagging(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	b.tags = nil
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getBucketNotificationConfiguration(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	cfg := b.notification
+	if cfg == nil {
+		cfg = &s3.NotificationConfiguration{}
+	}
+	writeXML(w, http.StatusOK, "NotificationConfiguration", cfg)
+}
+
+func (s *Server) putBucketNotificationConfiguration(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	cfg := &s3.NotificationConfiguration{}
+	if !readXML(w, r, cfg) {
+		return
+	}
+	if err := cfg.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", err.Error())
+		return
+	}
+	b.notification = cfg
+}
@@ -0,0 +1,26 @@
+// Package s3test provides an in-process fake IBM COS server for testing code
+// using the S3 client, s3manager and s3crypto without network access.
+//
+// The Server speaks the S3 REST/XML protocol over an httptest.Server and keeps
+// buckets and objects in memory. It supports buckets, objects, multipart
+// uploads, versioning, object and bucket tagging, bucket policies and
+// notification configurations, IBM COS legal holds and retention,
+// ListObjects and ListObjectsV2 pagination, conditional headers and ranged
+// GETs. Responses are encoded with the SDK's own XML protocol code, so they
+// are always readable by the client.
+//
+// Faults can be injected to test retries and error handling, e.g. throttling,
+// internal errors, slow response bodies and connection resets.
+//
+//	srv := s3test.NewServer()
+//	defer srv.Close()
+//
+//	sess := session.Must(session.NewSession(srv.Config()))
+//	svc := s3.New(sess)
+//
+//	srv.InjectFault(s3test.Fault{Type: s3test.FaultThrottle, Operation: "PutObject", Count: 2})
+//	uploader := s3manager.NewUploaderWithClient(svc)
+//
+// Requests must use path style addressing, as set by Server.Config. Request
+// signatures are not verified.
+package s3test
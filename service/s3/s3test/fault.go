@@ -0,0 +1,182 @@
+package s3test
+
+import (
+	"net/http"
+	"time"
+)
+
+// FaultType is the type of a Fault.
+type FaultType int
+
+const (
+	// FaultThrottle responds with a 503 SlowDown error.
+	FaultThrottle FaultType = iota
+
+	// FaultInternalError responds with a 500 InternalError error.
+	FaultInternalError
+
+	// FaultSlowBody writes the response body in chunks of ChunkSize bytes,
+	// waiting Delay before each chunk.
+	FaultSlowBody
+
+	// FaultConnectionReset closes the connection after writing AfterBytes
+	// bytes of the response body, or before responding if AfterBytes is
+	// zero.
+	FaultConnectionReset
+)
+
+// Default chunk size of FaultSlowBody.
+const defaultFaultChunkSize = 1024
+
+// Fault is a failure injected into the responses of the Server.
+type Fault struct {
+	Type FaultType
+
+	// Operation, bucket and key of the requests the fault applies to, e.g.
+	// GetObject. Empty values match all requests.
+	Operation string
+	Bucket    string
+	Key       string
+
+	// Number of requests the fault applies to. Zero applies the fault to
+	// all matching requests.
+	Count int
+
+	// Delay before each chunk of FaultSlowBody.
+	Delay time.Duration
+
+	// Size of the chunks of FaultSlowBody, defaults to 1 KiB.
+	ChunkSize int
+
+	// Number of response body bytes written before FaultConnectionReset
+	// closes the connection.
+	AfterBytes int
+
+	applied int
+}
+
+// InjectFault adds the fault to the server. Faults are matched in the order
+// they are injected, with at most one fault applied to each request.
+func (s *Server) InjectFault(f Fault) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all faults of the server.
+func (s *Server) ClearFaults() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.faults = nil
+}
+
+// matchFault returns the first fault matching the request, counting it as
+// applied. Must be called with the server lock held.
+func (s *Server) matchFault(r *request) *Fault {
+	for _, f := range s.faults {
+		if (len(f.Operation) != 0 && f.Operation != r.op) ||
+			(len(f.Bucket) != 0 && f.Bucket != r.bucket) ||
+			(len(f.Key) != 0 && f.Key != r.key) ||
+			(f.Count != 0 && f.applied >= f.Count) {
+			continue
+		}
+		f.applied++
+		return f
+	}
+	return nil
+}
+
+// apply applies the fault to the response. Returns the writer the response
+// is written to, or nil if the fault responded.
+func (f *Fault) apply(w http.ResponseWriter) http.ResponseWriter {
+	switch f.Type {
+	case FaultThrottle:
+		writeError(w, http.StatusServiceUnavailable, "SlowDown", "Please reduce your request rate.")
+		return nil
+	case FaultInternalError:
+		writeError(w, http.StatusInternalServerError, "InternalError",
+			"We encountered an internal error. Please try again.")
+		return nil
+	case FaultSlowBody:
+		size := f.ChunkSize
+		if size <= 0 {
+			size = defaultFaultChunkSize
+		}
+		return &slowWriter{ResponseWriter: w, delay: f.Delay, size: size}
+	case FaultConnectionReset:
+		if f.AfterBytes <= 0 {
+			resetConnection(w)
+			return nil
+		}
+		return &resetWriter{ResponseWriter: w, remaining: f.AfterBytes}
+	}
+	return w
+}
+
+// slowWriter writes the response body in chunks, with a delay before each
+// chunk.
+type slowWriter struct {
+	http.ResponseWriter
+	delay time.Duration
+	size  int
+}
+
+func (w *slowWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) != 0 {
+		chunk := p
+		if len(chunk) > w.size {
+			chunk = chunk[:w.size]
+		}
+		time.Sleep(w.delay)
+		m, err := w.ResponseWriter.Write(chunk)
+		n += m
+		if err != nil {
+			return n, err
+		}
+		if f, ok := w.ResponseWriter.(http.Flusher); ok {
+			f.Flush()
+		}
+		p = p[len(chunk):]
+	}
+	return n, nil
+}
+
+// resetWriter closes the connection once the remaining bytes of the response
+// body have been written.
+type resetWriter struct {
+	http.ResponseWriter
+	remaining int
+}
+
+func (w *resetWriter) Write(p []byte) (int, error) {
+	if w.remaining <= 0 {
+		return 0, http.ErrHijacked
+	}
+	if len(p) > w.remaining {
+		p = p[:w.remaining]
+	}
+	n, err := w.ResponseWriter.Write(p)
+	w.remaining -= n
+	if w.remaining <= 0 {
+		resetConnection(w.ResponseWriter)
+	}
+	return n, err
+}
+
+// resetConnection flushes the response written so far and closes the
+// connection.
+func resetConnection(w http.ResponseWriter) {
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		return
+	}
+	conn, buf, err := hj.Hijack()
+	if err != nil {
+		return
+	}
+	buf.Flush()
+	conn.Close()
+}
@@ -0,0 +1,287 @@
+package s3test
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// maxPartNumber is the largest part number of a multipart upload.
+const maxPartNumber = 10000
+
+var errNoSuchUpload = &s3Error{http.StatusNotFound, s3.ErrCodeNoSuchUpload,
+	"The specified upload does not exist. The upload ID may be invalid, or the upload may have been aborted or completed."}
+
+func (s *Server) createMultipartUpload(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	tags, err := tagsOf(r.Header.Get("X-Amz-Tagging"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", err.Error())
+		return
+	}
+
+	u := &upload{
+		id:          s.nextID(),
+		key:         r.key,
+		initiated:   s.now(),
+		contentType: r.Header.Get("Content-Type"),
+		metadata:    metadataOf(r.Header),
+		tags:        tags,
+		parts:       map[int64]*part{},
+	}
+	b.uploads[u.id] = u
+
+	writeXML(w, http.StatusOK, "InitiateMultipartUploadResult", &s3.CreateMultipartUploadOutput{
+		Bucket:   aws.String(b.name),
+		Key:      aws.String(u.key),
+		UploadId: aws.String(u.id),
+	})
+}
+
+// upload returns the upload of the request, writing a NoSuchUpload error if
+// it does not exist.
+func (s *Server) upload(w http.ResponseWriter, r *request) (*bucket, *upload, bool) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	u, ok := b.uploads[r.URL.Query().Get("uploadId")]
+	if !ok || u.key != r.key {
+		errNoSuchUpload.write(w)
+		return nil, nil, false
+	}
+	return b, u, true
+}
+
+func (s *Server) uploadPart(w http.ResponseWriter, r *request) {
+	_, u, ok := s.upload(w, r)
+	if !ok {
+		return
+	}
+	number, err := strconv.ParseInt(r.URL.Query().Get("partNumber"), 10, 64)
+	if err != nil || number < 1 || number > maxPartNumber {
+		writeError(w, http.StatusBadRequest, "InvalidArgument",
+			"Part number must be an integer between 1 and 10000, inclusive")
+		return
+	}
+
+	var data []byte
+	if r.op == "UploadPartCopy" {
+		src, e := s.copySource(r)
+		if e != nil {
+			e.write(w)
+			return
+		}
+		data = src.data
+		if rangeHeader := r.Header.Get("X-Amz-Copy-Source-Range"); len(rangeHeader) != 0 {
+			start, end, ok := parseRange(rangeHeader, int64(len(data)))
+			if !ok {
+				writeError(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange",
+					"The requested range is not satisfiable")
+				return
+			}
+			data = data[start : end+1]
+		}
+	} else {
+		data = r.body
+	}
+
+	p := &part{
+		number:       number,
+		data:         append([]byte{}, data...),
+		etag:         etagOf(data),
+		lastModified: s.now(),
+	}
+	u.parts[number] = p
+
+	if r.op == "UploadPartCopy" {
+		writeXML(w, http.StatusOK, "CopyPartResult", &s3.CopyPartResult{
+			ETag:         aws.String(p.etag),
+			LastModified: aws.Time(p.lastModified),
+		})
+		return
+	}
+	w.Header().Set("ETag", p.etag)
+}
+
+func (s *Server) completeMultipartUpload(w http.ResponseWriter, r *request) {
+	b, u, ok := s.upload(w, r)
+	if !ok {
+		return
+	}
+	completed := &s3.CompletedMultipartUpload{}
+	if !readXML(w, r, completed) {
+		return
+	}
+	if len(completed.Parts) == 0 {
+		writeError(w, http.StatusBadRequest, "MalformedXML",
+			"The XML you provided was not well-formed or did not validate against our published schema.")
+		return
+	}
+
+	var parts []*part
+	var data bytes.Buffer
+	last := int64(0)
+	for i, c := range completed.Parts {
+		number := aws.Int64Value(c.PartNumber)
+		if number <= last {
+			writeError(w, http.StatusBadRequest, "InvalidPartOrder",
+				"The list of parts was not in ascending order. Parts must be ordered by part number.")
+			return
+		}
+		last = number
+
+		p, ok := u.parts[number]
+		if !ok || strings.Trim(aws.StringValue(c.ETag), `"`) != strings.Trim(p.etag, `"`) {
+			writeError(w, http.StatusBadRequest, "InvalidPart",
+				"One or more of the specified parts could not be found. The part may not have been uploaded, or the specified entity tag may not match the part's entity tag.")
+			return
+		}
+		if i < len(completed.Parts)-1 && int64(len(p.data)) < s.MinPartSize {
+			writeError(w, http.StatusBadRequest, "EntityTooSmall",
+				"Your proposed upload is smaller than the minimum allowed object size.")
+			return
+		}
+		parts = append(parts, p)
+		data.Write(p.data)
+	}
+
+	v := &objectVersion{
+		key:         u.key,
+		data:        data.Bytes(),
+		etag:        multipartETag(parts),
+		contentType: u.contentType,
+		metadata:    u.metadata,
+		tags:        u.tags,
+	}
+	if e := s.store(b, v, r.Header); e != nil {
+		e.write(w)
+		return
+	}
+	delete(b.uploads, u.id)
+
+	s.writeVersionID(w, b, v)
+	writeXML(w, http.StatusOK, "CompleteMultipartUploadResult", &s3.CompleteMultipartUploadOutput{
+		Location: aws.String(s.URL + "/" + b.name + "/" + v.key),
+		Bucket:   aws.String(b.name),
+		Key:      aws.String(v.key),
+		ETag:     aws.String(v.etag),
+	})
+}
+
+func (s *Server) abortMultipartUpload(w http.ResponseWriter, r *request) {
+	b, u, ok := s.upload(w, r)
+	if !ok {
+		return
+	}
+	delete(b.uploads, u.id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listParts(w http.ResponseWriter, r *request) {
+	b, u, ok := s.upload(w, r)
+	if !ok {
+		return
+	}
+	maxParts, ok := maxKeysOf(w, r, "max-parts")
+	if !ok {
+		return
+	}
+	marker, _ := strconv.ParseInt(r.URL.Query().Get("part-number-marker"), 10, 64)
+
+	numbers := make([]int64, 0, len(u.parts))
+	for number := range u.parts {
+		if number > marker {
+			numbers = append(numbers, number)
+		}
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+
+	out := &s3.ListPartsOutput{
+		Bucket:           aws.String(b.name),
+		Key:              aws.String(u.key),
+		UploadId:         aws.String(u.id),
+		PartNumberMarker: aws.Int64(marker),
+		MaxParts:         aws.Int64(int64(maxParts)),
+		IsTruncated:      aws.Bool(len(numbers) > maxParts),
+		Initiator:        &s3.Initiator{ID: aws.String(s.OwnerID), DisplayName: aws.String(s.OwnerID)},
+		Owner:            s.owner(),
+		StorageClass:     aws.String(s3.StorageClassStandard),
+	}
+	if len(numbers) > maxParts {
+		numbers = numbers[:maxParts]
+		out.NextPartNumberMarker = aws.Int64(numbers[len(numbers)-1])
+	}
+	for _, number := range numbers {
+		p := u.parts[number]
+		out.Parts = append(out.Parts, &s3.Part{
+			PartNumber:   aws.Int64(p.number),
+			ETag:         aws.String(p.etag),
+			Size:         aws.Int64(int64(len(p.data))),
+			LastModified: aws.Time(p.lastModified),
+		})
+	}
+	writeXML(w, http.StatusOK, "ListPartsResult", out)
+}
+
+func (s *Server) listMultipartUploads(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	maxUploads, ok := maxKeysOf(w, r, "max-uploads")
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	prefix, keyMarker, uploadIDMarker := q.Get("prefix"), q.Get("key-marker"), q.Get("upload-id-marker")
+
+	var uploads []*upload
+	for _, u := range b.uploads {
+		if !strings.HasPrefix(u.key, prefix) || u.key < keyMarker ||
+			(u.key == keyMarker && (len(uploadIDMarker) == 0 || u.id <= uploadIDMarker)) {
+			continue
+		}
+		uploads = append(uploads, u)
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		if uploads[i].key != uploads[j].key {
+			return uploads[i].key < uploads[j].key
+		}
+		return uploads[i].id < uploads[j].id
+	})
+
+	out := &s3.ListMultipartUploadsOutput{
+		Bucket:         aws.String(b.name),
+		Prefix:         aws.String(prefix),
+		KeyMarker:      aws.String(keyMarker),
+		UploadIdMarker: aws.String(uploadIDMarker),
+		MaxUploads:     aws.Int64(int64(maxUploads)),
+		IsTruncated:    aws.Bool(len(uploads) > maxUploads),
+	}
+	if len(uploads) > maxUploads {
+		uploads = uploads[:maxUploads]
+		last := uploads[len(uploads)-1]
+		out.NextKeyMarker = aws.String(last.key)
+		out.NextUploadIdMarker = aws.String(last.id)
+	}
+	for _, u := range uploads {
+		out.Uploads = append(out.Uploads, &s3.MultipartUpload{
+			Key:          aws.String(u.key),
+			UploadId:     aws.String(u.id),
+			Initiated:    aws.Time(u.initiated),
+			StorageClass: aws.String(s3.StorageClassStandard),
+			Owner:        s.owner(),
+			Initiator:    &s3.Initiator{ID: aws.String(s.OwnerID), DisplayName: aws.String(s.OwnerID)},
+		})
+	}
+	writeXML(w, http.StatusOK, "ListMultipartUploadsResult", out)
+}
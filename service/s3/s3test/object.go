@@ -0,0 +1,691 @@
+package s3test
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// IBM COS retention limits.
+const (
+	permanentRetentionPeriod = -1
+	maxLegalHolds            = 100
+	maxLegalHoldIDLength     = 64
+	secondsPerDay            = 24 * 60 * 60
+)
+
+// s3Error is an error response of an operation.
+type s3Error struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *s3Error) write(w http.ResponseWriter) {
+	writeError(w, e.status, e.code, e.message)
+}
+
+var errObjectProtected = &s3Error{http.StatusForbidden, "AccessDenied",
+	"The object is protected by a retention period or legal hold."}
+
+func (s *Server) putObject(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	data := r.body
+	if md5Header := r.Header.Get("Content-MD5"); len(md5Header) != 0 {
+		sum := md5.Sum(data)
+		if md5Header != base64.StdEncoding.EncodeToString(sum[:]) {
+			writeError(w, http.StatusBadRequest, "BadDigest",
+				"The Content-MD5 you specified did not match what we received.")
+			return
+		}
+	}
+	if r.Header.Get("If-None-Match") == "*" {
+		if cur := b.current(r.key); cur != nil && !cur.deleteMarker {
+			writeError(w, http.StatusPreconditionFailed, "PreconditionFailed",
+				"At least one of the pre-conditions you specified did not hold")
+			return
+		}
+	}
+	tags, err := tagsOf(r.Header.Get("X-Amz-Tagging"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", err.Error())
+		return
+	}
+
+	v := &objectVersion{
+		key:         r.key,
+		data:        data,
+		etag:        etagOf(data),
+		contentType: r.Header.Get("Content-Type"),
+		metadata:    metadataOf(r.Header),
+		tags:        tags,
+	}
+	if e := s.store(b, v, r.Header); e != nil {
+		e.write(w)
+		return
+	}
+
+	w.Header().Set("ETag", v.etag)
+	s.writeVersionID(w, b, v)
+}
+
+// store applies the retention of the request headers to the version and
+// stores it as the current version of its key.
+func (s *Server) store(b *bucket, v *objectVersion, h http.Header) *s3Error {
+	now := s.now()
+	v.versionID = s.newVersionID(b)
+	v.lastModified = now
+	v.created = now
+	if len(v.contentType) == 0 {
+		v.contentType = "binary/octet-stream"
+	}
+
+	if v.versionID == nullVersionID {
+		if prev := b.version(v.key, nullVersionID); prev != nil && prev.protected(now) {
+			return errObjectProtected
+		}
+	}
+	if e := s.applyRetention(b, v, h); e != nil {
+		return e
+	}
+
+	b.put(v)
+	return nil
+}
+
+// applyRetention sets the retention of the version from the Retention-Period,
+// Retention-Expiration-Date and Retention-Legal-Hold-ID headers, or the
+// default retention of the bucket.
+func (s *Server) applyRetention(b *bucket, v *objectVersion, h http.Header) *s3Error {
+	period := h.Get("Retention-Period")
+	expiration := h.Get("Retention-Expiration-Date")
+	holdID := h.Get("Retention-Legal-Hold-ID")
+
+	cfg := b.protection
+	if cfg == nil {
+		if len(period) != 0 || len(expiration) != 0 || len(holdID) != 0 {
+			return &s3Error{http.StatusBadRequest, "InvalidRequest", "Retention is not enabled for the bucket."}
+		}
+		return nil
+	}
+
+	var seconds int64
+	switch {
+	case len(period) != 0:
+		n, err := strconv.ParseInt(period, 10, 64)
+		if err != nil {
+			return &s3Error{http.StatusBadRequest, "InvalidArgument", "invalid retention period " + period}
+		}
+		seconds = n
+	case len(expiration) != 0:
+		t, err := parseHeaderTime(expiration)
+		if err != nil {
+			return &s3Error{http.StatusBadRequest, "InvalidArgument", "invalid retention expiration date " + expiration}
+		}
+		seconds = int64(t.Sub(v.created) / time.Second)
+	case cfg.DefaultRetention != nil:
+		seconds = aws.Int64Value(cfg.DefaultRetention.Days) * secondsPerDay
+	}
+	if e := validateRetention(cfg, seconds); e != nil {
+		return e
+	}
+	setRetention(v, seconds)
+
+	if len(holdID) != 0 {
+		if e := addLegalHold(v, holdID, v.created); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func validateRetention(cfg *s3.ProtectionConfiguration, seconds int64) *s3Error {
+	if seconds == permanentRetentionPeriod {
+		if !aws.BoolValue(cfg.EnablePermanentRetention) {
+			return &s3Error{http.StatusBadRequest, "InvalidArgument", "Permanent retention is not enabled for the bucket."}
+		}
+		return nil
+	}
+
+	if cfg.MinimumRetention != nil && seconds < aws.Int64Value(cfg.MinimumRetention.Days)*secondsPerDay {
+		return &s3Error{http.StatusBadRequest, "InvalidArgument", "The retention period is shorter than the minimum retention."}
+	}
+	if cfg.MaximumRetention != nil && aws.Int64Value(cfg.MaximumRetention.Days) != 0 &&
+		seconds > aws.Int64Value(cfg.MaximumRetention.Days)*secondsPerDay {
+		return &s3Error{http.StatusBadRequest, "InvalidArgument", "The retention period is longer than the maximum retention."}
+	}
+	return nil
+}
+
+func setRetention(v *objectVersion, seconds int64) {
+	v.retentionPeriod = seconds
+	v.retentionExpiration = time.Time{}
+	if seconds > 0 {
+		v.retentionExpiration = v.created.Add(time.Duration(seconds) * time.Second)
+	}
+}
+
+func addLegalHold(v *objectVersion, id string, now time.Time) *s3Error {
+	if len(id) == 0 || len(id) > maxLegalHoldIDLength {
+		return &s3Error{http.StatusBadRequest, "InvalidArgument", "invalid legal hold ID " + id}
+	}
+	for _, hold := range v.legalHolds {
+		if aws.StringValue(hold.ID) == id {
+			return nil
+		}
+	}
+	if len(v.legalHolds) == maxLegalHolds {
+		return &s3Error{http.StatusBadRequest, "InvalidArgument", "The object has the maximum number of legal holds."}
+	}
+	v.legalHolds = append(v.legalHolds, &s3.LegalHold{ID: aws.String(id), Date: aws.Time(now)})
+	return nil
+}
+
+func (s *Server) copyObject(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	src, e := s.copySource(r)
+	if e != nil {
+		e.write(w)
+		return
+	}
+
+	v := &objectVersion{
+		key:         r.key,
+		data:        src.data,
+		etag:        src.etag,
+		contentType: src.contentType,
+		metadata:    src.metadata,
+		tags:        src.tags,
+	}
+	if r.Header.Get("X-Amz-Metadata-Directive") == s3.MetadataDirectiveReplace {
+		v.contentType = r.Header.Get("Content-Type")
+		v.metadata = metadataOf(r.Header)
+	}
+	if r.Header.Get("X-Amz-Tagging-Directive") == s3.TaggingDirectiveReplace {
+		tags, err := tagsOf(r.Header.Get("X-Amz-Tagging"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidArgument", err.Error())
+			return
+		}
+		v.tags = tags
+	}
+	if e := s.store(b, v, r.Header); e != nil {
+		e.write(w)
+		return
+	}
+
+	if src.versionID != nullVersionID {
+		w.Header().Set("X-Amz-Copy-Source-Version-Id", src.versionID)
+	}
+	s.writeVersionID(w, b, v)
+	writeXML(w, http.StatusOK, "CopyObjectResult", &s3.CopyObjectResult{
+		ETag:         aws.String(v.etag),
+		LastModified: aws.Time(v.lastModified),
+	})
+}
+
+// copySource returns the object version of the X-Amz-Copy-Source header,
+// bucket/key with an optional versionId query.
+func (s *Server) copySource(r *request) (*objectVersion, *s3Error) {
+	source := r.Header.Get("X-Amz-Copy-Source")
+	versionID := ""
+	if i := strings.Index(source, "?"); i >= 0 {
+		q, _ := url.ParseQuery(source[i+1:])
+		versionID = q.Get("versionId")
+		source = source[:i]
+	}
+	if unescaped, err := url.PathUnescape(source); err == nil {
+		source = unescaped
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(source, "/"), "/", 2)
+	if len(parts) != 2 || len(parts[1]) == 0 {
+		return nil, &s3Error{http.StatusBadRequest, "InvalidArgument", "invalid copy source " + source}
+	}
+	b, ok := s.buckets[parts[0]]
+	if !ok {
+		return nil, &s3Error{http.StatusNotFound, s3.ErrCodeNoSuchBucket, "The specified bucket does not exist."}
+	}
+	v := b.version(parts[1], versionID)
+	if v == nil || v.deleteMarker {
+		return nil, &s3Error{http.StatusNotFound, s3.ErrCodeNoSuchKey, "The specified key does not exist."}
+	}
+	return v, nil
+}
+
+func (s *Server) getObject(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	v, e := s.readableVersion(w, b, r)
+	if e != nil {
+		e.write(w)
+		return
+	}
+
+	if status, ok := checkConditions(r.Header, v); !ok {
+		w.Header().Set("ETag", v.etag)
+		if status == http.StatusNotModified {
+			w.WriteHeader(status)
+			return
+		}
+		writeError(w, status, "PreconditionFailed", "At least one of the pre-conditions you specified did not hold")
+		return
+	}
+
+	h := w.Header()
+	h.Set("ETag", v.etag)
+	h.Set("Last-Modified", v.lastModified.Format(http.TimeFormat))
+	h.Set("Content-Type", v.contentType)
+	h.Set("Accept-Ranges", "bytes")
+	for name, value := range v.metadata {
+		h.Set("X-Amz-Meta-"+name, value)
+	}
+	if len(v.tags) != 0 {
+		h.Set("X-Amz-Tagging-Count", strconv.Itoa(len(v.tags)))
+	}
+	if v.retentionPeriod != 0 {
+		h.Set("Retention-Period", strconv.FormatInt(v.retentionPeriod, 10))
+		if !v.retentionExpiration.IsZero() {
+			h.Set("Retention-Expiration-Date", protocol.FormatTime(protocol.RFC822TimeFormatName, v.retentionExpiration))
+		}
+	}
+	if len(v.legalHolds) != 0 {
+		h.Set("Retention-Legal-Hold-Count", strconv.Itoa(len(v.legalHolds)))
+	}
+	s.writeVersionID(w, b, v)
+
+	data := v.data
+	status := http.StatusOK
+	if rangeHeader := r.Header.Get("Range"); len(rangeHeader) != 0 {
+		start, end, ok := parseRange(rangeHeader, int64(len(data)))
+		if !ok {
+			writeError(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange",
+				"The requested range is not satisfiable")
+			return
+		}
+		h.Set("Content-Range", "bytes "+strconv.FormatInt(start, 10)+"-"+
+			strconv.FormatInt(end, 10)+"/"+strconv.Itoa(len(data)))
+		data = data[start : end+1]
+		status = http.StatusPartialContent
+	}
+
+	h.Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+// readableVersion returns the version of the request, returning NoSuchKey or
+// NoSuchVersion errors for missing versions and delete markers.
+func (s *Server) readableVersion(w http.ResponseWriter, b *bucket, r *request) (*objectVersion, *s3Error) {
+	versionID := r.URL.Query().Get("versionId")
+	v := b.version(r.key, versionID)
+	switch {
+	case v == nil && len(versionID) != 0:
+		return nil, &s3Error{http.StatusNotFound, "NoSuchVersion", "The specified version does not exist."}
+	case v == nil:
+		return nil, &s3Error{http.StatusNotFound, s3.ErrCodeNoSuchKey, "The specified key does not exist."}
+	case v.deleteMarker:
+		w.Header().Set("X-Amz-Delete-Marker", "true")
+		w.Header().Set("X-Amz-Version-Id", v.versionID)
+		if len(versionID) != 0 {
+			return nil, &s3Error{http.StatusMethodNotAllowed, "MethodNotAllowed",
+				"The specified method is not allowed against this resource."}
+		}
+		return nil, &s3Error{http.StatusNotFound, s3.ErrCodeNoSuchKey, "The specified key does not exist."}
+	}
+	return v, nil
+}
+
+// checkConditions evaluates the conditional headers of the request against the
+// version. Returns the status of the failed condition, and false if a
+// condition does not hold.
+func checkConditions(h http.Header, v *objectVersion) (int, bool) {
+	if match := h.Get("If-Match"); len(match) != 0 && !etagMatches(match, v.etag) {
+		return http.StatusPreconditionFailed, false
+	}
+	if since := h.Get("If-Unmodified-Since"); len(since) != 0 && len(h.Get("If-Match")) == 0 {
+		if t, err := http.ParseTime(since); err == nil && v.lastModified.After(t) {
+			return http.StatusPreconditionFailed, false
+		}
+	}
+	if noneMatch := h.Get("If-None-Match"); len(noneMatch) != 0 {
+		if etagMatches(noneMatch, v.etag) {
+			return http.StatusNotModified, false
+		}
+	} else if since := h.Get("If-Modified-Since"); len(since) != 0 {
+		if t, err := http.ParseTime(since); err == nil && !v.lastModified.After(t) {
+			return http.StatusNotModified, false
+		}
+	}
+	return http.StatusOK, true
+}
+
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.Trim(candidate, `"`) == strings.Trim(etag, `"`) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRange parses a single byte range of the Range header. Returns the
+// inclusive start and end of the range, and false if it is not satisfiable.
+// Unsupported ranges select the whole object.
+func parseRange(header string, size int64) (int64, int64, bool) {
+	spec := strings.TrimPrefix(header, "bytes=")
+	i := strings.Index(spec, "-")
+	if spec == header || i < 0 || strings.Contains(spec, ",") {
+		return 0, size - 1, size > 0
+	}
+
+	first, last := spec[:i], spec[i+1:]
+	if len(first) == 0 {
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n <= 0 {
+			return 0, size - 1, size > 0
+		}
+		if n > size {
+			n = size
+		}
+		return size - n, size - 1, size > 0
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return 0, size - 1, size > 0
+	}
+	end := size - 1
+	if len(last) != 0 {
+		if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+			return 0, size - 1, size > 0
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	return start, end, start < size
+}
+
+func (s *Server) deleteObject(w http.ResponseWriter, r *request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	deleted, e := s.deleteVersion(b, r.key, r.URL.Query().Get("versionId"))
+	if e != nil {
+		e.write(w)
+		return
+	}
+
+	if aws.BoolValue(deleted.DeleteMarker) {
+		w.Header().Set("X-Amz-Delete-Marker", "true")
+	}
+	if versionID := aws.StringValue(deleted.DeleteMarkerVersionId); len(versionID) != 0 {
+		w.Header().Set("X-Amz-Version-Id", versionID)
+	} else if versionID := aws.StringValue(deleted.VersionId); len(versionID) != 0 {
+		w.Header().Set("X-Amz-Version-Id", versionID)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteVersion deletes the version of the key, or the current version if the
+// version ID is empty, adding a delete marker if the bucket is versioned.
+func (s *Server) deleteVersion(b *bucket, key, versionID string) (*s3.DeletedObject, *s3Error) {
+	now := s.now()
+	deleted := &s3.DeletedObject{Key: aws.String(key)}
+
+	if len(versionID) != 0 {
+		deleted.VersionId = aws.String(versionID)
+		v := b.version(key, versionID)
+		if v == nil {
+			return deleted, nil
+		}
+		if v.protected(now) {
+			return nil, errObjectProtected
+		}
+		b.remove(key, versionID)
+		if v.deleteMarker {
+			deleted.DeleteMarker = aws.Bool(true)
+			deleted.DeleteMarkerVersionId = aws.String(versionID)
+		}
+		return deleted, nil
+	}
+
+	if len(b.versioning) == 0 {
+		if v := b.current(key); v != nil {
+			if v.protected(now) {
+				return nil, errObjectProtected
+			}
+			b.remove(key, v.versionID)
+		}
+		return deleted, nil
+	}
+
+	marker := &objectVersion{
+		key:          key,
+		versionID:    s.newVersionID(b),
+		deleteMarker: true,
+		lastModified: now,
+	}
+	if marker.versionID == nullVersionID {
+		if prev := b.version(key, nullVersionID); prev != nil && prev.protected(now) {
+			return nil, errObjectProtected
+		}
+	}
+	b.put(marker)
+	deleted.DeleteMarker = aws.Bool(true)
+	deleted.DeleteMarkerVersionId = aws.String(marker.versionID)
+	return deleted, nil
+}
+
+// writeVersionID sets the X-Amz-Version-Id header of versioned buckets.
+func (s *Server) writeVersionID(w http.ResponseWriter, b *bucket, v *objectVersion) {
+	if len(b.versioning) != 0 {
+		w.Header().Set("X-Amz-Version-Id", v.versionID)
+	}
+}
+
+// taggedVersion returns the version of a tagging or legal hold request.
+func (s *Server) taggedVersion(w http.ResponseWriter, r *request) (*bucket, *objectVersion, bool) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	v, e := s.readableVersion(w, b, r)
+	if e != nil {
+		e.write(w)
+		return nil, nil, false
+	}
+	return b, v, true
+}
+
+func (s *Server) getObjectTagging(w http.ResponseWriter, r *request) {
+	b, v, ok := s.taggedVersion(w, r)
+	if !ok {
+		return
+	}
+	s.writeVersionID(w, b, v)
+	writeXML(w, http.StatusOK, "Tagging", &s3.GetObjectTaggingOutput{TagSet: v.tags})
+}
+
+func (s *Server) putObjectTagging(w http.ResponseWriter, r *request) {
+	b, v, ok := s.taggedVersion(w, r)
+	if !ok {
+		return
+	}
+	tagging := &s3.Tagging{}
+	if !readXML(w, r, tagging) {
+		return
+	}
+	if err := tagging.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidTag", err.Error())
+		return
+	}
+	v.tags = sortTags(tagging.TagSet)
+	s.writeVersionID(w, b, v)
+}
+
+func (s *Server) deleteObjectTagging(w http.ResponseWriter, r *request) {
+	b, v, ok := s.taggedVersion(w, r)
+	if !ok {
+		return
+	}
+	v.tags = nil
+	s.writeVersionID(w, b, v)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// tagsOf parses the URL encoded tags of the X-Amz-Tagging header.
+func tagsOf(header string) ([]*s3.Tag, error) {
+	if len(header) == 0 {
+		return nil, nil
+	}
+	q, err := url.ParseQuery(header)
+	if err != nil {
+		return nil, err
+	}
+	var tags []*s3.Tag
+	for key, values := range q {
+		tags = append(tags, &s3.Tag{Key: aws.String(key), Value: aws.String(values[0])})
+	}
+	return sortTags(tags), nil
+}
+
+func sortTags(tags []*s3.Tag) []*s3.Tag {
+	sort.Slice(tags, func(i, j int) bool {
+		return aws.StringValue(tags[i].Key) < aws.StringValue(tags[j].Key)
+	})
+	return tags
+}
+
+// protectedVersion returns the current version of a legal hold or retention
+// request, in a bucket with protection enabled.
+func (s *Server) protectedVersion(w http.ResponseWriter, r *request) (*objectVersion, bool) {
+	b, v, ok := s.taggedVersion(w, r)
+	if !ok {
+		return nil, false
+	}
+	if b.protection == nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequest", "Retention is not enabled for the bucket.")
+		return nil, false
+	}
+	return v, true
+}
+
+func (s *Server) addLegalHold(w http.ResponseWriter, r *request) {
+	v, ok := s.protectedVersion(w, r)
+	if !ok {
+		return
+	}
+	if e := addLegalHold(v, r.URL.Query().Get("add"), s.now()); e != nil {
+		e.write(w)
+	}
+}
+
+func (s *Server) deleteLegalHold(w http.ResponseWriter, r *request) {
+	v, ok := s.protectedVersion(w, r)
+	if !ok {
+		return
+	}
+	id := r.URL.Query().Get("remove")
+	kept := v.legalHolds[:0:0]
+	for _, hold := range v.legalHolds {
+		if aws.StringValue(hold.ID) != id {
+			kept = append(kept, hold)
+		}
+	}
+	v.legalHolds = kept
+}
+
+func (s *Server) listLegalHolds(w http.ResponseWriter, r *request) {
+	v, ok := s.protectedVersion(w, r)
+	if !ok {
+		return
+	}
+	out := &s3.ListLegalHoldsOutput{
+		CreateTime:      aws.Time(v.created),
+		LegalHolds:      v.legalHolds,
+		RetentionPeriod: aws.Int64(v.retentionPeriod),
+	}
+	if !v.retentionExpiration.IsZero() {
+		out.RetentionPeriodExpirationDate = aws.Time(v.retentionExpiration)
+	}
+	writeXML(w, http.StatusOK, "RetentionState", out)
+}
+
+// extendObjectRetention extends the retention of the object with one of the
+// Additional-Retention-Period, Extend-Retention-From-Current-Time,
+// New-Retention-Expiration-Date or New-Retention-Period headers. The
+// retention cannot be shortened.
+func (s *Server) extendObjectRetention(w http.ResponseWriter, r *request) {
+	v, ok := s.protectedVersion(w, r)
+	if !ok {
+		return
+	}
+	if v.retentionPeriod == permanentRetentionPeriod {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "The object is permanently retained.")
+		return
+	}
+
+	now := s.now()
+	seconds, err := int64(0), error(nil)
+	h := r.Header
+	switch {
+	case len(h.Get("New-Retention-Period")) != 0:
+		seconds, err = strconv.ParseInt(h.Get("New-Retention-Period"), 10, 64)
+	case len(h.Get("Additional-Retention-Period")) != 0:
+		seconds, err = strconv.ParseInt(h.Get("Additional-Retention-Period"), 10, 64)
+		seconds += v.retentionPeriod
+	case len(h.Get("Extend-Retention-From-Current-Time")) != 0:
+		seconds, err = strconv.ParseInt(h.Get("Extend-Retention-From-Current-Time"), 10, 64)
+		seconds += int64(now.Sub(v.created) / time.Second)
+	case len(h.Get("New-Retention-Expiration-Date")) != 0:
+		var t time.Time
+		t, err = parseHeaderTime(h.Get("New-Retention-Expiration-Date"))
+		seconds = int64(t.Sub(v.created) / time.Second)
+	default:
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "A new retention period is required.")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", err.Error())
+		return
+	}
+
+	if seconds != permanentRetentionPeriod && seconds < v.retentionPeriod {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "The retention period cannot be shortened.")
+		return
+	}
+	b := s.buckets[r.bucket]
+	if e := validateRetention(b.protection, seconds); e != nil {
+		e.write(w)
+		return
+	}
+	setRetention(v, seconds)
+}
+
+func parseHeaderTime(v string) (time.Time, error) {
+	if t, err := protocol.ParseTime(protocol.RFC822TimeFormatName, v); err == nil {
+		return t, nil
+	}
+	return protocol.ParseTime(protocol.ISO8601TimeFormatName, v)
+}
@@ -0,0 +1,442 @@
+package s3test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol/xml/xmlutil"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// Defaults of the Server.
+const (
+	// DefaultRegion is the region of the aws.Config returned by Server.Config.
+	DefaultRegion = "us-south"
+
+	// DefaultMinPartSize is the minimum size of the parts of a multipart
+	// upload, except the last one.
+	DefaultMinPartSize = 5 * 1024 * 1024
+
+	// DefaultOwnerID is the ID of the owner of buckets and objects.
+	DefaultOwnerID = "s3test-owner"
+)
+
+// Server is an in-memory fake IBM COS server. A Server must be created with
+// NewServer, and is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	// Now returns the time of the server, used for object times and
+	// retention. Defaults to time.Now.
+	Now func() time.Time
+
+	// Minimum size of the parts of a multipart upload, except the last one.
+	MinPartSize int64
+
+	// ID of the owner of buckets and objects.
+	OwnerID string
+
+	m        sync.Mutex
+	buckets  map[string]*bucket
+	faults   []*Fault
+	requests []string
+	seq      int64
+}
+
+// NewServer creates and starts a new Server. Pass in additional functional
+// options to customize the server's behavior. The server must be closed with
+// Close.
+//
+// Example:
+//
+//	srv := s3test.NewServer(func(s *s3test.Server) {
+//	    s.MinPartSize = 1024
+//	})
+//	defer srv.Close()
+func NewServer(options ...func(*Server)) *Server {
+	s := &Server{
+		Now:         time.Now,
+		MinPartSize: DefaultMinPartSize,
+		OwnerID:     DefaultOwnerID,
+		buckets:     map[string]*bucket{},
+	}
+
+	for _, option := range options {
+		option(s)
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Config returns the aws.Config of a client using the server, with static
+// credentials and path style addressing.
+func (s *Server) Config() *aws.Config {
+	return &aws.Config{
+		Region:           aws.String(DefaultRegion),
+		Endpoint:         aws.String(s.URL),
+		DisableSSL:       aws.Bool(true),
+		S3ForcePathStyle: aws.Bool(true),
+		Credentials:      credentials.NewStaticCredentials("AKID", "SECRET", ""),
+	}
+}
+
+// Requests returns the operation names of the requests received by the
+// server, in order, including the requests failed by faults.
+func (s *Server) Requests() []string {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return append([]string{}, s.requests...)
+}
+
+// CreateBucket creates an empty bucket, e.g. to set up a test.
+func (s *Server) CreateBucket(name string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if _, ok := s.buckets[name]; !ok {
+		s.buckets[name] = newBucket(name, s.Now())
+	}
+}
+
+// Object returns the content of the current version of the object, and
+// whether it exists.
+func (s *Server) Object(bucketName, key string) ([]byte, bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[bucketName]
+	if !ok {
+		return nil, false
+	}
+	v := b.current(key)
+	if v == nil || v.deleteMarker {
+		return nil, false
+	}
+	return append([]byte{}, v.data...), true
+}
+
+// request is the parsed request of an operation.
+type request struct {
+	*http.Request
+	op     string
+	bucket string
+	key    string
+
+	// body is the request body, read before the server is locked so slow
+	// uploads do not block other requests.
+	body []byte
+}
+
+type handlerFunc func(s *Server, w http.ResponseWriter, r *request)
+
+var handlers = map[string]handlerFunc{
+	"ListBuckets":                        (*Server).listBuckets,
+	"ListBucketsExtended":                (*Server).listBuckets,
+	"CreateBucket":                       (*Server).createBucket,
+	"DeleteBucket":                       (*Server).deleteBucket,
+	"HeadBucket":                         (*Server).headBucket,
+	"GetBucketLocation":                  (*Server).getBucketLocation,
+	"GetBucketVersioning":                (*Server).getBucketVersioning,
+	"PutBucketVersioning":                (*Server).putBucketVersioning,
+	"GetBucketProtectionConfiguration":   (*Server).getBucketProtectionConfiguration,
+	"PutBucketProtectionConfiguration":   (*Server).putBucketProtectionConfiguration,
+	"GetBucketPolicy":                    (*Server).getBucketPolicy,
+	"PutBucketPolicy":                    (*Server).putBucketPolicy,
+	"DeleteBucketPolicy":                 (*Server).deleteBucketPolicy,
+	"GetBucketTagging":                   (*Server).getBucketTagging,
+	"PutBucketTagging":                   (*Server).putBucketTagging,
+	"DeleteBucketTagging":                (*Server).deleteBucketTagging,
+	"GetBucketNotificationConfiguration": (*Server).getBucketNotificationConfiguration,
+	"PutBucketNotificationConfiguration": (*Server).putBucketNotificationConfiguration,
+	"ListObjects":                        (*Server).listObjects,
+	"ListObjectsV2":                      (*Server).listObjectsV2,
+	"ListObjectVersions":                 (*Server).listObjectVersions,
+	"DeleteObjects":                      (*Server).deleteObjects,
+	"PutObject":                          (*Server).putObject,
+	"CopyObject":                         (*Server).copyObject,
+	"GetObject":                          (*Server).getObject,
+	"HeadObject":                         (*Server).getObject,
+	"DeleteObject":                       (*Server).deleteObject,
+	"GetObjectTagging":                   (*Server).getObjectTagging,
+	"PutObjectTagging":                   (*Server).putObjectTagging,
+	"DeleteObjectTagging":                (*Server).deleteObjectTagging,
+	"AddLegalHold":                       (*Server).addLegalHold,
+	"DeleteLegalHold":                    (*Server).deleteLegalHold,
+	"ListLegalHolds":                     (*Server).listLegalHolds,
+	"ExtendObjectRetention":              (*Server).extendObjectRetention,
+	"ListMultipartUploads":               (*Server).listMultipartUploads,
+	"CreateMultipartUpload":              (*Server).createMultipartUpload,
+	"UploadPart":                         (*Server).uploadPart,
+	"UploadPartCopy":                     (*Server).uploadPart,
+	"CompleteMultipartUpload":            (*Server).completeMultipartUpload,
+	"AbortMultipartUpload":               (*Server).abortMultipartUpload,
+	"ListParts":                          (*Server).listParts,
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	req := parseRequest(r)
+
+	s.m.Lock()
+	s.requests = append(s.requests, req.op)
+	fault := s.matchFault(req)
+	s.m.Unlock()
+
+	if fault != nil {
+		if w = fault.apply(w); w == nil {
+			return
+		}
+	}
+
+	handler, ok := handlers[req.op]
+	if !ok {
+		writeError(w, http.StatusNotImplemented, "NotImplemented",
+			"operation is not implemented by s3test")
+		return
+	}
+
+	var err error
+	if req.body, err = ioutil.ReadAll(r.Body); err != nil {
+		writeError(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+		return
+	}
+
+	// The request body is read before locking and the response is written
+	// after unlocking, so slow clients and faults do not block other
+	// requests.
+	rec := httptest.NewRecorder()
+	s.m.Lock()
+	handler(s, rec, req)
+	s.m.Unlock()
+
+	for name, values := range rec.Header() {
+		w.Header()[name] = values
+	}
+	w.WriteHeader(rec.Code)
+	if r.Method != http.MethodHead {
+		w.Write(rec.Body.Bytes())
+	}
+}
+
+// parseRequest returns the operation of the path style request.
+func parseRequest(r *http.Request) *request {
+	req := &request{Request: r}
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	req.bucket = parts[0]
+	if len(parts) == 2 {
+		req.key = parts[1]
+	}
+	req.op = operationName(r, req.bucket, req.key)
+	return req
+}
+
+func operationName(r *http.Request, bucket, key string) string {
+	q := r.URL.Query()
+	has := func(name string) bool {
+		_, ok := q[name]
+		return ok
+	}
+	copySource := len(r.Header.Get("X-Amz-Copy-Source")) != 0
+
+	switch {
+	case len(bucket) == 0:
+		if has("extended") {
+			return "ListBucketsExtended"
+		}
+		return "ListBuckets"
+
+	case len(key) == 0:
+		switch r.Method {
+		case http.MethodGet:
+			switch {
+			case has("versioning"):
+				return "GetBucketVersioning"
+			case has("protection"):
+				return "GetBucketProtectionConfiguration"
+			case has("policy"):
+				return "GetBucketPolicy"
+			case has("tagging"):
+				return "GetBucketTagging"
+			case has("notification"):
+				return "GetBucketNotificationConfiguration"
+			case has("location"):
+				return "GetBucketLocation"
+			case has("uploads"):
+				return "ListMultipartUploads"
+			case has("versions"):
+				return "ListObjectVersions"
+			case q.Get("list-type") == "2":
+				return "ListObjectsV2"
+			}
+			return "ListObjects"
+		case http.MethodPut:
+			switch {
+			case has("versioning"):
+				return "PutBucketVersioning"
+			case has("protection"):
+				return "PutBucketProtectionConfiguration"
+			case has("policy"):
+				return "PutBucketPolicy"
+			case has("tagging"):
+				return "PutBucketTagging"
+			case has("notification"):
+				return "PutBucketNotificationConfiguration"
+			case len(r.URL.RawQuery) == 0:
+				return "CreateBucket"
+			}
+		case http.MethodDelete:
+			switch {
+			case has("policy"):
+				return "DeleteBucketPolicy"
+			case has("tagging"):
+				return "DeleteBucketTagging"
+			case len(r.URL.RawQuery) == 0:
+				return "DeleteBucket"
+			}
+		case http.MethodHead:
+			return "HeadBucket"
+		case http.MethodPost:
+			if has("delete") {
+				return "DeleteObjects"
+			}
+		}
+
+	default:
+		switch r.Method {
+		case http.MethodGet:
+			switch {
+			case has("tagging"):
+				return "GetObjectTagging"
+			case has("legalHold"):
+				return "ListLegalHolds"
+			case has("uploadId"):
+				return "ListParts"
+			}
+			return "GetObject"
+		case http.MethodHead:
+			return "HeadObject"
+		case http.MethodPut:
+			switch {
+			case has("tagging"):
+				return "PutObjectTagging"
+			case has("uploadId") && copySource:
+				return "UploadPartCopy"
+			case has("uploadId"):
+				return "UploadPart"
+			case copySource:
+				return "CopyObject"
+			}
+			return "PutObject"
+		case http.MethodDelete:
+			switch {
+			case has("tagging"):
+				return "DeleteObjectTagging"
+			case has("uploadId"):
+				return "AbortMultipartUpload"
+			}
+			return "DeleteObject"
+		case http.MethodPost:
+			switch {
+			case has("uploads"):
+				return "CreateMultipartUpload"
+			case has("uploadId"):
+				return "CompleteMultipartUpload"
+			case has("legalHold") && has("add"):
+				return "AddLegalHold"
+			case has("legalHold") && has("remove"):
+				return "DeleteLegalHold"
+			case has("extendRetention"):
+				return "ExtendObjectRetention"
+			}
+		}
+	}
+
+	return r.Method + " " + r.URL.RawQuery
+}
+
+// nextID returns a new unique ID, used for version and upload IDs.
+func (s *Server) nextID() string {
+	s.seq++
+	return fmt.Sprintf("%016x", s.seq)
+}
+
+// now returns the time of the server truncated to seconds, as returned in
+// HTTP headers.
+func (s *Server) now() time.Time {
+	return s.Now().UTC().Truncate(time.Second)
+}
+
+func (s *Server) owner() *s3.Owner {
+	return &s3.Owner{ID: aws.String(s.OwnerID), DisplayName: aws.String(s.OwnerID)}
+}
+
+// bucket returns the bucket of the request, writing a NoSuchBucket error if
+// it does not exist.
+func (s *Server) bucket(w http.ResponseWriter, r *request) (*bucket, bool) {
+	b, ok := s.buckets[r.bucket]
+	if !ok {
+		writeError(w, http.StatusNotFound, s3.ErrCodeNoSuchBucket, "The specified bucket does not exist.")
+	}
+	return b, ok
+}
+
+// writeXML writes the SDK shape as the XML body of the response, with the
+// root element name.
+func writeXML(w http.ResponseWriter, status int, root string, v interface{}) {
+	wrapper := reflect.New(reflect.StructOf([]reflect.StructField{{
+		Name: "V",
+		Type: reflect.TypeOf(v),
+		Tag:  reflect.StructTag(`locationName:"` + root + `" type:"structure"`),
+	}})).Elem()
+	wrapper.Field(0).Set(reflect.ValueOf(v))
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	if err := xmlutil.BuildXML(wrapper.Interface(), xml.NewEncoder(&buf)); err != nil {
+		writeError(w, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
+
+// readXML reads the XML body of the request into the SDK shape.
+func readXML(w http.ResponseWriter, r *request, v interface{}) bool {
+	if err := xmlutil.UnmarshalXML(v, xml.NewDecoder(bytes.NewReader(r.body)), ""); err != nil {
+		writeError(w, http.StatusBadRequest, "MalformedXML",
+			"The XML you provided was not well-formed or did not validate against our published schema.")
+		return false
+	}
+	return true
+}
+
+// writeError writes an S3 error response.
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString("<Error><Code>")
+	xml.EscapeText(&buf, []byte(code))
+	buf.WriteString("</Code><Message>")
+	xml.EscapeText(&buf, []byte(message))
+	buf.WriteString("</Message></Error>")
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,539 @@
+package s3test_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/client"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/internal/s3testing"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3test"
+)
+
+func newClient(t *testing.T, options ...func(*s3test.Server)) (*s3test.Server, *s3.S3) {
+	srv := s3test.NewServer(options...)
+
+	cfg := srv.Config()
+	cfg.Retryer = client.DefaultRetryer{
+		NumMaxRetries:    3,
+		MinRetryDelay:    time.Millisecond,
+		MinThrottleDelay: time.Millisecond,
+		MaxRetryDelay:    time.Millisecond,
+		MaxThrottleDelay: time.Millisecond,
+	}
+	svc := s3.New(session.Must(session.NewSession(cfg)))
+
+	if _, err := svc.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String("bucket")}); err != nil {
+		srv.Close()
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return srv, svc
+}
+
+func putObject(t *testing.T, svc *s3.S3, key, body string) *s3.PutObjectOutput {
+	out, err := svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader([]byte(body)),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return out
+}
+
+func expectErrCode(t *testing.T, e string, err error) {
+	t.Helper()
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %v", err)
+	}
+	if a := aerr.Code(); e != a {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+}
+
+func TestServer_Objects(t *testing.T) {
+	srv, svc := newClient(t)
+	defer srv.Close()
+
+	_, err := svc.PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String("bucket"),
+		Key:         aws.String("dir/key"),
+		Body:        bytes.NewReader([]byte("hello world")),
+		ContentType: aws.String("text/plain"),
+		Metadata:    map[string]*string{"Color": aws.String("blue")},
+		Tagging:     aws.String("team=cos&env=test"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	get, err := svc.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("dir/key")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	b, _ := ioutil.ReadAll(get.Body)
+	get.Body.Close()
+	if e, a := "hello world", string(b); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "text/plain", aws.StringValue(get.ContentType); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "blue", aws.StringValue(get.Metadata["Color"]); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := int64(2), aws.Int64Value(get.TagCount); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	_, err = svc.CopyObject(&s3.CopyObjectInput{
+		Bucket:     aws.String("bucket"),
+		Key:        aws.String("copy"),
+		CopySource: aws.String("bucket/dir/key"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	tagging, err := svc.GetObjectTagging(&s3.GetObjectTaggingInput{Bucket: aws.String("bucket"), Key: aws.String("copy")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 2, len(tagging.TagSet); e != a {
+		t.Fatalf("expect %v tags, got %v", e, a)
+	}
+	if e, a := "env", aws.StringValue(tagging.TagSet[0].Key); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	if _, err := svc.DeleteObject(&s3.DeleteObjectInput{Bucket: aws.String("bucket"), Key: aws.String("dir/key")}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	_, err = svc.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("dir/key")})
+	expectErrCode(t, s3.ErrCodeNoSuchKey, err)
+
+	_, err = svc.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("dir/key")})
+	expectErrCode(t, "NotFound", err)
+
+	_, err = svc.GetObject(&s3.GetObjectInput{Bucket: aws.String("missing"), Key: aws.String("key")})
+	expectErrCode(t, s3.ErrCodeNoSuchBucket, err)
+
+	_, err = svc.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("bucket")})
+	expectErrCode(t, "BucketNotEmpty", err)
+}
+
+func TestServer_ListObjectsV2Pagination(t *testing.T) {
+	srv, svc := newClient(t)
+	defer srv.Close()
+
+	for i := 0; i < 7; i++ {
+		putObject(t, svc, fmt.Sprintf("key%d", i), "")
+		putObject(t, svc, fmt.Sprintf("dir%d/key", i), "")
+		putObject(t, svc, fmt.Sprintf("dir%d/other", i), "")
+	}
+
+	var keys, prefixes []string
+	pages := 0
+	err := svc.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket:    aws.String("bucket"),
+		Delimiter: aws.String("/"),
+		MaxKeys:   aws.Int64(3),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		pages++
+		for _, obj := range page.Contents {
+			keys = append(keys, aws.StringValue(obj.Key))
+		}
+		for _, p := range page.CommonPrefixes {
+			prefixes = append(prefixes, aws.StringValue(p.Prefix))
+		}
+		return true
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 7, len(keys); e != a {
+		t.Errorf("expect %v keys, got %v, %v", e, a, keys)
+	}
+	if e, a := 7, len(prefixes); e != a {
+		t.Errorf("expect %v prefixes, got %v, %v", e, a, prefixes)
+	}
+	if e, a := 5, pages; e != a {
+		t.Errorf("expect %v pages, got %v", e, a)
+	}
+	if e, a := "dir0/", prefixes[0]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestServer_Versioning(t *testing.T) {
+	srv, svc := newClient(t)
+	defer srv.Close()
+
+	_, err := svc.PutBucketVersioning(&s3.PutBucketVersioningInput{
+		Bucket:                  aws.String("bucket"),
+		VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(s3.BucketVersioningStatusEnabled)},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	first := putObject(t, svc, "key", "first")
+	putObject(t, svc, "key", "second")
+	del, err := svc.DeleteObject(&s3.DeleteObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if !aws.BoolValue(del.DeleteMarker) {
+		t.Errorf("expect delete marker")
+	}
+
+	versions, err := svc.ListObjectVersions(&s3.ListObjectVersionsInput{Bucket: aws.String("bucket")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 2, len(versions.Versions); e != a {
+		t.Errorf("expect %v versions, got %v", e, a)
+	}
+	if e, a := 1, len(versions.DeleteMarkers); e != a {
+		t.Fatalf("expect %v delete markers, got %v", e, a)
+	}
+	if !aws.BoolValue(versions.DeleteMarkers[0].IsLatest) {
+		t.Errorf("expect delete marker to be latest")
+	}
+
+	get, err := svc.GetObject(&s3.GetObjectInput{
+		Bucket:    aws.String("bucket"),
+		Key:       aws.String("key"),
+		VersionId: first.VersionId,
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	b, _ := ioutil.ReadAll(get.Body)
+	get.Body.Close()
+	if e, a := "first", string(b); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestServer_ConditionalRangeGet(t *testing.T) {
+	srv, svc := newClient(t)
+	defer srv.Close()
+	put := putObject(t, svc, "key", "0123456789")
+
+	get, err := svc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Range:  aws.String("bytes=2-5"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	b, _ := ioutil.ReadAll(get.Body)
+	get.Body.Close()
+	if e, a := "2345", string(b); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "bytes 2-5/10", aws.StringValue(get.ContentRange); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	_, err = svc.GetObject(&s3.GetObjectInput{
+		Bucket:      aws.String("bucket"),
+		Key:         aws.String("key"),
+		IfNoneMatch: put.ETag,
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := 304, err.(awserr.RequestFailure).StatusCode(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	_, err = svc.GetObject(&s3.GetObjectInput{
+		Bucket:  aws.String("bucket"),
+		Key:     aws.String("key"),
+		IfMatch: aws.String(`"etag"`),
+	})
+	expectErrCode(t, "PreconditionFailed", err)
+
+	_, err = svc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Range:  aws.String("bytes=20-"),
+	})
+	expectErrCode(t, "InvalidRange", err)
+}
+
+func TestServer_Retention(t *testing.T) {
+	srv, svc := newClient(t)
+	defer srv.Close()
+
+	_, err := svc.PutBucketProtectionConfiguration(&s3.PutBucketProtectionConfigurationInput{
+		Bucket: aws.String("bucket"),
+		ProtectionConfiguration: &s3.ProtectionConfiguration{
+			Status:           aws.String(s3.BucketProtectionStatusRetention),
+			DefaultRetention: &s3.BucketProtectionDefaultRetention{Days: aws.Int64(1)},
+			MinimumRetention: &s3.BucketProtectionMinimumRetention{Days: aws.Int64(1)},
+			MaximumRetention: &s3.BucketProtectionMaximumRetention{Days: aws.Int64(30)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	putObject(t, svc, "key", "protected")
+	_, err = svc.DeleteObject(&s3.DeleteObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	expectErrCode(t, "AccessDenied", err)
+
+	_, err = svc.AddLegalHold(&s3.AddLegalHoldInput{
+		Bucket:               aws.String("bucket"),
+		Key:                  aws.String("key"),
+		RetentionLegalHoldId: aws.String("case-1"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	_, err = svc.ExtendObjectRetention(&s3.ExtendObjectRetentionInput{
+		Bucket:                    aws.String("bucket"),
+		Key:                       aws.String("key"),
+		AdditionalRetentionPeriod: aws.Int64(24 * 60 * 60),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	holds, err := svc.ListLegalHolds(&s3.ListLegalHoldsInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, len(holds.LegalHolds); e != a {
+		t.Fatalf("expect %v legal holds, got %v", e, a)
+	}
+	if e, a := "case-1", aws.StringValue(holds.LegalHolds[0].ID); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := int64(2*24*60*60), aws.Int64Value(holds.RetentionPeriod); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	_, err = svc.ExtendObjectRetention(&s3.ExtendObjectRetentionInput{
+		Bucket:             aws.String("bucket"),
+		Key:                aws.String("key"),
+		NewRetentionPeriod: aws.Int64(60 * 24 * 60 * 60),
+	})
+	expectErrCode(t, "InvalidArgument", err)
+}
+
+func TestServer_UploadDownload(t *testing.T) {
+	srv, svc := newClient(t)
+	defer srv.Close()
+	data := s3testing.GetTestBytes(12 * 1024 * 1024)
+
+	srv.InjectFault(s3test.Fault{Type: s3test.FaultThrottle, Operation: "UploadPart", Count: 2})
+	uploader := s3manager.NewUploaderWithClient(svc)
+	_, err := uploader.Upload(&s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("large"),
+		Body:   bytes.NewReader(data),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	stored, ok := srv.Object("bucket", "large")
+	if !ok || !bytes.Equal(data, stored) {
+		t.Fatalf("expect uploaded object to match")
+	}
+
+	srv.ClearFaults()
+	srv.InjectFault(s3test.Fault{Type: s3test.FaultConnectionReset, Operation: "GetObject", Count: 1, AfterBytes: 1024})
+	srv.InjectFault(s3test.Fault{Type: s3test.FaultInternalError, Operation: "GetObject", Count: 1})
+	downloader := s3manager.NewDownloaderWithClient(svc)
+	buf := aws.NewWriteAtBuffer(nil)
+	n, err := downloader.Download(buf, &s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("large")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int64(len(data)), n; e != a {
+		t.Errorf("expect %v bytes, got %v", e, a)
+	}
+	if !bytes.Equal(data, buf.Bytes()) {
+		t.Errorf("expect downloaded object to match")
+	}
+
+	// Three parts of each, with the faults retried
+	requests := map[string]int{}
+	for _, op := range srv.Requests() {
+		requests[op]++
+	}
+	if e, a := 5, requests["UploadPart"]; e != a {
+		t.Errorf("expect %v UploadPart requests, got %v", e, a)
+	}
+	if e, a := 5, requests["GetObject"]; e != a {
+		t.Errorf("expect %v GetObject requests, got %v", e, a)
+	}
+}
+
+func TestServer_SlowBody(t *testing.T) {
+	srv, svc := newClient(t)
+	defer srv.Close()
+	putObject(t, svc, "key", "slow response body")
+
+	srv.InjectFault(s3test.Fault{Type: s3test.FaultSlowBody, Operation: "GetObject", Delay: time.Millisecond, ChunkSize: 4})
+	get, err := svc.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	b, _ := ioutil.ReadAll(get.Body)
+	get.Body.Close()
+	if e, a := "slow response body", string(b); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestServer_StalledRequestBody(t *testing.T) {
+	srv, svc := newClient(t)
+	defer srv.Close()
+
+	body, bodyW := io.Pipe()
+	defer bodyW.Close()
+	req, err := http.NewRequest(http.MethodPut, aws.StringValue(srv.Config().Endpoint)+"/bucket/stalled", body)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		resp, err := http.DefaultClient.Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		done <- err
+	}()
+	bodyW.Write([]byte("partial"))
+
+	// The stalled upload must not block other requests
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := svc.HeadBucketWithContext(ctx, &s3.HeadBucketInput{Bucket: aws.String("bucket")}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	bodyW.Close()
+	if err := <-done; err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	b, ok := srv.Object("bucket", "stalled")
+	if !ok {
+		t.Fatalf("expect stalled object to be stored")
+	}
+	if e, a := "partial", string(b); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestServer_Waiter(t *testing.T) {
+	srv, svc := newClient(t)
+	defer srv.Close()
+	putObject(t, svc, "key", "")
+
+	if err := svc.WaitUntilObjectExists(&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := svc.WaitUntilBucketExists(&s3.HeadBucketInput{Bucket: aws.String("bucket")}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+}
+
+func TestServer_BucketConfiguration(t *testing.T) {
+	srv, svc := newClient(t)
+	defer srv.Close()
+
+	_, err := svc.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: aws.String("bucket")})
+	expectErrCode(t, s3.ErrCodeNoSuchBucketPolicy, err)
+
+	policy := `{"Version":"2012-10-17","Statement":[]}`
+	_, err = svc.PutBucketPolicy(&s3.PutBucketPolicyInput{Bucket: aws.String("bucket"), Policy: aws.String(policy)})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	_, err = svc.PutBucketPolicy(&s3.PutBucketPolicyInput{Bucket: aws.String("bucket"), Policy: aws.String("{")})
+	expectErrCode(t, "MalformedPolicy", err)
+
+	policyOut, err := svc.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: aws.String("bucket")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := policy, aws.StringValue(policyOut.Policy); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	_, err = svc.GetBucketTagging(&s3.GetBucketTaggingInput{Bucket: aws.String("bucket")})
+	expectErrCode(t, s3.ErrCodeNoSuchTagSet, err)
+
+	_, err = svc.PutBucketTagging(&s3.PutBucketTaggingInput{
+		Bucket: aws.String("bucket"),
+		Tagging: &s3.Tagging{TagSet: []*s3.Tag{
+			{Key: aws.String("team"), Value: aws.String("storage")},
+			{Key: aws.String("env"), Value: aws.String("test")},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	tagging, err := svc.GetBucketTagging(&s3.GetBucketTaggingInput{Bucket: aws.String("bucket")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 2, len(tagging.TagSet); e != a {
+		t.Fatalf("expect %v tags, got %v", e, a)
+	}
+	if e, a := "env", aws.StringValue(tagging.TagSet[0].Key); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	_, err = svc.PutBucketNotificationConfiguration(&s3.PutBucketNotificationConfigurationInput{
+		Bucket: aws.String("bucket"),
+		NotificationConfiguration: &s3.NotificationConfiguration{
+			QueueConfigurations: []*s3.QueueConfiguration{{
+				Events:   []*string{aws.String(s3.EventS3ObjectCreated)},
+				QueueArn: aws.String("arn:aws:sqs:us-south:123456789012:queue"),
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	notification, err := svc.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String("bucket"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, len(notification.QueueConfigurations); e != a {
+		t.Fatalf("expect %v queue configurations, got %v", e, a)
+	}
+	if e, a := s3.EventS3ObjectCreated, aws.StringValue(notification.QueueConfigurations[0].Events[0]); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	if _, err := svc.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{Bucket: aws.String("bucket")}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, err := svc.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{Bucket: aws.String("bucket")}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	_, err = svc.GetBucketTagging(&s3.GetBucketTaggingInput{Bucket: aws.String("bucket")})
+	expectErrCode(t, s3.ErrCodeNoSuchTagSet, err)
+}
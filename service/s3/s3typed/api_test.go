@@ -0,0 +1,101 @@
+package s3typed_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3typed"
+)
+
+func TestClient_ListObjectsV2(t *testing.T) {
+	fake := &s3iface.FakeS3{
+		ListObjectsV2WithContextFunc: func(_ aws.Context, input *s3.ListObjectsV2Input, _ ...request.Option) (*s3.ListObjectsV2Output, error) {
+			expect := &s3.ListObjectsV2Input{
+				Bucket:       aws.String("bucket"),
+				MaxKeys:      aws.Int64(0),
+				EncodingType: aws.String(s3.EncodingTypeUrl),
+			}
+			if e, a := expect, input; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			return &s3.ListObjectsV2Output{
+				IsTruncated: aws.Bool(false),
+				Contents: []*s3.Object{
+					{Key: aws.String("a"), StorageClass: aws.String(s3.ObjectStorageClassGlacier)},
+					nil,
+				},
+			}, nil
+		},
+	}
+
+	svc := s3typed.New(fake)
+	out, err := svc.ListObjectsV2(context.Background(), &s3typed.ListObjectsV2Input{
+		Bucket:       "bucket",
+		MaxKeys:      aws.Some(int64(0)),
+		EncodingType: aws.Some(s3typed.EncodingTypeUrl),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if v, ok := out.IsTruncated.Get(); !ok || v {
+		t.Errorf("expect IsTruncated set to false, got %v", out.IsTruncated)
+	}
+	if out.NextContinuationToken.IsSet() {
+		t.Errorf("expect NextContinuationToken unset, got %v", out.NextContinuationToken)
+	}
+	expect := []s3typed.Object{
+		{Key: aws.Some("a"), StorageClass: aws.Some(s3typed.ObjectStorageClassGlacier)},
+		{},
+	}
+	if e, a := expect, out.Contents; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestClient_Options(t *testing.T) {
+	var calls [][]request.Option
+	fake := &s3iface.FakeS3{
+		HeadBucketWithContextFunc: func(_ aws.Context, input *s3.HeadBucketInput, opts ...request.Option) (*s3.HeadBucketOutput, error) {
+			calls = append(calls, opts)
+			return nil, awserr.New("NotFound", "not found", nil)
+		},
+	}
+
+	noop := func(*request.Request) {}
+	svc := s3typed.New(fake, s3typed.WithRequestOptions(noop))
+	for i := 0; i < 2; i++ {
+		out, err := svc.HeadBucket(context.Background(), &s3typed.HeadBucketInput{Bucket: "bucket"},
+			s3typed.WithRequestOptions(noop))
+		if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != "NotFound" {
+			t.Fatalf("expect NotFound error, got %v", err)
+		}
+		if out != nil {
+			t.Errorf("expect no output, got %v", out)
+		}
+	}
+
+	for i, opts := range calls {
+		if e, a := 2, len(opts); e != a {
+			t.Errorf("%d, expect %v options, got %v", i, e, a)
+		}
+	}
+}
+
+func TestEnumValues(t *testing.T) {
+	values := s3typed.StorageClass("").Values()
+	if e, a := len(s3.StorageClass_Values()), len(values); e != a {
+		t.Fatalf("expect %v values, got %v", e, a)
+	}
+	for i, v := range s3.StorageClass_Values() {
+		if e, a := v, string(values[i]); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+}
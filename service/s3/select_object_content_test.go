@@ -0,0 +1,114 @@
+package s3_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/awstesting/unit"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// The select_object_content.eventstream fixture is the response of
+//
+//	SELECT s.A, s.B, s.C FROM S3Object s WHERE s.B = '9'
+//
+// over positive_select.csv, with the records split over two Records events.
+func TestSelectObjectContent_RecordedStream(t *testing.T) {
+	stream, err := ioutil.ReadFile("testdata/select_object_content.eventstream")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	src, err := ioutil.ReadFile("testdata/positive_select.csv")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	svc := s3.New(unit.Session)
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		query := r.HTTPRequest.URL.Query()
+		if _, ok := query["select"]; !ok {
+			t.Errorf("expect select query, got %v", query)
+		}
+		if e, a := "2", query.Get("select-type"); e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader(stream)),
+		}
+	})
+
+	out, err := svc.SelectObjectContent(&s3.SelectObjectContentInput{
+		Bucket:         aws.String("bucket"),
+		Key:            aws.String("positive_select.csv"),
+		Expression:     aws.String("SELECT s.A, s.B, s.C FROM S3Object s WHERE s.B = '9'"),
+		ExpressionType: aws.String(s3.ExpressionTypeSql),
+		InputSerialization: &s3.InputSerialization{
+			CSV: &s3.CSVInput{FileHeaderInfo: aws.String(s3.FileHeaderInfoUse)},
+		},
+		OutputSerialization: &s3.OutputSerialization{CSV: &s3.CSVOutput{}},
+		RequestProgress:     &s3.RequestProgress{Enabled: aws.Bool(true)},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer out.EventStream.Close()
+
+	var records bytes.Buffer
+	var stats *s3.Stats
+	var progress, ends int
+	for event := range out.EventStream.Events() {
+		switch e := event.(type) {
+		case *s3.RecordsEvent:
+			records.Write(e.Payload)
+		case *s3.ProgressEvent:
+			progress++
+		case *s3.StatsEvent:
+			stats = e.Details
+		case *s3.EndEvent:
+			ends++
+		}
+	}
+	if err := out.EventStream.Err(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(src)).ReadAll()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	var expect bytes.Buffer
+	w := csv.NewWriter(&expect)
+	for _, row := range rows[1:] {
+		if row[1] == "9" {
+			w.Write(row[:3])
+		}
+	}
+	w.Flush()
+
+	if e, a := expect.String(), records.String(); e != a {
+		t.Errorf("expect records %v, got %v", e, a)
+	}
+	if e, a := 1, progress; e != a {
+		t.Errorf("expect %v progress events, got %v", e, a)
+	}
+	if e, a := 1, ends; e != a {
+		t.Errorf("expect %v end events, got %v", e, a)
+	}
+	if stats == nil {
+		t.Fatalf("expect stats event, got none")
+	}
+	if e, a := int64(len(src)), aws.Int64Value(stats.BytesScanned); e != a {
+		t.Errorf("expect %v bytes scanned, got %v", e, a)
+	}
+	if e, a := int64(records.Len()), aws.Int64Value(stats.BytesReturned); e != a {
+		t.Errorf("expect %v bytes returned, got %v", e, a)
+	}
+}
@@ -0,0 +1,133 @@
+package s3
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awsutil"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/awstesting/unit"
+)
+
+// listObjectVersionsBody returns a ListObjectVersions response body with n
+// versions and delete markers of long keys.
+func listObjectVersionsBody(n int) []byte {
+	var b bytes.Buffer
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<ListVersionsResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+	<Name>mock-bucket</Name><Prefix></Prefix><KeyMarker></KeyMarker><VersionIdMarker></VersionIdMarker>
+	<MaxKeys>1000</MaxKeys><IsTruncated>true</IsTruncated>
+	<NextKeyMarker>next</NextKeyMarker><NextVersionIdMarker>next-version</NextVersionIdMarker>`)
+	key := strings.Repeat("long/key/path/", 16)
+	for i := 0; i < n; i++ {
+		entry := "Version"
+		if i%10 == 0 {
+			entry = "DeleteMarker"
+		}
+		fmt.Fprintf(&b, `
+	<%[1]s>
+		<Key>%[2]s%[3]d</Key><VersionId>3/L4kqtJlcpXroDTDmJ+rmSpXd3dIbrHY+MTRCxf3vjVBH40Nr8X8gdRQBpUMLUo%[3]d</VersionId>
+		<IsLatest>%[4]t</IsLatest><LastModified>2009-10-12T17:50:30.000Z</LastModified>
+		<ETag>&quot;fba9dede5f27731c9771645a39863328&quot;</ETag><Size>434234</Size><StorageClass>STANDARD</StorageClass>
+		<Owner><ID>75aa57f09aa0c8caeab4f8c24e99d10f8e7faeebf76c078efc7c6caea54ba06a</ID><DisplayName>mtd@amazon.com</DisplayName></Owner>
+	</%[1]s>`, entry, key, i, i%2 == 0)
+	}
+	b.WriteString(`
+	<CommonPrefixes><Prefix>photos/</Prefix></CommonPrefixes>
+</ListVersionsResult>`)
+	return b.Bytes()
+}
+
+func mockResponseHandler(body []byte) func(*request.Request) {
+	return func(r *request.Request) {
+		r.HTTPResponse = &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader(body)),
+		}
+	}
+}
+
+func TestUnmarshal_StreamingXMLDecoder(t *testing.T) {
+	cases := map[string]struct {
+		Body    []byte
+		Request func(*S3) (*request.Request, interface{})
+		Expect  func(*testing.T, interface{})
+	}{
+		"ListObjectVersions": {
+			Body: listObjectVersionsBody(25),
+			Request: func(svc *S3) (*request.Request, interface{}) {
+				return svc.ListObjectVersionsRequest(&ListObjectVersionsInput{Bucket: aws.String("mock-bucket")})
+			},
+		},
+		"ListObjectsV2": {
+			Body: []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+	<Name>mock-bucket</Name><KeyCount>2</KeyCount><MaxKeys>1000</MaxKeys><IsTruncated>false</IsTruncated>
+	<Contents><Key>a&amp;b</Key><LastModified>2009-10-12T17:50:30.000Z</LastModified><Size>1</Size><StorageClass>STANDARD</StorageClass></Contents>
+	<Contents><Key><![CDATA[c<d]]></Key><Size>2</Size></Contents>
+	<CommonPrefixes><Prefix>photos/</Prefix></CommonPrefixes>
+</ListBucketResult>`),
+			Request: func(svc *S3) (*request.Request, interface{}) {
+				return svc.ListObjectsV2Request(&ListObjectsV2Input{Bucket: aws.String("mock-bucket")})
+			},
+		},
+		"GetBucketAcl": {
+			Body: []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<AccessControlPolicy xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+	<Owner><ID>owner-id</ID><DisplayName>owner</DisplayName></Owner>
+	<AccessControlList>
+		<Grant>
+			<Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>owner-id</ID><DisplayName>owner</DisplayName></Grantee>
+			<Permission>FULL_CONTROL</Permission>
+		</Grant>
+		<Grant>
+			<Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="Group"><URI>http://acs.amazonaws.com/groups/global/AllUsers</URI></Grantee>
+			<Permission>READ</Permission>
+		</Grant>
+	</AccessControlList>
+</AccessControlPolicy>`),
+			Request: func(svc *S3) (*request.Request, interface{}) {
+				return svc.GetBucketAclRequest(&GetBucketAclInput{Bucket: aws.String("mock-bucket")})
+			},
+			Expect: func(t *testing.T, out interface{}) {
+				grants := out.(*GetBucketAclOutput).Grants
+				if e, a := 2, len(grants); e != a {
+					t.Fatalf("expect %v grants, got %v", e, a)
+				}
+				if e, a := TypeGroup, aws.StringValue(grants[1].Grantee.Type); e != a {
+					t.Errorf("expect %v, got %v", e, a)
+				}
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var outs []interface{}
+			for _, stream := range []bool{false, true} {
+				svc := New(unit.Session, &aws.Config{UseStreamingXMLDecoder: aws.Bool(stream)})
+				req, out := c.Request(svc)
+				req.Handlers.Send.Clear()
+				req.Handlers.Send.PushBack(mockResponseHandler(c.Body))
+				if err := req.Send(); err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				if c.Expect != nil {
+					c.Expect(t, out)
+				}
+				outs = append(outs, out)
+			}
+
+			if e, a := outs[0], outs[1]; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v, got %v", awsutil.Prettify(e), awsutil.Prettify(a))
+			}
+		})
+	}
+}
@@ -116,6 +116,63 @@ func (c *S3) WaitUntilBucketNotExistsWithContext(ctx aws.Context, input *HeadBuc
 	return w.WaitWithContext(ctx)
 }
 
+// WaitUntilLifecycleApplied uses the Amazon S3 API operation
+// GetBucketLifecycleConfiguration to wait for a condition to be met before returning.
+// If the condition is not met within the max attempt window, or max wait time,
+// an error will be returned.
+//
+// Succeeds once the bucket has a lifecycle configuration, as soon as GetBucketLifecycleConfiguration
+// returns it, not once its rules were applied to the objects of the bucket.
+func (c *S3) WaitUntilLifecycleApplied(input *GetBucketLifecycleConfigurationInput) error {
+	return c.WaitUntilLifecycleAppliedWithContext(aws.BackgroundContext(), input)
+}
+
+// WaitUntilLifecycleAppliedWithContext is an extended version of WaitUntilLifecycleApplied.
+// With the support for passing in a context and options to configure the
+// Waiter and the underlying request options.
+//
+// The waiter backs off from 5 to 60 seconds between attempts, with
+// jitter, for up to 120 attempts or 600 seconds.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *S3) WaitUntilLifecycleAppliedWithContext(ctx aws.Context, input *GetBucketLifecycleConfigurationInput, opts ...request.WaiterOption) error {
+	w := request.TypedWaiter[*GetBucketLifecycleConfigurationOutput]{
+		Name:        "WaitUntilLifecycleApplied",
+		MaxAttempts: 120,
+		Delay:       request.JitteredWaiterDelay(5*time.Second, 60*time.Second),
+		MaxWait:     600 * time.Second,
+		Acceptors: []request.TypedAcceptor[*GetBucketLifecycleConfigurationOutput]{
+			{
+				State:   request.SuccessWaiterState,
+				Matcher: request.MatchStatus[*GetBucketLifecycleConfigurationOutput](200),
+			},
+			{
+				State:   request.RetryWaiterState,
+				Matcher: request.MatchErrorCode[*GetBucketLifecycleConfigurationOutput]("NoSuchLifecycleConfiguration"),
+			},
+		},
+		Logger: c.Config.Logger,
+		NewRequest: func(opts []request.Option) (*request.Request, *GetBucketLifecycleConfigurationOutput, error) {
+			var inCpy *GetBucketLifecycleConfigurationInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, out := c.GetBucketLifecycleConfigurationRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, out, nil
+		},
+	}
+	w.ApplyOptions(opts...)
+
+	_, err := w.WaitWithContext(ctx)
+	return err
+}
+
 // WaitUntilObjectExists uses the Amazon S3 API operation
 // HeadObject to wait for a condition to be met before returning.
 // If the condition is not met within the max attempt window, an error will
@@ -212,3 +269,117 @@ func (c *S3) WaitUntilObjectNotExistsWithContext(ctx aws.Context, input *HeadObj
 
 	return w.WaitWithContext(ctx)
 }
+
+// WaitUntilObjectRestored uses the Amazon S3 API operation
+// HeadObject to wait for a condition to be met before returning.
+// If the condition is not met within the max attempt window, or max wait time,
+// an error will be returned.
+//
+// Succeeds once the restore of the archived object completed and the object
+// can be read. Restores from the IBM COS archive tier take up to 12 hours,
+// and up to 2 hours from the accelerated archive tier. Fails if the object
+// has no Restore header, because it is not archived or its restore was not
+// requested, or an invalid one.
+func (c *S3) WaitUntilObjectRestored(input *HeadObjectInput) error {
+	return c.WaitUntilObjectRestoredWithContext(aws.BackgroundContext(), input)
+}
+
+// WaitUntilObjectRestoredWithContext is an extended version of WaitUntilObjectRestored.
+// With the support for passing in a context and options to configure the
+// Waiter and the underlying request options.
+//
+// The waiter waits 300 seconds between attempts, for up to
+// 180 attempts or 54000 seconds.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *S3) WaitUntilObjectRestoredWithContext(ctx aws.Context, input *HeadObjectInput, opts ...request.WaiterOption) error {
+	w := request.TypedWaiter[*HeadObjectOutput]{
+		Name:        "WaitUntilObjectRestored",
+		MaxAttempts: 180,
+		Delay:       request.JitteredWaiterDelay(300*time.Second, 300*time.Second),
+		MaxWait:     54000 * time.Second,
+		Acceptors: []request.TypedAcceptor[*HeadObjectOutput]{
+			{
+				State:   request.SuccessWaiterState,
+				Matcher: objectRestored,
+			},
+			{
+				State:   request.FailureWaiterState,
+				Matcher: objectRestoreStatusInvalid,
+			},
+		},
+		Logger: c.Config.Logger,
+		NewRequest: func(opts []request.Option) (*request.Request, *HeadObjectOutput, error) {
+			var inCpy *HeadObjectInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, out := c.HeadObjectRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, out, nil
+		},
+	}
+	w.ApplyOptions(opts...)
+
+	_, err := w.WaitWithContext(ctx)
+	return err
+}
+
+// WaitUntilReplicationComplete uses the Amazon S3 API operation
+// HeadObject to wait for a condition to be met before returning.
+// If the condition is not met within the max attempt window, or max wait time,
+// an error will be returned.
+func (c *S3) WaitUntilReplicationComplete(input *HeadObjectInput) error {
+	return c.WaitUntilReplicationCompleteWithContext(aws.BackgroundContext(), input)
+}
+
+// WaitUntilReplicationCompleteWithContext is an extended version of WaitUntilReplicationComplete.
+// With the support for passing in a context and options to configure the
+// Waiter and the underlying request options.
+//
+// The waiter backs off from 15 to 300 seconds between attempts, with
+// jitter, for up to 480 attempts or 7200 seconds.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *S3) WaitUntilReplicationCompleteWithContext(ctx aws.Context, input *HeadObjectInput, opts ...request.WaiterOption) error {
+	w := request.TypedWaiter[*HeadObjectOutput]{
+		Name:        "WaitUntilReplicationComplete",
+		MaxAttempts: 480,
+		Delay:       request.JitteredWaiterDelay(15*time.Second, 300*time.Second),
+		MaxWait:     7200 * time.Second,
+		Acceptors: []request.TypedAcceptor[*HeadObjectOutput]{
+			{
+				State:   request.SuccessWaiterState,
+				Matcher: request.MatchPath[*HeadObjectOutput]("ReplicationStatus", "COMPLETE"),
+			},
+			{
+				State:   request.FailureWaiterState,
+				Matcher: request.MatchPath[*HeadObjectOutput]("ReplicationStatus", "FAILED"),
+			},
+		},
+		Logger: c.Config.Logger,
+		NewRequest: func(opts []request.Option) (*request.Request, *HeadObjectOutput, error) {
+			var inCpy *HeadObjectInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, out := c.HeadObjectRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, out, nil
+		},
+	}
+	w.ApplyOptions(opts...)
+
+	_, err := w.WaitWithContext(ctx)
+	return err
+}
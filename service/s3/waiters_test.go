@@ -0,0 +1,145 @@
+package s3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/awstesting/unit"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+type waiterResponse struct {
+	Status int
+	Header http.Header
+	Body   string
+}
+
+// newWaiterClient returns a client responding to each request with the next
+// response, and the delays the waiter sleeps.
+func newWaiterClient(t *testing.T, resps []waiterResponse) (*s3.S3, *int, request.WaiterOption) {
+	svc := s3.New(unit.Session, &aws.Config{Region: aws.String("us-south")})
+
+	attempts := 0
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		if attempts >= len(resps) {
+			t.Fatalf("too many polling requests made")
+		}
+		resp := resps[attempts]
+		attempts++
+		header := resp.Header
+		if header == nil {
+			header = http.Header{}
+		}
+		r.HTTPResponse = &http.Response{
+			StatusCode: resp.Status,
+			Header:     header,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(resp.Body))),
+		}
+	})
+
+	noSleep := func(w *request.Waiter) {
+		w.SleepWithContext = func(aws.Context, time.Duration) error { return nil }
+	}
+	return svc, &attempts, noSleep
+}
+
+func replicationStatus(status string) waiterResponse {
+	return waiterResponse{Status: 200, Header: http.Header{"X-Amz-Replication-Status": []string{status}}}
+}
+
+func TestWaitUntilReplicationComplete(t *testing.T) {
+	svc, attempts, noSleep := newWaiterClient(t, []waiterResponse{
+		replicationStatus(s3.ReplicationStatusPending),
+		replicationStatus(s3.ReplicationStatusPending),
+		replicationStatus(s3.ReplicationStatusComplete),
+	})
+
+	err := svc.WaitUntilReplicationCompleteWithContext(aws.BackgroundContext(),
+		&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")}, noSleep)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 3, *attempts; e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
+
+func TestWaitUntilReplicationComplete_Failed(t *testing.T) {
+	svc, attempts, noSleep := newWaiterClient(t, []waiterResponse{
+		replicationStatus(s3.ReplicationStatusPending),
+		replicationStatus(s3.ReplicationStatusFailed),
+	})
+
+	err := svc.WaitUntilReplicationCompleteWithContext(aws.BackgroundContext(),
+		&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")}, noSleep)
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != request.WaiterResourceNotReadyErrorCode {
+		t.Fatalf("expect %v error, got %v", request.WaiterResourceNotReadyErrorCode, err)
+	}
+	if e, a := 2, *attempts; e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
+
+func TestWaitUntilLifecycleApplied(t *testing.T) {
+	noLifecycle := waiterResponse{
+		Status: 404,
+		Body:   `<Error><Code>NoSuchLifecycleConfiguration</Code><Message>The lifecycle configuration does not exist</Message></Error>`,
+	}
+	svc, attempts, noSleep := newWaiterClient(t, []waiterResponse{
+		noLifecycle,
+		noLifecycle,
+		{Status: 200, Body: `<LifecycleConfiguration><Rule><ID>tmp</ID><Status>Enabled</Status></Rule></LifecycleConfiguration>`},
+	})
+
+	err := svc.WaitUntilLifecycleAppliedWithContext(aws.BackgroundContext(),
+		&s3.GetBucketLifecycleConfigurationInput{Bucket: aws.String("bucket")}, noSleep)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 3, *attempts; e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
+
+func TestWaitUntilLifecycleApplied_NoMaxWait(t *testing.T) {
+	resps := make([]waiterResponse, 120)
+	for i := range resps {
+		resps[i] = waiterResponse{
+			Status: 404,
+			Body:   `<Error><Code>NoSuchLifecycleConfiguration</Code><Message>The lifecycle configuration does not exist</Message></Error>`,
+		}
+	}
+	svc, attempts, noSleep := newWaiterClient(t, resps)
+
+	err := svc.WaitUntilLifecycleAppliedWithContext(aws.BackgroundContext(),
+		&s3.GetBucketLifecycleConfigurationInput{Bucket: aws.String("bucket")},
+		noSleep, request.WithWaiterMaxWait(0))
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != request.WaiterResourceNotReadyErrorCode {
+		t.Fatalf("expect %v error, got %v", request.WaiterResourceNotReadyErrorCode, err)
+	}
+	if e, a := 120, *attempts; e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
+
+func TestWaitUntilLifecycleApplied_AccessDenied(t *testing.T) {
+	svc, attempts, noSleep := newWaiterClient(t, []waiterResponse{
+		{Status: 403, Body: `<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`},
+	})
+
+	err := svc.WaitUntilLifecycleAppliedWithContext(aws.BackgroundContext(),
+		&s3.GetBucketLifecycleConfigurationInput{Bucket: aws.String("bucket")}, noSleep)
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != "AccessDenied" {
+		t.Fatalf("expect AccessDenied error, got %v", err)
+	}
+	if e, a := 1, *attempts; e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}